@@ -0,0 +1,113 @@
+// Command tournament pits bot behaviors against each other in headless,
+// seeded one-on-one matches and reports Elo ratings and per-bot stats.
+//
+//	go run ./cmd/tournament -entries offensive,defensive@hard -rounds 20 -format csv
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"LightSpeedDuel/internal/dag"
+	"LightSpeedDuel/internal/game"
+)
+
+func main() {
+	entries := flag.String("entries", "offensive,defensive", "comma-separated competitors as behavior[@difficulty]")
+	rounds := flag.Int("rounds", 10, "matches per pairing")
+	seed := flag.Int64("seed", 1, "seed of the first match")
+	duration := flag.Float64("duration", 180, "simulated seconds per match")
+	parallel := flag.Int("parallel", runtime.NumCPU(), "matches to simulate at once")
+	botTreesDir := flag.String("bots-dir", "configs/bots", "directory of JSON behavior trees for bots")
+	format := flag.String("format", "json", "report format: json or csv")
+	out := flag.String("out", "", "write the report to this file instead of stdout")
+	verbose := flag.Bool("v", false, "keep game logs such as bot crafting")
+	flag.Parse()
+
+	logger := log.New(os.Stderr, "tournament: ", 0)
+	if *format != "json" && *format != "csv" {
+		logger.Fatalf("unknown format: %s", *format)
+	}
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	// Bots craft missiles through the DAG once their starting stock runs out.
+	nodes := append(dag.SeedMissileCraftNodes(), dag.SeedConsumableCraftNodes()...)
+	if err := dag.Init(nodes); err != nil {
+		logger.Fatalf("failed to initialize DAG: %v", err)
+	}
+	if _, err := game.LoadBehaviorTreesDir(*botTreesDir); err != nil {
+		logger.Printf("bot behavior trees: %v", err)
+	}
+
+	cfg := game.TournamentConfig{
+		Rounds:       *rounds,
+		Seed:         *seed,
+		MatchSeconds: *duration,
+		Parallel:     *parallel,
+	}
+	for _, spec := range strings.Split(*entries, ",") {
+		entry, err := game.ParseTournamentEntry(spec)
+		if err != nil {
+			logger.Fatalf("entry %q: %v", spec, err)
+		}
+		cfg.Entries = append(cfg.Entries, entry)
+	}
+
+	report, err := game.RunTournament(cfg)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			logger.Fatalf("create report: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	case "csv":
+		err = writeCSV(w, report)
+	}
+	if err != nil {
+		logger.Fatalf("write report: %v", err)
+	}
+}
+
+// writeCSV writes one row per standing.
+func writeCSV(w io.Writer, report *game.TournamentReport) error {
+	cw := csv.NewWriter(w)
+	rows := [][]string{{"name", "elo", "wins", "losses", "draws", "kills", "deaths", "missiles_fired", "stalls"}}
+	for _, s := range report.Standings {
+		rows = append(rows, []string{
+			s.Name,
+			strconv.FormatFloat(s.Elo, 'f', 1, 64),
+			strconv.Itoa(s.Wins),
+			strconv.Itoa(s.Losses),
+			strconv.Itoa(s.Draws),
+			strconv.Itoa(s.Kills),
+			strconv.Itoa(s.Deaths),
+			strconv.Itoa(s.MissilesFired),
+			strconv.Itoa(s.Stalls),
+		})
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
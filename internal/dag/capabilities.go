@@ -8,7 +8,9 @@ type PlayerCapabilities struct {
     MissileSpeedMultiplier float64
     ShipHeatCapacity       float64
     MissileHeatCapacity    float64
+    ShipHeatEfficiency     float64 // Divides the ship's heating rate
     UnlockedMissiles       []string
+    WingmanSlots           int // Number of AI wingmen the player may field
 }
 
 // DefaultCapabilities returns base capabilities with no upgrades.
@@ -18,6 +20,7 @@ func DefaultCapabilities() PlayerCapabilities {
         MissileSpeedMultiplier: 1.0,
         ShipHeatCapacity:       1.0,
         MissileHeatCapacity:    1.0,
+        ShipHeatEfficiency:     1.0,
         UnlockedMissiles:       nil,
     }
 }
@@ -34,6 +37,8 @@ func CalculateCapabilities(state *State) PlayerCapabilities {
     highestMissileSpeed := 1.0
     highestShipHeat := 1.0
     highestMissileHeat := 1.0
+    highestShipEfficiency := 1.0
+    wingmanSlots := 0
 
     graph := GetGraph()
     if graph == nil {
@@ -71,6 +76,14 @@ func CalculateCapabilities(state *State) PlayerCapabilities {
                         highestMissileHeat = v
                     }
                 }
+            case EffectHeatEfficiency:
+                if v, ok := eff.Value.(float64); ok && isShip && v > highestShipEfficiency {
+                    highestShipEfficiency = v
+                }
+            case EffectWingmanSlot:
+                if v, ok := eff.Value.(float64); ok && int(v) > wingmanSlots {
+                    wingmanSlots = int(v)
+                }
             case EffectMissileUnlock:
                 if id, ok := eff.Value.(string); ok {
                     caps.UnlockedMissiles = append(caps.UnlockedMissiles, id)
@@ -83,6 +96,8 @@ func CalculateCapabilities(state *State) PlayerCapabilities {
     caps.MissileSpeedMultiplier = highestMissileSpeed
     caps.ShipHeatCapacity = highestShipHeat
     caps.MissileHeatCapacity = highestMissileHeat
+    caps.ShipHeatEfficiency = highestShipEfficiency
+    caps.WingmanSlots = wingmanSlots
     return caps
 }
 
@@ -105,3 +105,38 @@ func SeedMissileCraftNodes() []*Node {
 		},
 	}
 }
+
+// SeedConsumableCraftNodes creates the crafting nodes for ship consumables
+// (repair kits and heat sinks).
+func SeedConsumableCraftNodes() []*Node {
+	return []*Node{
+		{
+			ID:         "craft.repair_kit",
+			Kind:       NodeKindCraft,
+			Label:      "Craft Repair Kit",
+			DurationS:  45.0,
+			Repeatable: true,
+			Payload: map[string]string{
+				"item_type":       "repair_kit",
+				"variant_id":      "standard",
+				"base_duration_s": "45",
+				"desc":            "Field repair kit that restores damaged ship subsystems",
+			},
+			Requires: []NodeID{},
+		},
+		{
+			ID:         "craft.heat_sink",
+			Kind:       NodeKindCraft,
+			Label:      "Craft Heat Sink",
+			DurationS:  30.0,
+			Repeatable: true,
+			Payload: map[string]string{
+				"item_type":       "heat_sink",
+				"variant_id":      "standard",
+				"base_duration_s": "30",
+				"desc":            "Disposable heat sink that absorbs a fixed amount of ship heat",
+			},
+			Requires: []NodeID{},
+		},
+	}
+}
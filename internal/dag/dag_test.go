@@ -1,6 +1,7 @@
 package dag
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -89,6 +90,80 @@ func TestGraphMissingDependency(t *testing.T) {
 	}
 }
 
+// TestGraphMissingChoiceOutcome tests that choices must lead to existing nodes
+func TestGraphMissingChoiceOutcome(t *testing.T) {
+	nodes := []*Node{
+		{
+			ID:    "story1",
+			Kind:  NodeKindStory,
+			Label: "Story 1",
+			Dialogue: &DialogueContent{
+				Choices: []DialogueChoice{{ID: "go", Text: "Go", Next: "nonexistent"}},
+			},
+		},
+	}
+
+	if _, err := NewGraph(nodes); err == nil {
+		t.Error("Expected error for choice leading to a missing node")
+	}
+	nodes[0].Dialogue.Choices[0].Next = "story1"
+	if _, err := NewGraph(nodes); err != nil {
+		t.Errorf("Expected valid choice outcome, got %v", err)
+	}
+}
+
+// TestGraphStoryEffectValidation tests that malformed story effects fail to load
+func TestGraphStoryEffectValidation(t *testing.T) {
+	build := func(effects ...StoryEffect) error {
+		_, err := NewGraph([]*Node{
+			{ID: "upgrade1", Kind: NodeKindUpgrade, Label: "Upgrade 1"},
+			{ID: "story1", Kind: NodeKindStory, Label: "Story 1", StoryEffects: effects},
+		})
+		return err
+	}
+
+	valid := []StoryEffect{
+		{Type: StoryEffectSetFlag, Flag: "seen"},
+		{Type: StoryEffectGrantItem, Item: "missile", VariantID: "basic", Count: 2},
+		{Type: StoryEffectGrantUpgrade, Node: "upgrade1"},
+		{Type: StoryEffectSpawnEncounter, Wave: 1},
+		{Type: StoryEffectHeatParams, Heat: map[string]float64{"kUp": 1.2}},
+		{Type: StoryEffectStartTimer, Seconds: 5, Node: "story1"},
+		{Type: StoryEffectAddObjective, Objective: "hold", ObjectiveType: StoryObjectiveSurvive, Seconds: 30},
+		{Type: StoryEffectRemoveObjective, Objective: "hold"},
+	}
+	if err := build(valid...); err != nil {
+		t.Fatalf("Expected valid effects to load, got %v", err)
+	}
+
+	invalid := []StoryEffect{
+		{Type: "explode"},
+		{Type: StoryEffectClearFlag},
+		{Type: StoryEffectRemoveItem, Item: "missile"},
+		{Type: StoryEffectGrantUpgrade, Node: "story1"},
+		{Type: StoryEffectSpawnEncounter},
+		{Type: StoryEffectHeatParams, Heat: map[string]float64{"color": 2}},
+		{Type: StoryEffectStartTimer, Seconds: 5, Node: "missing"},
+		{Type: StoryEffectAddObjective, Objective: "kill", ObjectiveType: StoryObjectiveKill},
+	}
+	for _, effect := range invalid {
+		if err := build(effect); !errors.Is(err, ErrInvalidStoryEffect) {
+			t.Errorf("Expected %+v to be rejected, got %v", effect, err)
+		}
+	}
+
+	_, err := NewGraph([]*Node{{
+		ID:   "story1",
+		Kind: NodeKindStory,
+		Dialogue: &DialogueContent{
+			Choices: []DialogueChoice{{ID: "go", Effects: []StoryEffect{{Type: StoryEffectSetFlag}}}},
+		},
+	}})
+	if !errors.Is(err, ErrInvalidStoryEffect) {
+		t.Errorf("Expected a malformed choice effect to be rejected, got %v", err)
+	}
+}
+
 // TestStateInitialization tests basic state operations
 func TestStateInitialization(t *testing.T) {
 	state := NewState()
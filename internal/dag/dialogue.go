@@ -1,10 +1,13 @@
 package dag
 
 // DialogueChoice represents a player response option in a story node.
-// Each choice has an ID (sent back to server) and display text.
+// Each choice has an ID (sent back to server), display text, the effects of
+// picking it and the node it leads to.
 type DialogueChoice struct {
-	ID   string // Unique identifier, e.g. "investigate", "cautious"
-	Text string // Display text shown to player
+	ID      string        // Unique identifier, e.g. "investigate", "cautious"
+	Text    string        // Display text shown to player
+	Effects []StoryEffect // Applied when the choice is picked
+	Next    NodeID        // Story node started when the choice is picked (empty = none)
 }
 
 // TutorialTip provides gameplay hints alongside dialogue.
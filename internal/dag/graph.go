@@ -8,6 +8,7 @@ package dag
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 )
 
 // NodeID uniquely identifies a node in the graph.
@@ -35,6 +36,7 @@ const (
 	EffectMissileUnlock
 	EffectHeatCapacity
 	EffectHeatEfficiency
+	EffectWingmanSlot
 )
 
 // UpgradeEffect describes what an upgrade does when completed.
@@ -45,15 +47,16 @@ type UpgradeEffect struct {
 
 // Node represents a single node in the DAG.
 type Node struct {
-	ID         NodeID            `json:"id"`
-	Kind       NodeKind          `json:"kind"`
-	Label      string            `json:"label"`
-	DurationS  float64           `json:"duration_s"` // Duration in seconds (0 = instant)
-	Repeatable bool              `json:"repeatable"` // Can be repeated after completion
-	Payload    map[string]string `json:"payload"`    // Arbitrary key-value data
-	Requires   []NodeID          `json:"requires"`   // Dependencies (must be completed)
-	Dialogue   *DialogueContent  `json:"dialogue,omitempty"` // Story nodes only - dialogue content to display
-	Effects    []UpgradeEffect   `json:"effects,omitempty"`  // Upgrade nodes only - effects to apply when completed
+	ID           NodeID            `json:"id"`
+	Kind         NodeKind          `json:"kind"`
+	Label        string            `json:"label"`
+	DurationS    float64           `json:"duration_s"`              // Duration in seconds (0 = instant)
+	Repeatable   bool              `json:"repeatable"`              // Can be repeated after completion
+	Payload      map[string]string `json:"payload"`                 // Arbitrary key-value data
+	Requires     []NodeID          `json:"requires"`                // Dependencies (must be completed)
+	Dialogue     *DialogueContent  `json:"dialogue,omitempty"`      // Story nodes only - dialogue content to display
+	Effects      []UpgradeEffect   `json:"effects,omitempty"`       // Upgrade nodes only - effects to apply when completed
+	StoryEffects []StoryEffect     `json:"story_effects,omitempty"` // Story nodes only - consequences applied when started
 }
 
 // Graph represents the complete DAG.
@@ -72,11 +75,22 @@ var (
 	ErrInvalidDuration = errors.New("dag: negative duration")
 )
 
-// defaultGraph is the singleton graph instance.
-var defaultGraph *Graph
+// defaultGraph is the singleton graph instance. It is swapped atomically so
+// content can be reloaded while rooms are running.
+var defaultGraph atomic.Pointer[Graph]
 
 // Init initializes the global graph with the provided nodes and validates it.
 func Init(nodes []*Node) error {
+	g, err := NewGraph(nodes)
+	if err != nil {
+		return err
+	}
+	SetGraph(g)
+	return nil
+}
+
+// NewGraph builds and validates a graph without installing it.
+func NewGraph(nodes []*Node) (*Graph, error) {
 	g := &Graph{
 		Nodes:      make(map[NodeID]*Node),
 		RequiresIn: make(map[NodeID][]NodeID),
@@ -85,7 +99,7 @@ func Init(nodes []*Node) error {
 	// Index all nodes
 	for _, node := range nodes {
 		if node.DurationS < 0 {
-			return fmt.Errorf("%w: node %s has duration %.2f", ErrInvalidDuration, node.ID, node.DurationS)
+			return nil, fmt.Errorf("%w: node %s has duration %.2f", ErrInvalidDuration, node.ID, node.DurationS)
 		}
 		g.Nodes[node.ID] = node
 	}
@@ -94,26 +108,51 @@ func Init(nodes []*Node) error {
 	for _, node := range nodes {
 		for _, reqID := range node.Requires {
 			if _, exists := g.Nodes[reqID]; !exists {
-				return fmt.Errorf("%w: node %s requires missing node %s", ErrNodeNotFound, node.ID, reqID)
+				return nil, fmt.Errorf("%w: node %s requires missing node %s", ErrNodeNotFound, node.ID, reqID)
 			}
 			g.RequiresIn[reqID] = append(g.RequiresIn[reqID], node.ID)
 		}
 	}
 
+	// Validate dialogue choice outcomes
+	for _, node := range nodes {
+		if node.Dialogue == nil {
+			continue
+		}
+		for _, choice := range node.Dialogue.Choices {
+			if choice.Next == "" {
+				continue
+			}
+			if _, exists := g.Nodes[choice.Next]; !exists {
+				return nil, fmt.Errorf("%w: node %s choice %s leads to missing node %s", ErrNodeNotFound, node.ID, choice.ID, choice.Next)
+			}
+		}
+	}
+
+	// Validate story effects
+	for _, node := range nodes {
+		if err := validateStoryEffects(g, node); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate acyclic via topological sort
 	order, err := g.topoSort()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	g.TopoOrder = order
+	return g, nil
+}
 
-	defaultGraph = g
-	return nil
+// SetGraph installs g as the global graph.
+func SetGraph(g *Graph) {
+	defaultGraph.Store(g)
 }
 
 // GetGraph returns the initialized global graph.
 func GetGraph() *Graph {
-	return defaultGraph
+	return defaultGraph.Load()
 }
 
 // GetNode returns a node by ID, or nil if not found.
@@ -0,0 +1,9 @@
+package dag
+
+// SeedNodes returns every built-in node: crafting, story and upgrades.
+func SeedNodes() []*Node {
+	nodes := append(SeedMissileCraftNodes(), SeedConsumableCraftNodes()...)
+	nodes = append(nodes, SeedStoryNodes()...)
+	nodes = append(nodes, SeedUpgradeNodes()...)
+	return append(nodes, SeedWingmanUpgradeNodes()...)
+}
@@ -54,8 +54,8 @@ func SeedStoryNodes() []*Node {
 [The beacon's security protocols are active. You can attempt to bypass them peacefully, or force your way through.]`,
 				Intent: "factory",
 				Choices: []DialogueChoice{
-					{ID: "friendly", Text: "Negotiate with the beacon's AI"},
-					{ID: "hostile", Text: "Override security protocols by force"},
+					{ID: "friendly", Text: "Negotiate with the beacon's AI", Next: "story.signal-static-1.beacon-1-friendly"},
+					{ID: "hostile", Text: "Override security protocols by force", Next: "story.signal-static-1.beacon-1-hostile"},
 				},
 			},
 		},
@@ -70,9 +70,11 @@ func SeedStoryNodes() []*Node {
 				"chapter":        "signal-static-1",
 				"node":           "beacon-1-friendly",
 				"flag":           "story.signal-static-1.beacon-1-friendly",
-				"grant_upgrade":  "upgrade.missile.speed_1",
 				"reward_summary": "Missile Speed Boost I unlocked",
 			},
+			StoryEffects: []StoryEffect{
+				{Type: StoryEffectGrantUpgrade, Node: "upgrade.missile.speed_1"},
+			},
 			Requires: []NodeID{"story.signal-static-1.beacon-1-lock"},
 			Dialogue: &DialogueContent{
 				Speaker:       "BEACON AI",
@@ -89,12 +91,12 @@ func SeedStoryNodes() []*Node {
 			DurationS:  999999,
 			Repeatable: false,
 			Payload: map[string]string{
-				"chapter":          "signal-static-1",
-				"node":             "beacon-1-hostile",
-				"flag":             "story.signal-static-1.beacon-1-hostile",
-				"spawn_encounter":  "true",
-				"encounter_wave":   "1",
-				"encounter_beacon": "1",
+				"chapter": "signal-static-1",
+				"node":    "beacon-1-hostile",
+				"flag":    "story.signal-static-1.beacon-1-hostile",
+			},
+			StoryEffects: []StoryEffect{
+				{Type: StoryEffectSpawnEncounter, Wave: 1, Beacon: 1},
 			},
 			Requires: []NodeID{"story.signal-static-1.beacon-1-lock"},
 			Dialogue: &DialogueContent{
@@ -125,8 +127,8 @@ func SeedStoryNodes() []*Node {
 [This beacon monitors biometric traffic near the distress source. You can align with its humanitarian protocols, or silence them to keep moving fast.]`,
 				Intent: "factory",
 				Choices: []DialogueChoice{
-					{ID: "friendly", Text: "Respect the beacon's aid protocols"},
-					{ID: "hostile", Text: "Bypass safeguards and push through"},
+					{ID: "friendly", Text: "Respect the beacon's aid protocols", Next: "story.signal-static-1.beacon-2-friendly"},
+					{ID: "hostile", Text: "Bypass safeguards and push through", Next: "story.signal-static-1.beacon-2-hostile"},
 				},
 			},
 		},
@@ -141,9 +143,11 @@ func SeedStoryNodes() []*Node {
 				"chapter":        "signal-static-1",
 				"node":           "beacon-2-friendly",
 				"flag":           "story.signal-static-1.beacon-2-friendly",
-				"grant_upgrade":  "upgrade.missile.heat_cap_1",
 				"reward_summary": "Missile Heat Capacity Boost I unlocked",
 			},
+			StoryEffects: []StoryEffect{
+				{Type: StoryEffectGrantUpgrade, Node: "upgrade.missile.heat_cap_1"},
+			},
 			Requires: []NodeID{"story.signal-static-1.beacon-2-lock"},
 			Dialogue: &DialogueContent{
 				Speaker:       "BEACON MEDICAL CORE",
@@ -160,12 +164,12 @@ func SeedStoryNodes() []*Node {
 			DurationS:  999999,
 			Repeatable: false,
 			Payload: map[string]string{
-				"chapter":          "signal-static-1",
-				"node":             "beacon-2-hostile",
-				"flag":             "story.signal-static-1.beacon-2-hostile",
-				"spawn_encounter":  "true",
-				"encounter_wave":   "2",
-				"encounter_beacon": "2",
+				"chapter": "signal-static-1",
+				"node":    "beacon-2-hostile",
+				"flag":    "story.signal-static-1.beacon-2-hostile",
+			},
+			StoryEffects: []StoryEffect{
+				{Type: StoryEffectSpawnEncounter, Wave: 2, Beacon: 2},
 			},
 			Requires: []NodeID{"story.signal-static-1.beacon-2-lock"},
 			Dialogue: &DialogueContent{
@@ -196,8 +200,8 @@ func SeedStoryNodes() []*Node {
 [Final defense drones converge on this beacon. You can coordinate with them for safe passage, or seize control and divert them.]`,
 				Intent: "factory",
 				Choices: []DialogueChoice{
-					{ID: "friendly", Text: "Sync defense grid to your transponder"},
-					{ID: "hostile", Text: "Hijack the drones and clear the corridor"},
+					{ID: "friendly", Text: "Sync defense grid to your transponder", Next: "story.signal-static-1.beacon-3-friendly"},
+					{ID: "hostile", Text: "Hijack the drones and clear the corridor", Next: "story.signal-static-1.beacon-3-hostile"},
 				},
 			},
 		},
@@ -212,9 +216,11 @@ func SeedStoryNodes() []*Node {
 				"chapter":        "signal-static-1",
 				"node":           "beacon-3-friendly",
 				"flag":           "story.signal-static-1.beacon-3-friendly",
-				"grant_upgrade":  "upgrade.ship.speed_1",
 				"reward_summary": "Ship Speed Boost I unlocked",
 			},
+			StoryEffects: []StoryEffect{
+				{Type: StoryEffectGrantUpgrade, Node: "upgrade.ship.speed_1"},
+			},
 			Requires: []NodeID{"story.signal-static-1.beacon-3-lock"},
 			Dialogue: &DialogueContent{
 				Speaker:       "BEACON COMMAND NODE",
@@ -231,12 +237,12 @@ func SeedStoryNodes() []*Node {
 			DurationS:  999999,
 			Repeatable: false,
 			Payload: map[string]string{
-				"chapter":          "signal-static-1",
-				"node":             "beacon-3-hostile",
-				"flag":             "story.signal-static-1.beacon-3-hostile",
-				"spawn_encounter":  "true",
-				"encounter_wave":   "3",
-				"encounter_beacon": "3",
+				"chapter": "signal-static-1",
+				"node":    "beacon-3-hostile",
+				"flag":    "story.signal-static-1.beacon-3-hostile",
+			},
+			StoryEffects: []StoryEffect{
+				{Type: StoryEffectSpawnEncounter, Wave: 3, Beacon: 3},
 			},
 			Requires: []NodeID{"story.signal-static-1.beacon-3-lock"},
 			Dialogue: &DialogueContent{
@@ -0,0 +1,158 @@
+package dag
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidStoryEffect is returned when a story effect is malformed.
+var ErrInvalidStoryEffect = errors.New("dag: invalid story effect")
+
+// StoryEffectType names a story consequence.
+type StoryEffectType string
+
+const (
+	StoryEffectSetFlag         StoryEffectType = "set_flag"         // Sets Flag
+	StoryEffectClearFlag       StoryEffectType = "clear_flag"       // Clears Flag
+	StoryEffectGrantItem       StoryEffectType = "grant_item"       // Adds Count of Item/VariantID
+	StoryEffectRemoveItem      StoryEffectType = "remove_item"      // Removes up to Count of Item/VariantID
+	StoryEffectGrantUpgrade    StoryEffectType = "grant_upgrade"    // Completes upgrade Node
+	StoryEffectSpawnEncounter  StoryEffectType = "spawn_encounter"  // Spawns mission Wave at Beacon
+	StoryEffectHeatParams      StoryEffectType = "heat_params"      // Scales the room's heat params by Heat
+	StoryEffectStartTimer      StoryEffectType = "start_timer"      // Starts story Node after Seconds
+	StoryEffectAddObjective    StoryEffectType = "add_objective"    // Adds mission objective Objective
+	StoryEffectRemoveObjective StoryEffectType = "remove_objective" // Drops mission objective Objective
+)
+
+// Objective types an add_objective effect can create
+const (
+	StoryObjectiveKill    = "kill"    // Destroy Count entities tagged Tag
+	StoryObjectiveSurvive = "survive" // Hold out for Seconds
+	StoryObjectiveReach   = "reach"   // Fly to beacon Beacon
+)
+
+// StoryHeatParams lists the heat params a heat_params effect may scale.
+var StoryHeatParams = map[string]bool{
+	"max":          true,
+	"warnAt":       true,
+	"overheatAt":   true,
+	"stallSeconds": true,
+	"markerSpeed":  true,
+	"kUp":          true,
+	"kDown":        true,
+}
+
+// StoryEffect is a typed consequence of a story node starting or a dialogue
+// choice being picked. Only the fields its Type names are used.
+type StoryEffect struct {
+	Type          StoryEffectType    `json:"type"`
+	Flag          string             `json:"flag,omitempty"`
+	Item          string             `json:"item,omitempty"`       // Inventory item type, e.g. "missile"
+	VariantID     string             `json:"variant_id,omitempty"` // Inventory item variant
+	HeatCapacity  float64            `json:"heat_capacity,omitempty"`
+	Count         int                `json:"count,omitempty"`
+	Node          NodeID             `json:"node,omitempty"`
+	Wave          int                `json:"wave,omitempty"`
+	Beacon        int                `json:"beacon,omitempty"` // 1-based beacon ordinal; 0 = any
+	Heat          map[string]float64 `json:"heat,omitempty"`   // Param name -> multiplier
+	Seconds       float64            `json:"seconds,omitempty"`
+	Objective     string             `json:"objective,omitempty"` // Objective ID
+	ObjectiveType string             `json:"objective_type,omitempty"`
+	Tag           string             `json:"tag,omitempty"`
+}
+
+// Validate checks that the effect has the fields its type needs and that
+// any node it names exists in g with the right kind.
+func (e StoryEffect) Validate(g *Graph) error {
+	switch e.Type {
+	case StoryEffectSetFlag, StoryEffectClearFlag:
+		if e.Flag == "" {
+			return fmt.Errorf("%s needs a flag", e.Type)
+		}
+	case StoryEffectGrantItem, StoryEffectRemoveItem:
+		if e.Item == "" || e.Count <= 0 {
+			return fmt.Errorf("%s needs an item and a positive count", e.Type)
+		}
+	case StoryEffectGrantUpgrade:
+		return e.checkNode(g, NodeKindUpgrade)
+	case StoryEffectSpawnEncounter:
+		if e.Wave <= 0 || e.Beacon < 0 {
+			return fmt.Errorf("%s needs a positive wave and a beacon ordinal", e.Type)
+		}
+	case StoryEffectHeatParams:
+		if len(e.Heat) == 0 {
+			return fmt.Errorf("%s needs heat multipliers", e.Type)
+		}
+		for name, scale := range e.Heat {
+			if !StoryHeatParams[name] {
+				return fmt.Errorf("%s: unknown heat param %s", e.Type, name)
+			}
+			if scale <= 0 {
+				return fmt.Errorf("%s: %s multiplier must be positive", e.Type, name)
+			}
+		}
+	case StoryEffectStartTimer:
+		if e.Seconds <= 0 {
+			return fmt.Errorf("%s needs positive seconds", e.Type)
+		}
+		return e.checkNode(g, NodeKindStory)
+	case StoryEffectAddObjective:
+		if e.Objective == "" {
+			return fmt.Errorf("%s needs an objective ID", e.Type)
+		}
+		switch e.ObjectiveType {
+		case StoryObjectiveKill:
+			if e.Tag == "" || e.Count <= 0 {
+				return fmt.Errorf("%s kill needs a tag and a positive count", e.Type)
+			}
+		case StoryObjectiveSurvive:
+			if e.Seconds <= 0 {
+				return fmt.Errorf("%s survive needs positive seconds", e.Type)
+			}
+		case StoryObjectiveReach:
+			if e.Beacon <= 0 {
+				return fmt.Errorf("%s reach needs a beacon ordinal", e.Type)
+			}
+		default:
+			return fmt.Errorf("%s: unknown objective type %q", e.Type, e.ObjectiveType)
+		}
+	case StoryEffectRemoveObjective:
+		if e.Objective == "" {
+			return fmt.Errorf("%s needs an objective ID", e.Type)
+		}
+	default:
+		return fmt.Errorf("unknown story effect %q", e.Type)
+	}
+	return nil
+}
+
+func (e StoryEffect) checkNode(g *Graph, want NodeKind) error {
+	node := g.Nodes[e.Node]
+	if node == nil {
+		return fmt.Errorf("%s: unknown node %q", e.Type, e.Node)
+	}
+	if node.Kind != want {
+		return fmt.Errorf("%s: node %s is %s, not %s", e.Type, e.Node, node.Kind, want)
+	}
+	return nil
+}
+
+// validateStoryEffects checks the story effects of a node and its choices.
+func validateStoryEffects(g *Graph, node *Node) error {
+	for i, effect := range node.StoryEffects {
+		if err := effect.Validate(g); err != nil {
+			return fmt.Errorf("%w: node %s story_effects[%d]: %v", ErrInvalidStoryEffect, node.ID, i, err)
+		}
+	}
+	if node.Dialogue == nil {
+		return nil
+	}
+	for _, choice := range node.Dialogue.Choices {
+		for i, effect := range choice.Effects {
+			if err := effect.Validate(g); err != nil {
+				return fmt.Errorf("%w: node %s choice %s effects[%d]: %v", ErrInvalidStoryEffect, node.ID, choice.ID, i, err)
+			}
+		}
+	}
+	return nil
+}
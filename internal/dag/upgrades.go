@@ -1,5 +1,7 @@
 package dag
 
+import "fmt"
+
 // SeedUpgradeNodes defines the upgrade progression for ship/missile speed and heat capacity.
 // This uses existing effect types and disambiguates target via node ID/payload.
 func SeedUpgradeNodes() []*Node {
@@ -55,6 +57,38 @@ func SeedUpgradeNodes() []*Node {
 	return nodes
 }
 
+// SeedWingmanUpgradeNodes returns the upgrades that unlock AI wingman slots.
+// Each tier raises the slot count to its Value; story nodes may also grant
+// them directly.
+func SeedWingmanUpgradeNodes() []*Node {
+	return []*Node{
+		{
+			ID:        "upgrade.wingman.slot_1",
+			Kind:      NodeKindUpgrade,
+			Label:     "Wingman Berth I",
+			DurationS: 120,
+			Payload: map[string]string{
+				"target":      "wingman",
+				"description": "Field 1 AI wingman",
+			},
+			Requires: []NodeID{},
+			Effects:  []UpgradeEffect{{Type: EffectWingmanSlot, Value: 1.0}},
+		},
+		{
+			ID:        "upgrade.wingman.slot_2",
+			Kind:      NodeKindUpgrade,
+			Label:     "Wingman Berth II",
+			DurationS: 360,
+			Payload: map[string]string{
+				"target":      "wingman",
+				"description": "Field 2 AI wingmen",
+			},
+			Requires: []NodeID{"upgrade.wingman.slot_1"},
+			Effects:  []UpgradeEffect{{Type: EffectWingmanSlot, Value: 2.0}},
+		},
+	}
+}
+
 // roman converts small integers 1..5 to Roman numerals for labels.
 func roman(n int) string {
 	switch n {
@@ -73,6 +107,38 @@ func roman(n int) string {
 	}
 }
 
+// effectTypeNames are the names content files use for effect types.
+var effectTypeNames = map[EffectType]string{
+	EffectSpeedMultiplier: "speed_multiplier",
+	EffectMissileUnlock:   "missile_unlock",
+	EffectHeatCapacity:    "heat_capacity",
+	EffectHeatEfficiency:  "heat_efficiency",
+	EffectWingmanSlot:     "wingman_slot",
+}
+
+func (t EffectType) String() string {
+	if name, ok := effectTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalText encodes the effect type by name.
+func (t EffectType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText parses an effect type name such as "heat_capacity".
+func (t *EffectType) UnmarshalText(text []byte) error {
+	for effect, name := range effectTypeNames {
+		if name == string(text) {
+			*t = effect
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown effect type: %s", text)
+}
+
 func upgradeDescription(t EffectType, target string, percent int) string {
 	switch t {
 	case EffectSpeedMultiplier:
@@ -0,0 +1,309 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// BTStatus is the result of ticking a behavior-tree node.
+type BTStatus int
+
+const (
+	BTSuccess BTStatus = iota
+	BTFailure
+	BTRunning
+)
+
+// BTBlackboard is the per-plan scratch space shared by the nodes of a tree.
+// Conditions fill in Target and Threat for the actions that follow them.
+type BTBlackboard struct {
+	Ctx      *AIContext
+	Commands []AICommand
+	Target   *AIShipInfo
+	Threat   *AIMissileThreat
+}
+
+func (bb *BTBlackboard) emit(cmd AICommand) {
+	bb.Commands = append(bb.Commands, cmd)
+}
+
+// BTNode is a node in a behavior tree.
+type BTNode interface {
+	Tick(bb *BTBlackboard) BTStatus
+}
+
+// btSelector ticks children in order until one does not fail.
+type btSelector struct{ children []BTNode }
+
+func (n *btSelector) Tick(bb *BTBlackboard) BTStatus {
+	for _, child := range n.children {
+		if status := child.Tick(bb); status != BTFailure {
+			return status
+		}
+	}
+	return BTFailure
+}
+
+// btSequence ticks children in order until one does not succeed.
+type btSequence struct{ children []BTNode }
+
+func (n *btSequence) Tick(bb *BTBlackboard) BTStatus {
+	for _, child := range n.children {
+		if status := child.Tick(bb); status != BTSuccess {
+			return status
+		}
+	}
+	return BTSuccess
+}
+
+// btInverter swaps success and failure of its child.
+type btInverter struct{ child BTNode }
+
+func (n *btInverter) Tick(bb *BTBlackboard) BTStatus {
+	switch n.child.Tick(bb) {
+	case BTSuccess:
+		return BTFailure
+	case BTFailure:
+		return BTSuccess
+	default:
+		return BTRunning
+	}
+}
+
+// btSucceeder ticks its child and always succeeds.
+type btSucceeder struct{ child BTNode }
+
+func (n *btSucceeder) Tick(bb *BTBlackboard) BTStatus {
+	n.child.Tick(bb)
+	return BTSuccess
+}
+
+// btCooldown fails while cooling down and starts the cooldown whenever its
+// child succeeds.
+type btCooldown struct {
+	child   BTNode
+	seconds float64
+	readyAt float64
+}
+
+func (n *btCooldown) Tick(bb *BTBlackboard) BTStatus {
+	if bb.Ctx.Now < n.readyAt {
+		return BTFailure
+	}
+	status := n.child.Tick(bb)
+	if status == BTSuccess {
+		n.readyAt = bb.Ctx.Now + n.seconds
+	}
+	return status
+}
+
+// btLeaf wraps a condition or action with its parameters.
+type btLeaf struct {
+	fn     btLeafFunc
+	params btParams
+}
+
+func (n *btLeaf) Tick(bb *BTBlackboard) BTStatus {
+	return n.fn(bb, n.params)
+}
+
+// btParams holds the JSON parameters of a leaf node.
+type btParams map[string]interface{}
+
+func (p btParams) float(key string, def float64) float64 {
+	if v, ok := p[key].(float64); ok {
+		return v
+	}
+	return def
+}
+
+func (p btParams) str(key, def string) string {
+	if v, ok := p[key].(string); ok && v != "" {
+		return v
+	}
+	return def
+}
+
+type btLeafFunc func(bb *BTBlackboard, p btParams) BTStatus
+
+// BTNodeDef is the JSON form of a behavior-tree node.
+//
+// Composite types are "selector" and "sequence" (using Children); decorators
+// are "inverter", "succeeder" and "cooldown" (using Child, plus Seconds for
+// cooldown); leaves are "condition" and "action" (using Name and Params).
+type BTNodeDef struct {
+	Type     string                 `json:"type"`
+	Name     string                 `json:"name,omitempty"`
+	Params   map[string]interface{} `json:"params,omitempty"`
+	Seconds  float64                `json:"seconds,omitempty"`
+	Children []BTNodeDef            `json:"children,omitempty"`
+	Child    *BTNodeDef             `json:"child,omitempty"`
+}
+
+// BehaviorTreeDef is a named behavior tree loaded from JSON.
+type BehaviorTreeDef struct {
+	Name string    `json:"name"`
+	Root BTNodeDef `json:"root"`
+}
+
+// buildBTNode instantiates a node definition. Each call creates fresh node
+// state, so every bot gets its own cooldown timers.
+func buildBTNode(def BTNodeDef, path string) (BTNode, error) {
+	switch def.Type {
+	case "selector", "sequence":
+		if len(def.Children) == 0 {
+			return nil, fmt.Errorf("%s: %s needs children", path, def.Type)
+		}
+		children := make([]BTNode, 0, len(def.Children))
+		for i, childDef := range def.Children {
+			child, err := buildBTNode(childDef, fmt.Sprintf("%s.children[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+		if def.Type == "selector" {
+			return &btSelector{children: children}, nil
+		}
+		return &btSequence{children: children}, nil
+
+	case "inverter", "succeeder", "cooldown":
+		if def.Child == nil {
+			return nil, fmt.Errorf("%s: %s needs a child", path, def.Type)
+		}
+		child, err := buildBTNode(*def.Child, path+".child")
+		if err != nil {
+			return nil, err
+		}
+		switch def.Type {
+		case "inverter":
+			return &btInverter{child: child}, nil
+		case "succeeder":
+			return &btSucceeder{child: child}, nil
+		default:
+			if def.Seconds <= 0 {
+				return nil, fmt.Errorf("%s: cooldown needs positive seconds", path)
+			}
+			return &btCooldown{child: child, seconds: def.Seconds}, nil
+		}
+
+	case "condition", "action":
+		leaves := btActions
+		if def.Type == "condition" {
+			leaves = btConditions
+		}
+		fn, ok := leaves[def.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown %s %q", path, def.Type, def.Name)
+		}
+		return &btLeaf{fn: fn, params: btParams(def.Params)}, nil
+
+	default:
+		return nil, fmt.Errorf("%s: unknown node type %q", path, def.Type)
+	}
+}
+
+// BehaviorTreeBehavior runs a behavior tree as an AIBehavior.
+type BehaviorTreeBehavior struct {
+	name string
+	root BTNode
+}
+
+// NewBehaviorTreeBehavior builds a behavior from a tree definition.
+func NewBehaviorTreeBehavior(def *BehaviorTreeDef) (*BehaviorTreeBehavior, error) {
+	if def == nil {
+		return nil, fmt.Errorf("nil behavior tree")
+	}
+	root, err := buildBTNode(def.Root, "root")
+	if err != nil {
+		return nil, fmt.Errorf("behavior tree %s: %w", def.Name, err)
+	}
+	return &BehaviorTreeBehavior{name: def.Name, root: root}, nil
+}
+
+// Name implements the optional named-behavior interface used by the registry.
+func (b *BehaviorTreeBehavior) Name() string { return b.name }
+
+func (b *BehaviorTreeBehavior) Plan(ctx *AIContext) []AICommand {
+	if ctx == nil || ctx.SelfTransform == nil {
+		return nil
+	}
+	bb := &BTBlackboard{Ctx: ctx}
+	b.root.Tick(bb)
+	return bb.Commands
+}
+
+// ParseBehaviorTree decodes and validates a behavior tree from JSON.
+func ParseBehaviorTree(data []byte) (*BehaviorTreeDef, error) {
+	var def BehaviorTreeDef
+	if err := json.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("parse behavior tree: %w", err)
+	}
+	if def.Name == "" {
+		return nil, fmt.Errorf("behavior tree missing name")
+	}
+	if _, err := NewBehaviorTreeBehavior(&def); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// RegisterBehaviorTree adds a tree to the AI behavior registry under its name.
+// Built-in Go behaviors cannot be replaced.
+func RegisterBehaviorTree(def *BehaviorTreeDef) error {
+	if def == nil || def.Name == "" {
+		return fmt.Errorf("behavior tree missing name")
+	}
+	if def.Name == AIBehaviorDefensive || def.Name == AIBehaviorOffensive {
+		return fmt.Errorf("behavior tree %s: name is reserved", def.Name)
+	}
+	if _, err := NewBehaviorTreeBehavior(def); err != nil {
+		return err
+	}
+	tree := *def
+	RegisterAIBehavior(def.Name, func() AIBehavior {
+		b, err := NewBehaviorTreeBehavior(&tree)
+		if err != nil {
+			return NewDefensiveBehavior()
+		}
+		return b
+	})
+	return nil
+}
+
+// LoadBehaviorTreesDir parses and registers every *.json tree in dir and
+// returns the registered names. A missing directory is not an error.
+func LoadBehaviorTreesDir(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read behavior tree dir %q: %w", dir, err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return names, fmt.Errorf("read behavior tree %q: %w", path, err)
+		}
+		def, err := ParseBehaviorTree(data)
+		if err != nil {
+			return names, fmt.Errorf("%s: %w", path, err)
+		}
+		if err := RegisterBehaviorTree(def); err != nil {
+			return names, fmt.Errorf("%s: %w", path, err)
+		}
+		names = append(names, def.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,190 @@
+package game
+
+import "math"
+
+// aiMissileAmmo counts the missiles in the player's inventory.
+func aiMissileAmmo(p *Player) int {
+	ammo := 0
+	if p == nil || p.Inventory == nil {
+		return ammo
+	}
+	for _, it := range p.Inventory.Items {
+		if it.Type == "missile" && it.Quantity > 0 {
+			ammo += it.Quantity
+		}
+	}
+	return ammo
+}
+
+func btResult(ok bool) BTStatus {
+	if ok {
+		return BTSuccess
+	}
+	return BTFailure
+}
+
+// btWorldBounds returns the playable area for the bot's room.
+func btWorldBounds(ctx *AIContext) (float64, float64) {
+	if ctx.Room != nil {
+		return ctx.Room.WorldWidth, ctx.Room.WorldHeight
+	}
+	return WorldW, WorldH
+}
+
+func btShipSpeed(ctx *AIContext) float64 {
+	if ctx.SelfMovement != nil && ctx.SelfMovement.MaxSpeed > 0 {
+		return ctx.SelfMovement.MaxSpeed
+	}
+	return ShipMaxSpeed
+}
+
+// btShipRoute clamps a route to the bot's heat discipline and emits it.
+func btShipRoute(bb *BTBlackboard, route []RouteWaypoint) BTStatus {
+	ctx := bb.Ctx
+	if ctx.SelfHeat != nil {
+		route = clampShipWaypointsToHeat(ctx.SelfHeat, ctx.SelfTransform.Pos, route, ctx.Difficulty.shipHeatCap(ctx.SelfHeat), ctx.SelfHeat.P.MarkerSpeed*0.5)
+	}
+	bb.emit(CommandSetShipRoute(route))
+	return BTSuccess
+}
+
+// btConditions are the leaf checks available to behavior trees.
+var btConditions = map[string]btLeafFunc{
+	// has_target picks the nearest perceived opponent within "range".
+	"has_target": func(bb *BTBlackboard, p btParams) BTStatus {
+		pos := bb.Ctx.SelfTransform.Pos
+		maxRange := p.float("range", math.Inf(1))
+		bb.Target = nil
+		best := math.MaxFloat64
+		for i := range bb.Ctx.Opponents {
+			op := &bb.Ctx.Opponents[i]
+			if op.Transform == nil {
+				continue
+			}
+			if d := op.Transform.Pos.Sub(pos).Len(); d <= maxRange && d < best {
+				bb.Target, best = op, d
+			}
+		}
+		return btResult(bb.Target != nil)
+	},
+	// threat_imminent finds a missile passing within "radius" hit radii of
+	// the ship in the next "seconds".
+	"threat_imminent": func(bb *BTBlackboard, p btParams) BTStatus {
+		horizon := p.float("seconds", 4)
+		radius := shipHitRadius(bb.Ctx.SelfShip) * p.float("radius", 3)
+		bb.Threat = nil
+		for i := range bb.Ctx.Threats {
+			threat := &bb.Ctx.Threats[i]
+			if threat.TimeToClosest > 0 && threat.TimeToClosest < horizon && threat.DistanceAtClosest <= radius {
+				bb.Threat = threat
+				break
+			}
+		}
+		return btResult(bb.Threat != nil)
+	},
+	// missile_ready is true when the launcher is off cooldown and ammo is left.
+	"missile_ready": func(bb *BTBlackboard, p btParams) BTStatus {
+		return btResult(bb.Ctx.MissileReady() && aiMissileAmmo(bb.Ctx.Self) > 0)
+	},
+	// ammo_below is true when fewer than "count" missiles remain.
+	"ammo_below": func(bb *BTBlackboard, p btParams) BTStatus {
+		return btResult(float64(aiMissileAmmo(bb.Ctx.Self)) < p.float("count", 3))
+	},
+	// heat_above is true when ship heat is at least "ratio" of OverheatAt.
+	"heat_above": func(bb *BTBlackboard, p btParams) BTStatus {
+		h := bb.Ctx.SelfHeat
+		return btResult(h != nil && h.S.Value >= h.P.OverheatAt*p.float("ratio", 0.7))
+	},
+	// target_within is true when the current target is within "range".
+	"target_within": func(bb *BTBlackboard, p btParams) BTStatus {
+		if bb.Target == nil || bb.Target.Transform == nil {
+			return BTFailure
+		}
+		d := bb.Target.Transform.Pos.Sub(bb.Ctx.SelfTransform.Pos).Len()
+		return btResult(d <= p.float("range", closeRangePX))
+	},
+}
+
+// btActions are the leaf actions available to behavior trees. Each wraps
+// one of the AI commands.
+var btActions = map[string]btLeafFunc{
+	// evade sidesteps the threat found by threat_imminent.
+	"evade": func(bb *BTBlackboard, p btParams) BTStatus {
+		if bb.Threat == nil {
+			return BTFailure
+		}
+		w, h := btWorldBounds(bb.Ctx)
+		return btShipRoute(bb, planDirectMissileRoute(bb.Ctx.SelfTransform.Pos, *bb.Threat, w, h, btShipSpeed(bb.Ctx), bb.Ctx.Room.simRand()))
+	},
+	// approach flies "distance" units toward the target at "speed" times max speed.
+	"approach": func(bb *BTBlackboard, p btParams) BTStatus {
+		return btMoveRelativeToTarget(bb, p, 1)
+	},
+	// retreat flies "distance" units away from the target.
+	"retreat": func(bb *BTBlackboard, p btParams) BTStatus {
+		return btMoveRelativeToTarget(bb, p, -1)
+	},
+	// cool_down cruises below the heat marker speed.
+	"cool_down": func(bb *BTBlackboard, p btParams) BTStatus {
+		ctx := bb.Ctx
+		if ctx.SelfHeat == nil {
+			return BTFailure
+		}
+		dir := ctx.SelfTransform.Vel
+		if bb.Target != nil && bb.Target.Transform != nil {
+			dir = orthogonal(bb.Target.Transform.Pos.Sub(ctx.SelfTransform.Pos))
+		}
+		w, h := btWorldBounds(ctx)
+		return btShipRoute(bb, planHeatCooldownRoute(ctx.SelfTransform.Pos, dir, w, h, ctx.SelfHeat.P.MarkerSpeed, ctx.Room.simRand()))
+	},
+	// hold clears the ship route.
+	"hold": func(bb *BTBlackboard, p btParams) BTStatus {
+		bb.emit(CommandClearShipRoute())
+		return BTSuccess
+	},
+	// fire_missile leads the target with a missile flying at "speed" times
+	// max ship speed with an "agro" seeker radius.
+	"fire_missile": func(bb *BTBlackboard, p btParams) BTStatus {
+		ctx := bb.Ctx
+		if bb.Target == nil || bb.Target.Transform == nil || !ctx.MissileReady() || aiMissileAmmo(ctx.Self) == 0 {
+			return BTFailure
+		}
+		pos := ctx.SelfTransform.Pos
+		cfg := SanitizeMissileConfig(MissileConfig{
+			Speed:      btShipSpeed(ctx) * p.float("speed", 0.75),
+			AgroRadius: p.float("agro", 800),
+		})
+		track := aiTrack{Pos: bb.Target.Transform.Pos, Vel: bb.Target.Transform.Vel, SeenAt: bb.Target.SeenAt}
+		present := estimatePresent(track, ctx.Now)
+		aim, _, ok := interceptPoint(pos, present, track.Vel, cfg.Speed)
+		if !ok {
+			aim = present
+		}
+		aim = aim.Add(ctx.Difficulty.aimOffset(ctx.Room.simRand()))
+		w, h := btWorldBounds(ctx)
+		waypoints := []RouteWaypoint{{Pos: clampPointToWorldBounds(aim, w, h), Speed: cfg.Speed}}
+		waypoints = clampMissileWaypointsToHeat(cfg.HeatParams, pos, waypoints, cfg.HeatParams.OverheatAt*missileHeatCapRatio, MissileMinSpeed, cfg.Speed)
+		bb.emit(CommandLaunchMissile(cfg, waypoints))
+		return BTSuccess
+	},
+	// craft starts the DAG craft node named by "node".
+	"craft": func(bb *BTBlackboard, p btParams) BTStatus {
+		bb.emit(CommandDagStart(p.str("node", "craft.missile.basic")))
+		return BTSuccess
+	},
+}
+
+func btMoveRelativeToTarget(bb *BTBlackboard, p btParams, sign float64) BTStatus {
+	if bb.Target == nil || bb.Target.Transform == nil {
+		return BTFailure
+	}
+	pos := bb.Ctx.SelfTransform.Pos
+	dir := unitOrZero(bb.Target.Transform.Pos.Sub(pos)).Scale(sign)
+	if dir.Len() <= 1e-3 {
+		return BTFailure
+	}
+	w, h := btWorldBounds(bb.Ctx)
+	dest := clampPlanDestination(pos, dir, p.float("distance", 700), w, h)
+	speed := btShipSpeed(bb.Ctx) * Clamp(p.float("speed", 0.7), 0.1, 1)
+	return btShipRoute(bb, []RouteWaypoint{{Pos: dest, Speed: speed}})
+}
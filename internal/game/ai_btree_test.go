@@ -0,0 +1,159 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func countingLeaf(status BTStatus, calls *int) BTNode {
+	return &btLeaf{fn: func(bb *BTBlackboard, p btParams) BTStatus {
+		*calls++
+		return status
+	}}
+}
+
+func TestBehaviorTreeCompositeSemantics(t *testing.T) {
+	bb := &BTBlackboard{Ctx: &AIContext{Now: 10}}
+	var a, b, c int
+
+	sel := &btSelector{children: []BTNode{countingLeaf(BTFailure, &a), countingLeaf(BTSuccess, &b), countingLeaf(BTSuccess, &c)}}
+	if status := sel.Tick(bb); status != BTSuccess || a != 1 || b != 1 || c != 0 {
+		t.Fatalf("selector should stop at first success, got status %v calls %d/%d/%d", status, a, b, c)
+	}
+
+	a, b, c = 0, 0, 0
+	seq := &btSequence{children: []BTNode{countingLeaf(BTSuccess, &a), countingLeaf(BTFailure, &b), countingLeaf(BTSuccess, &c)}}
+	if status := seq.Tick(bb); status != BTFailure || a != 1 || b != 1 || c != 0 {
+		t.Fatalf("sequence should stop at first failure, got status %v calls %d/%d/%d", status, a, b, c)
+	}
+
+	if status := (&btInverter{child: countingLeaf(BTFailure, &a)}).Tick(bb); status != BTSuccess {
+		t.Fatalf("inverter should turn failure into success, got %v", status)
+	}
+
+	calls := 0
+	cd := &btCooldown{child: countingLeaf(BTSuccess, &calls), seconds: 2}
+	cd.Tick(bb)
+	if status := cd.Tick(bb); status != BTFailure || calls != 1 {
+		t.Fatalf("cooldown should block the second tick, got status %v calls %d", status, calls)
+	}
+	bb.Ctx.Now = 12
+	if status := cd.Tick(bb); status != BTSuccess || calls != 2 {
+		t.Fatalf("cooldown should expire after its duration, got status %v calls %d", status, calls)
+	}
+}
+
+func TestParseBehaviorTreeRejectsInvalidTrees(t *testing.T) {
+	cases := map[string]string{
+		"bad json":       `{`,
+		"missing name":   `{"root": {"type": "action", "name": "hold"}}`,
+		"unknown type":   `{"name": "t", "root": {"type": "parallel"}}`,
+		"unknown leaf":   `{"name": "t", "root": {"type": "action", "name": "teleport"}}`,
+		"condition leaf": `{"name": "t", "root": {"type": "condition", "name": "evade"}}`,
+		"no children":    `{"name": "t", "root": {"type": "selector"}}`,
+		"no child":       `{"name": "t", "root": {"type": "inverter"}}`,
+		"no cooldown":    `{"name": "t", "root": {"type": "cooldown", "child": {"type": "action", "name": "hold"}}}`,
+	}
+	for name, data := range cases {
+		if _, err := ParseBehaviorTree([]byte(data)); err == nil {
+			t.Errorf("%s: expected parse error", name)
+		}
+	}
+}
+
+const testSniperTree = `{
+  "name": "test_sniper",
+  "root": {
+    "type": "sequence",
+    "children": [
+      {"type": "condition", "name": "has_target", "params": {"range": 6000}},
+      {"type": "condition", "name": "missile_ready"},
+      {"type": "action", "name": "fire_missile", "params": {"speed": 0.8, "agro": 600}}
+    ]
+  }
+}`
+
+func TestBehaviorTreeLaunchesMissileAtTarget(t *testing.T) {
+	def, err := ParseBehaviorTree([]byte(testSniperTree))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	behavior, err := NewBehaviorTreeBehavior(def)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	room, bot, _ := movingShipRoom()
+	room.World.Transform(bot.Ship).Pos = Vec2{X: 3000, Y: 1500}
+	if cmds := behavior.Plan(buildAIContext(room, bot, 0)); len(cmds) != 0 {
+		t.Fatalf("expected no commands without ammo, got %d", len(cmds))
+	}
+
+	bot.EnsureInventory()
+	bot.Inventory.AddItem("missile", "basic", 80, 5)
+	cmds := behavior.Plan(buildAIContext(room, bot, 0))
+	if len(cmds) != 1 {
+		t.Fatalf("expected one command, got %d", len(cmds))
+	}
+	launch, ok := cmds[0].(aiCommandLaunchMissile)
+	if !ok {
+		t.Fatalf("expected a missile launch, got %T", cmds[0])
+	}
+	if launch.config.AgroRadius != 600 {
+		t.Fatalf("expected leaf params to set agro radius, got %.1f", launch.config.AgroRadius)
+	}
+}
+
+func TestLoadBehaviorTreesDirRegistersBehaviors(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "sniper.json"), []byte(testSniperTree), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		aiBehaviorMu.Lock()
+		delete(AIBehaviorRegistry, "test_sniper")
+		aiBehaviorMu.Unlock()
+	})
+
+	names, err := LoadBehaviorTreesDir(dir)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(names) != 1 || names[0] != "test_sniper" {
+		t.Fatalf("expected test_sniper to load, got %v", names)
+	}
+	behavior, err := NewAIBehavior("test_sniper")
+	if err != nil {
+		t.Fatalf("expected registered behavior: %v", err)
+	}
+	if behaviorName(behavior) != "test_sniper" {
+		t.Fatalf("expected tree behavior to report its name, got %q", behaviorName(behavior))
+	}
+
+	if names, err := LoadBehaviorTreesDir(filepath.Join(dir, "missing")); err != nil || len(names) != 0 {
+		t.Fatalf("missing dir should load nothing without error, got %v %v", names, err)
+	}
+	if err := RegisterBehaviorTree(&BehaviorTreeDef{Name: AIBehaviorOffensive, Root: BTNodeDef{Type: "action", Name: "hold"}}); err == nil {
+		t.Fatal("expected built-in behavior names to be reserved")
+	}
+}
+
+func TestBundledBehaviorTreesParse(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "..", "configs", "bots", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseBehaviorTree(data); err != nil {
+			t.Errorf("%s: %v", path, err)
+		}
+	}
+}
@@ -105,23 +105,23 @@ func clampPlanDestination(pos Vec2, direction Vec2, distance, worldW, worldH flo
 	return clampPointToWorldBounds(dest, worldW, worldH)
 }
 
-func planHeatBuildRoute(pos Vec2, direction Vec2, worldW, worldH, shipSpeed float64) []RouteWaypoint {
+func planHeatBuildRoute(pos Vec2, direction Vec2, worldW, worldH, shipSpeed float64, rng *rand.Rand) []RouteWaypoint {
 	direction = unitOrZero(direction)
 	if direction.Len() <= 1e-3 {
 		direction = Vec2{X: 1, Y: 0}
 	}
-	distance := Clamp(300+rand.Float64()*250, 200, 650)
+	distance := Clamp(300+rng.Float64()*250, 200, 650)
 	dest := clampPlanDestination(pos, direction, distance, worldW, worldH)
 	speed := Clamp(shipSpeed*0.95, shipSpeed*0.8, shipSpeed)
 	return []RouteWaypoint{{Pos: dest, Speed: speed}}
 }
 
-func planHeatCooldownRoute(pos Vec2, direction Vec2, worldW, worldH, markerSpeed float64) []RouteWaypoint {
+func planHeatCooldownRoute(pos Vec2, direction Vec2, worldW, worldH, markerSpeed float64, rng *rand.Rand) []RouteWaypoint {
 	direction = unitOrZero(direction)
 	if direction.Len() <= 1e-3 {
 		direction = Vec2{X: 0, Y: 1}
 	}
-	distance := Clamp(900+rand.Float64()*500, 600, 1500)
+	distance := Clamp(900+rng.Float64()*500, 600, 1500)
 	speed := Clamp(markerSpeed*0.8, math.Max(markerSpeed*0.6, markerSpeed-20), markerSpeed)
 	if speed <= 5 {
 		speed = markerSpeed
@@ -130,7 +130,7 @@ func planHeatCooldownRoute(pos Vec2, direction Vec2, worldW, worldH, markerSpeed
 	return []RouteWaypoint{{Pos: dest, Speed: speed}}
 }
 
-func planDirectMissileRoute(pos Vec2, threat AIMissileThreat, worldW, worldH, shipSpeed float64) []RouteWaypoint {
+func planDirectMissileRoute(pos Vec2, threat AIMissileThreat, worldW, worldH, shipSpeed float64, rng *rand.Rand) []RouteWaypoint {
 	toShip := unitOrZero(pos.Sub(threat.Pos))
 	if toShip.Len() <= 1e-3 {
 		toShip = Vec2{X: 1, Y: 0}
@@ -142,13 +142,13 @@ func planDirectMissileRoute(pos Vec2, threat AIMissileThreat, worldW, worldH, sh
 	if threat.Vel.Dot(lateral) > 0 {
 		lateral = lateral.Scale(-1)
 	}
-	sideDistance := Clamp(550+rand.Float64()*200, 400, 800)
+	sideDistance := Clamp(550+rng.Float64()*200, 400, 800)
 	sidePoint := clampPlanDestination(pos, lateral, sideDistance, worldW, worldH)
 	escapeDir := unitOrZero(lateral.Scale(0.6).Add(toShip.Scale(0.4)))
 	if escapeDir.Len() <= 1e-3 {
 		escapeDir = toShip
 	}
-	escapeDistance := Clamp(900+rand.Float64()*400, 700, 1400)
+	escapeDistance := Clamp(900+rng.Float64()*400, 700, 1400)
 	escapePoint := clampPlanDestination(sidePoint, escapeDir, escapeDistance, worldW, worldH)
 	speed := Clamp(shipSpeed, shipSpeed*0.8, shipSpeed)
 	return []RouteWaypoint{
@@ -157,12 +157,12 @@ func planDirectMissileRoute(pos Vec2, threat AIMissileThreat, worldW, worldH, sh
 	}
 }
 
-func planGeneralThreatRoute(pos Vec2, threat AIMissileThreat, worldW, worldH, shipSpeed float64) []RouteWaypoint {
+func planGeneralThreatRoute(pos Vec2, threat AIMissileThreat, worldW, worldH, shipSpeed float64, rng *rand.Rand) []RouteWaypoint {
 	away := unitOrZero(pos.Sub(threat.Pos))
 	if away.Len() <= 1e-3 {
 		away = Vec2{X: 1, Y: 0}
 	}
-	distance := Clamp(800+rand.Float64()*400, 500, 1400)
+	distance := Clamp(800+rng.Float64()*400, 500, 1400)
 	dest := clampPlanDestination(pos, away, distance, worldW, worldH)
 	speed := Clamp(shipSpeed, shipSpeed*0.7, shipSpeed)
 	return []RouteWaypoint{{Pos: dest, Speed: speed}}
@@ -185,11 +185,14 @@ func NewDefensiveBehavior() *DefensiveBehavior {
     }
 }
 
-func randRange(lo, hi float64) float64 {
+// Name implements the optional named-behavior interface used by the registry.
+func (b *DefensiveBehavior) Name() string { return AIBehaviorDefensive }
+
+func randRange(rng *rand.Rand, lo, hi float64) float64 {
     if hi <= lo {
         return lo
     }
-    return lo + rand.Float64()*(hi-lo)
+    return lo + rng.Float64()*(hi-lo)
 }
 
 func projectMaxHeatForWaypoints(h *HeatComponent, startPos Vec2, waypoints []RouteWaypoint) float64 {
@@ -266,6 +269,8 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
 		shipSpeed = ctx.SelfMovement.MaxSpeed
 	}
 
+	hitRadius := shipHitRadius(ctx.SelfShip)
+
 	steer := Vec2{}
 
 	// Missile avoidance takes precedence
@@ -290,7 +295,7 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
 		if threat.TimeToClosest > 0 && threat.TimeToClosest < 4 {
 			weight += 2.0
 		}
-		if threat.DistanceAtClosest <= MissileHitRadius*3 {
+		if threat.DistanceAtClosest <= hitRadius*3 {
 			weight += 8.0
 		}
 		if weight == 0 {
@@ -321,7 +326,7 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
 			toShip := unitOrZero(pos.Sub(threat.Pos))
 			missileDir := unitOrZero(threat.Vel)
 			if toShip.Len() > 1e-3 && missileDir.Len() > 1e-3 {
-				if toShip.Dot(missileDir) > 0.85 && dist <= 1500 && threat.DistanceAtClosest <= MissileHitRadius*3 {
+				if toShip.Dot(missileDir) > 0.85 && dist <= 1500 && threat.DistanceAtClosest <= hitRadius*3 {
 					imminentThreat = &ctx.Threats[i]
 				}
 			}
@@ -401,12 +406,12 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
 	b.lastPlanDir = direction
 
     if imminentThreat != nil {
-        route := planDirectMissileRoute(pos, *imminentThreat, worldW, worldH, shipSpeed)
+        route := planDirectMissileRoute(pos, *imminentThreat, worldW, worldH, shipSpeed, ctx.Room.simRand())
         commands = append(commands, CommandClearShipRoute())
         // When dodging, keep as-is (threat escape has priority)
         commands = append(commands, CommandSetShipRoute(route))
     } else if closeThreat != nil && closestThreatDist <= 1400 {
-        route := planGeneralThreatRoute(pos, *closeThreat, worldW, worldH, shipSpeed)
+        route := planGeneralThreatRoute(pos, *closeThreat, worldW, worldH, shipSpeed, ctx.Room.simRand())
         commands = append(commands, CommandSetShipRoute(route))
     } else {
         heat := ctx.SelfHeat
@@ -428,7 +433,7 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
         if needPlan {
             // Choose route by phase
             if heat != nil {
-                shipCap := math.Min(heat.P.Max, heat.P.OverheatAt*shipHeatCapRatio)
+                shipCap := math.Min(heat.P.Max, ctx.Difficulty.shipHeatCap(heat))
                 minSpeed := math.Max(heat.P.MarkerSpeed*0.7, 60)
                 switch b.phase {
                 case aiPhaseAttack:
@@ -437,21 +442,21 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
                     if nearest != nil && nearest.Transform != nil {
                         toward = unitOrZero(nearest.Transform.Pos.Sub(pos))
                     }
-                    newRoute := planHeatBuildRoute(pos, toward, worldW, worldH, shipSpeed)
+                    newRoute := planHeatBuildRoute(pos, toward, worldW, worldH, shipSpeed, ctx.Room.simRand())
                     newRoute = clampShipWaypointsToHeat(heat, pos, newRoute, shipCap, minSpeed)
                     // If still too hot, fallback to cooldown
                     if projectMaxHeatForWaypoints(heat, pos, newRoute) > shipCap {
-                        newRoute = planHeatCooldownRoute(pos, toward, worldW, worldH, heat.P.MarkerSpeed)
+                        newRoute = planHeatCooldownRoute(pos, toward, worldW, worldH, heat.P.MarkerSpeed, ctx.Room.simRand())
                     }
                     commands = append(commands, CommandSetShipRoute(newRoute))
                     // Initialize or maintain attack phase timer
                     if b.phaseUntil <= ctx.Now {
-                        b.phaseUntil = ctx.Now + randRange(phaseAttackMinS, phaseAttackMaxS)
+                        b.phaseUntil = ctx.Now + randRange(ctx.Room.simRand(), phaseAttackMinS, phaseAttackMaxS)
                     }
                     // Transition to Cool&Fire when close to target or timer elapsed
                     if (nearest != nil && nearest.Transform != nil && nearest.Transform.Pos.Sub(pos).Len() <= closeRangePX) || ctx.Now >= b.phaseUntil {
                         b.phase = aiPhaseCoolFire
-                        b.phaseUntil = ctx.Now + randRange(phaseCoolMinS, phaseCoolMaxS)
+                        b.phaseUntil = ctx.Now + randRange(ctx.Room.simRand(), phaseCoolMinS, phaseCoolMaxS)
                     }
 
                 case aiPhaseCoolFire:
@@ -459,15 +464,15 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
                     if nearest != nil && nearest.Transform != nil {
                         toward = unitOrZero(nearest.Transform.Pos.Sub(pos))
                     }
-                    newRoute := planHeatCooldownRoute(pos, toward, worldW, worldH, heat.P.MarkerSpeed)
+                    newRoute := planHeatCooldownRoute(pos, toward, worldW, worldH, heat.P.MarkerSpeed, ctx.Room.simRand())
                     newRoute = clampShipWaypointsToHeat(heat, pos, newRoute, shipCap, minSpeed)
                     commands = append(commands, CommandSetShipRoute(newRoute))
                     if b.phaseUntil <= ctx.Now {
-                        b.phaseUntil = ctx.Now + randRange(phaseCoolMinS, phaseCoolMaxS)
+                        b.phaseUntil = ctx.Now + randRange(ctx.Room.simRand(), phaseCoolMinS, phaseCoolMaxS)
                     }
                     if ctx.Now >= b.phaseUntil {
                         b.phase = aiPhaseEvade
-                        b.phaseUntil = ctx.Now + randRange(phaseEvadeMinS, phaseEvadeMaxS)
+                        b.phaseUntil = ctx.Now + randRange(ctx.Room.simRand(), phaseEvadeMinS, phaseEvadeMaxS)
                     }
 
                 case aiPhaseEvade:
@@ -475,23 +480,23 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
                     if nearest != nil && nearest.Transform != nil {
                         away = unitOrZero(pos.Sub(nearest.Transform.Pos))
                     }
-                    newRoute := planHeatBuildRoute(pos, away, worldW, worldH, shipSpeed)
+                    newRoute := planHeatBuildRoute(pos, away, worldW, worldH, shipSpeed, ctx.Room.simRand())
                     newRoute = clampShipWaypointsToHeat(heat, pos, newRoute, shipCap, minSpeed)
                     if projectMaxHeatForWaypoints(heat, pos, newRoute) > shipCap {
-                        newRoute = planHeatCooldownRoute(pos, away, worldW, worldH, heat.P.MarkerSpeed)
+                        newRoute = planHeatCooldownRoute(pos, away, worldW, worldH, heat.P.MarkerSpeed, ctx.Room.simRand())
                     }
                     commands = append(commands, CommandSetShipRoute(newRoute))
                     if b.phaseUntil <= ctx.Now {
-                        b.phaseUntil = ctx.Now + randRange(phaseEvadeMinS, phaseEvadeMaxS)
+                        b.phaseUntil = ctx.Now + randRange(ctx.Room.simRand(), phaseEvadeMinS, phaseEvadeMaxS)
                     }
                     if ctx.Now >= b.phaseUntil {
                         b.phase = aiPhaseAttack
-                        b.phaseUntil = ctx.Now + randRange(phaseAttackMinS, phaseAttackMaxS)
+                        b.phaseUntil = ctx.Now + randRange(ctx.Room.simRand(), phaseAttackMinS, phaseAttackMaxS)
                     }
                 }
             } else {
                 // No heat data: default to build route
-                newRoute := planHeatBuildRoute(pos, direction, worldW, worldH, shipSpeed)
+                newRoute := planHeatBuildRoute(pos, direction, worldW, worldH, shipSpeed, ctx.Room.simRand())
                 commands = append(commands, CommandSetShipRoute(newRoute))
             }
         }
@@ -544,7 +549,7 @@ func (b *DefensiveBehavior) Plan(ctx *AIContext) []AICommand {
 		dirToOpponent := unitOrZero(nearest.Transform.Pos.Sub(pos))
 		leadTime := rel.Len() / math.Max(cfg.Speed, 1)
 		leadTime = Clamp(leadTime, 0.4, 3.5)
-		leadPoint := oppPos.Add(oppVel.Scale(leadTime))
+		leadPoint := oppPos.Add(oppVel.Scale(leadTime)).Add(ctx.Difficulty.aimOffset(ctx.Room.simRand()))
 
 		startAccel := pos.Add(dirToOpponent.Scale(250))
 		tail := leadPoint.Add(oppVel.Scale(0.5 * leadTime))
@@ -0,0 +1,149 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// AIDifficulty tunes how well a bot plays, independent of its behavior.
+type AIDifficulty struct {
+	ID            string
+	DisplayName   string
+	PlanInterval  float64 // Seconds between plans
+	ReactionNoise float64 // Max random seconds added to each plan interval
+	HeatCapRatio  float64 // Fraction of OverheatAt the bot is willing to reach
+	AimError      float64 // Max random missile aim offset in world units
+	CheatLevel    float64 // See AIAgent.CheatLevel
+}
+
+// DefaultAIDifficultyID is the profile used when none is requested.
+const DefaultAIDifficultyID = "normal"
+
+// AIDifficultyRegistry holds the bot difficulty profiles.
+var AIDifficultyRegistry = map[string]AIDifficulty{
+	"easy": {
+		ID:            "easy",
+		DisplayName:   "Easy",
+		PlanInterval:  0.6,
+		ReactionNoise: 0.5,
+		HeatCapRatio:  1.0,
+		AimError:      300,
+	},
+	// Bots without a profile play like this, so it must stay the baseline:
+	// exact aim and the fixed plan interval.
+	"normal": {
+		ID:           "normal",
+		DisplayName:  "Normal",
+		PlanInterval: 0.2,
+		HeatCapRatio: shipHeatCapRatio,
+	},
+	"hard": {
+		ID:           "hard",
+		DisplayName:  "Hard",
+		PlanInterval: 0.15,
+		HeatCapRatio: 0.85,
+	},
+	"ace": {
+		ID:           "ace",
+		DisplayName:  "Ace",
+		PlanInterval: Dt * 2,
+		HeatCapRatio: 0.85,
+		CheatLevel:   0.5,
+	},
+}
+
+// GetAIDifficulty retrieves a difficulty profile by ID.
+func GetAIDifficulty(id string) (*AIDifficulty, error) {
+	profile, ok := AIDifficultyRegistry[id]
+	if !ok {
+		return nil, fmt.Errorf("ai difficulty not found: %s", id)
+	}
+	return &profile, nil
+}
+
+// AIDifficultyIDs returns the registered difficulty IDs in sorted order.
+func AIDifficultyIDs() []string {
+	ids := make([]string, 0, len(AIDifficultyRegistry))
+	for id := range AIDifficultyRegistry {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// shipHeatCap returns the heat level the bot plans its routes under.
+func (d AIDifficulty) shipHeatCap(h *HeatComponent) float64 {
+	ratio := d.HeatCapRatio
+	if ratio <= 0 {
+		ratio = shipHeatCapRatio
+	}
+	return h.P.OverheatAt * ratio
+}
+
+// aimOffset returns a random missile aim error within the profile's bounds.
+func (d AIDifficulty) aimOffset(rng *rand.Rand) Vec2 {
+	if d.AimError <= 0 {
+		return Vec2{}
+	}
+	return Vec2{X: (rng.Float64()*2 - 1) * d.AimError, Y: (rng.Float64()*2 - 1) * d.AimError}
+}
+
+// applyDifficulty tunes an agent to the given profile.
+func (a *AIAgent) applyDifficulty(d AIDifficulty) {
+	a.Difficulty = d
+	a.CheatLevel = d.CheatLevel
+	if d.PlanInterval >= Dt {
+		a.PlanInterval = d.PlanInterval
+	}
+}
+
+// BotSpawnOptions configures a bot added with AddConfiguredBotLocked. Empty
+// fields fall back to the defensive behavior, normal difficulty and the
+// default hull.
+type BotSpawnOptions struct {
+	Name       string
+	Behavior   string
+	Difficulty string
+	Hull       string
+	Pos        Vec2
+}
+
+// AddConfiguredBotLocked validates the options and adds a bot to the room.
+// Callers must hold r.Mu.
+func (r *Room) AddConfiguredBotLocked(opts BotSpawnOptions) (*Player, error) {
+	if opts.Behavior == "" {
+		opts.Behavior = AIBehaviorDefensive
+	}
+	if opts.Difficulty == "" {
+		opts.Difficulty = DefaultAIDifficultyID
+	}
+	behavior, err := NewAIBehavior(opts.Behavior)
+	if err != nil {
+		return nil, err
+	}
+	difficulty, err := GetAIDifficulty(opts.Difficulty)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Hull != "" {
+		if _, err := GetHull(opts.Hull); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Name == "" {
+		opts.Name = fmt.Sprintf("%s AI (%s)", difficulty.DisplayName, opts.Behavior)
+	}
+	pos := clampPointToWorldBounds(opts.Pos, r.WorldWidth, r.WorldHeight)
+
+	bot := r.addBotUnlocked(opts.Name, behavior, pos)
+	if opts.Hull != "" {
+		if err := r.SetPlayerHullLocked(bot, opts.Hull); err != nil {
+			return nil, err
+		}
+	}
+	if agent := r.Bots[bot.ID]; agent != nil {
+		agent.applyDifficulty(*difficulty)
+	}
+	return bot, nil
+}
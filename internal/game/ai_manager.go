@@ -1,11 +1,20 @@
 package game
 
+import "sort"
+
 func (r *Room) updateAI() {
 	if len(r.Bots) == 0 {
 		return
 	}
 	now := r.Now
-	for id, agent := range r.Bots {
+	// Plan in ID order so bots draw from the room's random source in turn.
+	ids := make([]string, 0, len(r.Bots))
+	for id := range r.Bots {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		agent := r.Bots[id]
 		if agent == nil || agent.Behavior == nil {
 			continue
 		}
@@ -22,9 +31,10 @@ func (r *Room) updateAI() {
 		if !agent.ready(now) {
 			continue
 		}
-		ctx := buildAIContext(r, player)
+		ctx := buildAIContext(r, player, agent.CheatLevel)
+		ctx.Difficulty = agent.Difficulty
 		cmds := agent.Behavior.Plan(ctx)
-		agent.planned(now)
+		agent.planned(now, r.simRand())
 		for _, cmd := range cmds {
 			if cmd == nil {
 				continue
@@ -0,0 +1,207 @@
+package game
+
+import "math"
+
+// Tunables for the offensive behavior
+const (
+	offensiveEngageMin   = 1400.0 // Preferred minimum standoff distance
+	offensiveEngageMax   = 2600.0 // Close in when the target is farther than this
+	offensiveFireRange   = 3800.0 // Max estimated distance to commit a missile
+	offensiveEvadeRadius = 3.0    // Multiple of hit radius that triggers evasion
+	offensiveMaxLead     = 20.0   // Seconds; longer intercepts are not attempted
+)
+
+// aiTrack is the latest perceived snapshot of an opponent together with a
+// velocity estimate refined from successive observations.
+type aiTrack struct {
+	Pos    Vec2
+	Vel    Vec2
+	SeenAt float64
+}
+
+// OffensiveBehavior hunts the nearest opponent. Because bots only see
+// light-delayed snapshots, it extrapolates each observation to an estimate of
+// the opponent's present position and fires missiles at the predicted
+// intercept point.
+type OffensiveBehavior struct {
+	tracks map[EntityID]aiTrack
+}
+
+func NewOffensiveBehavior() *OffensiveBehavior {
+	return &OffensiveBehavior{tracks: make(map[EntityID]aiTrack)}
+}
+
+// Name implements the optional named-behavior interface used by the registry.
+func (b *OffensiveBehavior) Name() string { return AIBehaviorOffensive }
+
+// observe records a perceived opponent and returns its updated track. When a
+// newer snapshot arrives, the velocity is smoothed against the displacement
+// since the previous observation.
+func (b *OffensiveBehavior) observe(op AIShipInfo) aiTrack {
+	track := aiTrack{Pos: op.Transform.Pos, Vel: op.Transform.Vel, SeenAt: op.SeenAt}
+	if prev, ok := b.tracks[op.Entity]; ok {
+		if dt := op.SeenAt - prev.SeenAt; dt > 0.25 {
+			observed := op.Transform.Pos.Sub(prev.Pos).Scale(1 / dt)
+			track.Vel = track.Vel.Scale(0.7).Add(observed.Scale(0.3))
+		} else if dt <= 0 {
+			track = prev
+		}
+	}
+	b.tracks[op.Entity] = track
+	return track
+}
+
+// estimatePresent extrapolates a track from its emission time to now.
+func estimatePresent(track aiTrack, now float64) Vec2 {
+	age := math.Max(now-track.SeenAt, 0)
+	return track.Pos.Add(track.Vel.Scale(age))
+}
+
+// interceptPoint solves for where a projectile fired from origin at the given
+// speed meets a target moving at constant velocity. It returns the meeting
+// point, the time to reach it, and false if no intercept exists.
+func interceptPoint(origin, targetPos, targetVel Vec2, speed float64) (Vec2, float64, bool) {
+	rel := targetPos.Sub(origin)
+	a := targetVel.Dot(targetVel) - speed*speed
+	bq := 2 * rel.Dot(targetVel)
+	c := rel.Dot(rel)
+
+	var t float64
+	if math.Abs(a) < 1e-9 {
+		if math.Abs(bq) < 1e-9 {
+			return Vec2{}, 0, false
+		}
+		t = -c / bq
+	} else {
+		disc := bq*bq - 4*a*c
+		if disc < 0 {
+			return Vec2{}, 0, false
+		}
+		sq := math.Sqrt(disc)
+		t1 := (-bq - sq) / (2 * a)
+		t2 := (-bq + sq) / (2 * a)
+		t = math.Min(t1, t2)
+		if t < 0 {
+			t = math.Max(t1, t2)
+		}
+	}
+	if t < 0 || math.IsNaN(t) {
+		return Vec2{}, 0, false
+	}
+	return targetPos.Add(targetVel.Scale(t)), t, true
+}
+
+// offensiveMissileConfig trades missile speed against seeker radius: distant
+// targets get a fast missile with a wide seeker to absorb prediction error,
+// close ones a slower, cooler missile with a tight seeker.
+func offensiveMissileConfig(dist, shipSpeed float64) MissileConfig {
+	switch {
+	case dist > 2800:
+		return SanitizeMissileConfig(MissileConfig{Speed: shipSpeed * 0.9, AgroRadius: 1200})
+	case dist > 1600:
+		return SanitizeMissileConfig(MissileConfig{Speed: shipSpeed * 0.75, AgroRadius: 800})
+	default:
+		return SanitizeMissileConfig(MissileConfig{Speed: shipSpeed * 0.55, AgroRadius: 450})
+	}
+}
+
+func (b *OffensiveBehavior) Plan(ctx *AIContext) []AICommand {
+	if ctx == nil || ctx.Room == nil || ctx.SelfTransform == nil {
+		return nil
+	}
+	if b.tracks == nil {
+		b.tracks = make(map[EntityID]aiTrack)
+	}
+	// Forget ships that have left the world so tracks don't pile up.
+	for id := range b.tracks {
+		if !ctx.Room.World.Exists(id) {
+			delete(b.tracks, id)
+		}
+	}
+	pos := ctx.SelfTransform.Pos
+	shipSpeed := ShipMaxSpeed
+	if ctx.SelfMovement != nil && ctx.SelfMovement.MaxSpeed > 0 {
+		shipSpeed = ctx.SelfMovement.MaxSpeed
+	}
+	worldW, worldH := ctx.Room.WorldWidth, ctx.Room.WorldHeight
+	hitRadius := shipHitRadius(ctx.SelfShip)
+
+	var commands []AICommand
+
+	// Dodge a missile that is on course to hit us; otherwise keep attacking.
+	for _, threat := range ctx.Threats {
+		if threat.TimeToClosest > 0 && threat.TimeToClosest < 4 && threat.DistanceAtClosest <= hitRadius*offensiveEvadeRadius {
+			route := planDirectMissileRoute(pos, threat, worldW, worldH, shipSpeed, ctx.Room.simRand())
+			if ctx.SelfHeat != nil {
+				route = clampShipWaypointsToHeat(ctx.SelfHeat, pos, route, ctx.Difficulty.shipHeatCap(ctx.SelfHeat), ctx.SelfHeat.P.MarkerSpeed*0.5)
+			}
+			return append(commands, CommandSetShipRoute(route))
+		}
+	}
+
+	// Pick the opponent whose estimated present position is nearest.
+	var target *aiTrack
+	var targetPos Vec2
+	targetDist := math.MaxFloat64
+	for _, op := range ctx.Opponents {
+		if op.Transform == nil {
+			continue
+		}
+		track := b.observe(op)
+		present := estimatePresent(track, ctx.Now)
+		if d := present.Sub(pos).Len(); d < targetDist {
+			t := track
+			target, targetPos, targetDist = &t, present, d
+		}
+	}
+
+	ammo := aiMissileAmmo(ctx.Self)
+	if ammo < 3 {
+		commands = append(commands, CommandDagStart("craft.missile.basic"))
+	}
+
+	if target == nil {
+		return commands
+	}
+
+	// Maneuver toward the engagement band around the estimated position,
+	// cruising at the heat marker so we stay fit to fight.
+	toTarget := unitOrZero(targetPos.Sub(pos))
+	if targetDist > offensiveEngageMax || targetDist < offensiveEngageMin {
+		dir := toTarget
+		if targetDist < offensiveEngageMin {
+			dir = toTarget.Scale(-1)
+		}
+		dir = unitOrZero(dir.Add(orthogonal(dir).Scale(randRange(ctx.Room.simRand(), -0.3, 0.3))))
+		speed := shipSpeed
+		if ctx.SelfHeat != nil {
+			speed = Clamp(ctx.SelfHeat.P.MarkerSpeed*1.1, shipSpeed*0.4, shipSpeed)
+		}
+		dest := clampPlanDestination(pos, dir, randRange(ctx.Room.simRand(), 500, 900), worldW, worldH)
+		route := []RouteWaypoint{{Pos: dest, Speed: speed}}
+		if ctx.SelfHeat != nil {
+			route = clampShipWaypointsToHeat(ctx.SelfHeat, pos, route, ctx.Difficulty.shipHeatCap(ctx.SelfHeat), ctx.SelfHeat.P.MarkerSpeed*0.5)
+		}
+		commands = append(commands, CommandSetShipRoute(route))
+	}
+
+	if ammo == 0 || !ctx.MissileReady() || targetDist > offensiveFireRange {
+		return commands
+	}
+
+	cfg := offensiveMissileConfig(targetDist, shipSpeed)
+	aim, leadTime, ok := interceptPoint(pos, targetPos, target.Vel, cfg.Speed)
+	if !ok || leadTime > offensiveMaxLead {
+		aim = targetPos
+		leadTime = targetDist / math.Max(cfg.Speed, 1)
+	}
+	aim = aim.Add(ctx.Difficulty.aimOffset(ctx.Room.simRand()))
+	tail := aim.Add(target.Vel.Scale(0.5 * math.Min(leadTime, 6)))
+	waypoints := []RouteWaypoint{
+		{Pos: clampPointToWorldBounds(aim, worldW, worldH), Speed: cfg.Speed},
+		{Pos: clampPointToWorldBounds(tail, worldW, worldH), Speed: cfg.Speed},
+	}
+	missileCap := cfg.HeatParams.OverheatAt * missileHeatCapRatio
+	waypoints = clampMissileWaypointsToHeat(cfg.HeatParams, pos, waypoints, missileCap, MissileMinSpeed, cfg.Speed)
+	return append(commands, CommandLaunchMissile(cfg, waypoints))
+}
@@ -0,0 +1,66 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Built-in AI behavior names.
+const (
+	AIBehaviorDefensive = "defensive"
+	AIBehaviorOffensive = "offensive"
+)
+
+// AIBehaviorFactory creates a fresh behavior instance with its own state.
+type AIBehaviorFactory func() AIBehavior
+
+// aiBehaviorMu guards AIBehaviorRegistry, which bot trees loaded at runtime
+// can add to while rooms create bots.
+var aiBehaviorMu sync.RWMutex
+
+// AIBehaviorRegistry holds all named bot behaviors. Use RegisterAIBehavior
+// and NewAIBehavior rather than touching it directly.
+var AIBehaviorRegistry = map[string]AIBehaviorFactory{
+	AIBehaviorDefensive: func() AIBehavior { return NewDefensiveBehavior() },
+	AIBehaviorOffensive: func() AIBehavior { return NewOffensiveBehavior() },
+}
+
+// RegisterAIBehavior adds or replaces a named behavior factory.
+func RegisterAIBehavior(name string, factory AIBehaviorFactory) {
+	aiBehaviorMu.Lock()
+	defer aiBehaviorMu.Unlock()
+	AIBehaviorRegistry[name] = factory
+}
+
+// NewAIBehavior creates a behavior by name.
+func NewAIBehavior(name string) (AIBehavior, error) {
+	aiBehaviorMu.RLock()
+	factory, ok := AIBehaviorRegistry[name]
+	aiBehaviorMu.RUnlock()
+	if !ok || factory == nil {
+		return nil, fmt.Errorf("ai behavior not found: %s", name)
+	}
+	return factory(), nil
+}
+
+// AIBehaviorNames returns the registered behavior names in sorted order.
+func AIBehaviorNames() []string {
+	aiBehaviorMu.RLock()
+	defer aiBehaviorMu.RUnlock()
+	names := make([]string, 0, len(AIBehaviorRegistry))
+	for name := range AIBehaviorRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// behaviorName returns the registry name of a behavior, defaulting to the
+// defensive behavior for types that do not report one.
+func behaviorName(b AIBehavior) string {
+	if named, ok := b.(interface{ Name() string }); ok {
+		return named.Name()
+	}
+	return AIBehaviorDefensive
+}
@@ -0,0 +1,240 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+// movingShipRoom spawns a bot and a distant opponent whose history shows it
+// flying east, so its perceived position lags behind its true position.
+func movingShipRoom() (*Room, *Player, EntityID) {
+	room := newCombatTestRoom()
+	bot := &Player{ID: "bot", IsBot: true}
+	room.Players[bot.ID] = bot
+	bot.Ship = room.SpawnShip(bot.ID, Vec2{X: 1000, Y: 1000})
+
+	room.Players["enemy"] = &Player{ID: "enemy"}
+	enemy := room.SpawnShip("enemy", Vec2{X: 4000, Y: 1000})
+	hist := room.World.HistoryComponent(enemy).History
+	for step := 1; step <= 200; step++ {
+		now := float64(step) * Dt
+		pos := Vec2{X: 4000 + 100*now, Y: 1000}
+		room.World.Transform(enemy).Pos = pos
+		room.World.Transform(enemy).Vel = Vec2{X: 100}
+		hist.push(Snapshot{T: now, Pos: pos, Vel: Vec2{X: 100}})
+		room.World.HistoryComponent(bot.Ship).History.push(Snapshot{T: now, Pos: Vec2{X: 1000, Y: 1000}})
+		room.Now = now
+	}
+	return room, bot, enemy
+}
+
+func TestAIContextUsesPerceivedOpponents(t *testing.T) {
+	room, bot, enemy := movingShipRoom()
+	truePos := room.World.Transform(enemy).Pos
+
+	ctx := buildAIContext(room, bot, 0)
+	if len(ctx.Opponents) != 1 {
+		t.Fatalf("expected one opponent, got %d", len(ctx.Opponents))
+	}
+	seen := ctx.Opponents[0]
+	if seen.Transform == room.World.Transform(enemy) {
+		t.Fatal("AI context must not expose the live transform")
+	}
+	lag := room.Now - seen.SeenAt
+	if lag < 4 {
+		t.Fatalf("expected a light delay of several seconds, got %.2f", lag)
+	}
+	if seen.Transform.Pos.X >= truePos.X-100 {
+		t.Fatalf("expected perceived position to lag the true position %.1f, got %.1f", truePos.X, seen.Transform.Pos.X)
+	}
+
+	if seen.Ship != nil || seen.Movement != nil {
+		t.Fatal("AI context must not expose the opponent's live ship state")
+	}
+
+	cheat := buildAIContext(room, bot, 1)
+	if got := cheat.Opponents[0].Transform.Pos; math.Abs(got.X-truePos.X) > 1e-6 {
+		t.Fatalf("expected full cheat level to see the true position %.1f, got %.1f", truePos.X, got.X)
+	}
+	if cheat.Opponents[0].Ship == nil {
+		t.Fatal("expected a cheating bot to see the opponent's ship state")
+	}
+	if partial := buildAIContext(room, bot, 0.5).Opponents[0]; partial.Ship != nil || partial.Movement != nil {
+		t.Fatal("expected a partial cheat to blend positions without live ship state")
+	}
+}
+
+func TestPerceivedHeadingDecidesTargeting(t *testing.T) {
+	bot := Vec2{X: 1000, Y: 1000}
+	toward := Snapshot{Pos: Vec2{X: 3000, Y: 1100}, Vel: Vec2{X: -150}}
+	if !perceivedHeadingAt(toward, bot) {
+		t.Fatal("expected a missile flying at the bot to count as targeting it")
+	}
+	across := Snapshot{Pos: Vec2{X: 3000, Y: 1000}, Vel: Vec2{Y: 150}}
+	if perceivedHeadingAt(across, bot) {
+		t.Fatal("expected a missile flying across the bot's bearing not to count as targeting it")
+	}
+}
+
+func TestAIContextHidesUnseenThreats(t *testing.T) {
+	room, bot, _ := movingShipRoom()
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500})
+	room.LaunchMissile("enemy", 0, cfg, []RouteWaypoint{{Pos: Vec2{X: 1000, Y: 1000}}}, Vec2{X: 3000, Y: 1000}, Vec2{})
+
+	if ctx := buildAIContext(room, bot, 0); len(ctx.Threats) != 0 {
+		t.Fatalf("a missile launched this tick should not be visible yet, got %d threats", len(ctx.Threats))
+	}
+	if ctx := buildAIContext(room, bot, 1); len(ctx.Threats) != 1 {
+		t.Fatalf("a cheating bot should see the missile immediately, got %d threats", len(ctx.Threats))
+	}
+}
+
+func TestInterceptPointLeadsMovingTarget(t *testing.T) {
+	origin := Vec2{}
+	targetPos := Vec2{X: 1000}
+	targetVel := Vec2{Y: 100}
+
+	aim, tof, ok := interceptPoint(origin, targetPos, targetVel, 200)
+	if !ok {
+		t.Fatal("expected an intercept solution")
+	}
+	if math.Abs(aim.Len()-200*tof) > 1e-6 {
+		t.Fatalf("missile should reach the aim point at time %.2f, aim %+v", tof, aim)
+	}
+	if math.Abs(aim.Sub(targetPos.Add(targetVel.Scale(tof))).Len()) > 1e-6 {
+		t.Fatalf("aim point should be the target's position at impact, got %+v", aim)
+	}
+	if _, _, ok := interceptPoint(origin, targetPos, Vec2{X: 300}, 200); ok {
+		t.Fatal("a faster receding target cannot be intercepted")
+	}
+}
+
+func TestOffensiveBehaviorLeadsPerceivedTarget(t *testing.T) {
+	room, bot, _ := movingShipRoom()
+	bot.EnsureInventory()
+	bot.Inventory.AddItem("missile", "basic", 80, 5)
+	// Move the bot within firing range of the opponent.
+	room.World.Transform(bot.Ship).Pos = Vec2{X: 3000, Y: 1500}
+
+	behavior := NewOffensiveBehavior()
+	ctx := buildAIContext(room, bot, 0)
+	if len(ctx.Opponents) != 1 {
+		t.Fatalf("expected one opponent, got %d", len(ctx.Opponents))
+	}
+	seen := ctx.Opponents[0]
+
+	var launch *aiCommandLaunchMissile
+	for _, cmd := range behavior.Plan(ctx) {
+		if c, ok := cmd.(aiCommandLaunchMissile); ok {
+			launch = &c
+		}
+	}
+	if launch == nil {
+		t.Fatal("expected the offensive behavior to launch a missile")
+	}
+	aim := launch.waypoints[0].Pos
+	if aim.X <= seen.Transform.Pos.X+200 {
+		t.Fatalf("expected aim %.1f to lead the perceived position %.1f", aim.X, seen.Transform.Pos.X)
+	}
+	if launch.config.HeatParams.OverheatAt <= 0 {
+		t.Fatal("expected a sanitized missile config")
+	}
+}
+
+func TestOffensiveBehaviorNeedsRoom(t *testing.T) {
+	room, bot, _ := movingShipRoom()
+	ctx := buildAIContext(room, bot, 0)
+	ctx.Room = nil
+	if cmds := NewOffensiveBehavior().Plan(ctx); cmds != nil {
+		t.Fatalf("expected no plan without a room, got %v", cmds)
+	}
+}
+
+func TestOffensiveBehaviorForgetsRemovedShips(t *testing.T) {
+	room, bot, enemy := movingShipRoom()
+	behavior := NewOffensiveBehavior()
+	behavior.Plan(buildAIContext(room, bot, 0))
+	if _, ok := behavior.tracks[enemy]; !ok {
+		t.Fatal("expected the opponent to be tracked")
+	}
+
+	room.World.RemoveEntity(enemy)
+	behavior.Plan(buildAIContext(room, bot, 0))
+	if len(behavior.tracks) != 0 {
+		t.Fatalf("expected the removed ship's track to be dropped, got %d tracks", len(behavior.tracks))
+	}
+}
+
+func TestRespawnKeepsRegisteredBehavior(t *testing.T) {
+	room := newCombatTestRoom()
+	bot := room.addBotUnlocked("Hunter", NewOffensiveBehavior(), Vec2{X: 1000, Y: 1000})
+	agent := room.Bots[bot.ID]
+	if agent.BehaviorName != AIBehaviorOffensive {
+		t.Fatalf("expected offensive behavior name, got %q", agent.BehaviorName)
+	}
+
+	room.handleShipDestruction(bot.Ship, "enemy")
+
+	if _, ok := agent.Behavior.(*OffensiveBehavior); !ok {
+		t.Fatalf("expected respawned bot to stay offensive, got %T", agent.Behavior)
+	}
+	if _, err := NewAIBehavior("berserker"); err == nil {
+		t.Fatal("expected error for unknown behavior")
+	}
+}
+
+func TestDefaultDifficultyKeepsBaselineBots(t *testing.T) {
+	room := newCombatTestRoom()
+	bot := room.addBotUnlocked("Plain", NewDefensiveBehavior(), Vec2{X: 1000, Y: 1000})
+	agent := room.Bots[bot.ID]
+	if offset := agent.Difficulty.aimOffset(room.simRand()); offset != (Vec2{}) {
+		t.Fatalf("expected exact aim without a difficulty, got %+v", offset)
+	}
+	agent.planned(10, room.simRand())
+	if agent.nextPlanAt != 10+agent.PlanInterval {
+		t.Fatalf("expected a fixed plan interval without a difficulty, got %.3f", agent.nextPlanAt-10)
+	}
+	if offset := AIDifficultyRegistry["easy"].aimOffset(room.simRand()); offset == (Vec2{}) {
+		t.Fatal("expected easy bots to miss their aim")
+	}
+}
+
+func TestAddConfiguredBotAppliesOptions(t *testing.T) {
+	room := newCombatTestRoom()
+	bot, err := room.AddConfiguredBotLocked(BotSpawnOptions{
+		Behavior:   AIBehaviorOffensive,
+		Difficulty: "hard",
+		Hull:       "destroyer",
+		Pos:        Vec2{X: 2000, Y: 1500},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	agent := room.Bots[bot.ID]
+	if _, ok := agent.Behavior.(*OffensiveBehavior); !ok {
+		t.Fatalf("expected offensive behavior, got %T", agent.Behavior)
+	}
+	hard := AIDifficultyRegistry["hard"]
+	if agent.Difficulty.ID != "hard" || agent.PlanInterval != hard.PlanInterval {
+		t.Fatalf("expected hard difficulty, got %+v", agent.Difficulty)
+	}
+	if hull := room.World.ShipData(bot.Ship).Hull; hull != "destroyer" {
+		t.Fatalf("expected destroyer hull, got %s", hull)
+	}
+	if pos := room.World.Transform(bot.Ship).Pos; pos != (Vec2{X: 2000, Y: 1500}) {
+		t.Fatalf("expected requested spawn position, got %+v", pos)
+	}
+
+	for _, opts := range []BotSpawnOptions{
+		{Behavior: "berserker"},
+		{Difficulty: "impossible"},
+		{Hull: "battleship"},
+	} {
+		if _, err := room.AddConfiguredBotLocked(opts); err == nil {
+			t.Fatalf("expected error for options %+v", opts)
+		}
+	}
+	if len(room.Bots) != 1 {
+		t.Fatalf("invalid options should not add bots, got %d", len(room.Bots))
+	}
+}
@@ -1,6 +1,8 @@
 package game
 
 import (
+    "math/rand"
+
     dagpkg "LightSpeedDuel/internal/dag"
 )
 
@@ -88,7 +90,7 @@ func (c aiCommandLaunchMissile) apply(r *Room, p *Player) {
         missileID := r.LaunchMissile(p.ID, p.Ship, cfg, c.waypoints, tr.Pos, tr.Vel)
         if missileID != 0 {
             speed := tr.Vel.Len()
-            p.MissileReadyAt = now + MissileCooldownForSpeed(speed)
+            p.MissileReadyAt = now + r.MissileCooldownFor(p, speed)
         }
     }
 }
@@ -138,6 +140,14 @@ type AIAgent struct {
 	PlayerID     string
 	Behavior     AIBehavior
 	PlanInterval float64
+	// CheatLevel blends the light-delayed view the bot perceives (0) toward
+	// the true present state of the world (1). Opponents' live ship state is
+	// only exposed at 1; in between, a missile's real target is known that
+	// share of the time.
+	CheatLevel float64
+	// BehaviorName is the registry name used to rebuild the behavior on respawn.
+	BehaviorName string
+	Difficulty   AIDifficulty
 	nextPlanAt   float64
 }
 
@@ -146,30 +156,42 @@ func NewAIAgent(playerID string, behavior AIBehavior) *AIAgent {
 	if interval < Dt {
 		interval = Dt
 	}
-	return &AIAgent{PlayerID: playerID, Behavior: behavior, PlanInterval: interval}
+	return &AIAgent{
+		PlayerID:     playerID,
+		Behavior:     behavior,
+		PlanInterval: interval,
+		BehaviorName: behaviorName(behavior),
+		Difficulty:   AIDifficultyRegistry[DefaultAIDifficultyID],
+	}
 }
 
 func (a *AIAgent) ready(now float64) bool {
 	return now >= a.nextPlanAt
 }
 
-func (a *AIAgent) planned(now float64) {
+func (a *AIAgent) planned(now float64, rng *rand.Rand) {
 	a.nextPlanAt = now + a.PlanInterval
+	if a.Difficulty.ReactionNoise > 0 {
+		a.nextPlanAt += rng.Float64() * a.Difficulty.ReactionNoise
+	}
 }
 
 type AIShipInfo struct {
 	Player    *Player
 	Entity    EntityID
-	Transform *Transform
-	Movement  *Movement
+	Transform *Transform     // Perceived position and velocity, not the live transform
+	Movement  *Movement      // Live movement; nil unless the bot fully cheats
+	Ship      *ShipComponent // Live HP, hit radius and hull class; nil unless the bot fully cheats
+	SeenAt    float64        // Emission time of the perceived snapshot
 }
 
 type AIMissileThreat struct {
 	Entity            EntityID
-	Pos               Vec2
-	Vel               Vec2
+	Pos               Vec2 // Perceived position
+	Vel               Vec2 // Perceived velocity
+	SeenAt            float64
 	AgroRadius        float64
-	TargetingSelf     bool
+	TargetingSelf     bool // Perceived heading points at the bot; the live target as often as the bot cheats
 	Distance          float64
 	TimeToClosest     float64
 	DistanceAtClosest float64
@@ -184,6 +206,8 @@ type AIContext struct {
 	SelfMovement  *Movement
 	SelfRoute     *RouteComponent
 	SelfHeat      *HeatComponent
+	SelfShip      *ShipComponent
+	Difficulty    AIDifficulty
 	Opponents     []AIShipInfo
 	Threats       []AIMissileThreat
 }
@@ -195,7 +219,33 @@ func (ctx *AIContext) MissileReady() bool {
 	return ctx.Self.MissileReadyAt <= 0 || ctx.Now >= ctx.Self.MissileReadyAt
 }
 
-func buildAIContext(r *Room, self *Player) *AIContext {
+// perceiveForAI returns what the bot at observerPos sees of an entity. With a
+// cheat level of 0 this is the light-delayed snapshot a human would see; higher
+// levels blend toward the entity's true present position and velocity.
+func perceiveForAI(r *Room, observerPos Vec2, id EntityID, cheat float64) (Snapshot, bool) {
+	cheat = Clamp(cheat, 0, 1)
+	tr := r.World.Transform(id)
+	if tr == nil {
+		return Snapshot{}, false
+	}
+	snap, ok := PerceiveEntity(observerPos, id, r.World, r.Now)
+	if !ok {
+		if cheat < 1 {
+			return Snapshot{}, false
+		}
+		return Snapshot{T: r.Now, Pos: tr.Pos, Vel: tr.Vel}, true
+	}
+	if cheat > 0 {
+		snap.Pos = snap.Pos.Add(tr.Pos.Sub(snap.Pos).Scale(cheat))
+		snap.Vel = snap.Vel.Add(tr.Vel.Sub(snap.Vel).Scale(cheat))
+	}
+	return snap, true
+}
+
+// buildAIContext assembles the bot's view of the world. Opponents and threats
+// come from perceived snapshots so bots play with the same light delay as
+// humans; cheat raises that toward live information.
+func buildAIContext(r *Room, self *Player, cheat float64) *AIContext {
 	ctx := &AIContext{Room: r, Now: r.Now, Self: self}
 	if self != nil {
 		ctx.SelfEntity = self.Ship
@@ -204,54 +254,72 @@ func buildAIContext(r *Room, self *Player) *AIContext {
 			ctx.SelfMovement = r.World.Movement(self.Ship)
 			ctx.SelfRoute = r.World.Route(self.Ship)
 			ctx.SelfHeat = r.World.HeatData(self.Ship)
+			ctx.SelfShip = r.World.ShipData(self.Ship)
 		}
 	}
 
 	if ctx.SelfTransform != nil {
 		selfPos := ctx.SelfTransform.Pos
+		sensorRange := SensorRange(r.World, ctx.SelfEntity)
+		selfOwner := r.World.Owner(ctx.SelfEntity)
 		r.World.ForEach([]ComponentKey{CompTransform, CompShip, CompOwner}, func(e EntityID) {
 			if ctx.Self != nil && e == ctx.SelfEntity {
 				return
 			}
 			owner := r.World.Owner(e)
-			if owner == nil || (ctx.Self != nil && owner.PlayerID == ctx.Self.ID) {
+			if owner == nil || (ctx.Self != nil && owner.PlayerID == ctx.Self.ID) || ownersAllied(selfOwner, owner) {
 				return
 			}
-			tr := r.World.Transform(e)
-			mov := r.World.Movement(e)
-			if tr == nil {
+			snap, ok := perceiveForAI(r, selfPos, e, cheat)
+			if !ok || selfPos.Sub(snap.Pos).Len() > sensorRange {
 				return
 			}
-			ctx.Opponents = append(ctx.Opponents, AIShipInfo{
+			info := AIShipInfo{
 				Player:    r.Players[owner.PlayerID],
 				Entity:    e,
-				Transform: tr,
-				Movement:  mov,
-			})
+				Transform: &Transform{Pos: snap.Pos, Vel: snap.Vel},
+				SeenAt:    snap.T,
+			}
+			// Live ship state can't be seen or blended, so only full cheats get it.
+			if cheat >= 1 {
+				info.Movement = r.World.Movement(e)
+				info.Ship = r.World.ShipData(e)
+			}
+			ctx.Opponents = append(ctx.Opponents, info)
 		})
 
 		r.World.ForEach([]ComponentKey{CompTransform, CompMissile, CompOwner}, func(e EntityID) {
 			owner := r.World.Owner(e)
-			if owner == nil || (ctx.Self != nil && owner.PlayerID == ctx.Self.ID) {
+			if owner == nil || (ctx.Self != nil && owner.PlayerID == ctx.Self.ID) || ownersAllied(selfOwner, owner) {
 				return
 			}
-			tr := r.World.Transform(e)
 			missile := r.World.MissileData(e)
-			if tr == nil || missile == nil {
+			if missile == nil {
+				return
+			}
+			snap, ok := perceiveForAI(r, selfPos, e, cheat)
+			if !ok {
 				return
 			}
-			distVec := selfPos.Sub(tr.Pos)
+			distVec := selfPos.Sub(snap.Pos)
 			dist := distVec.Len()
-			relVel := tr.Vel
+			if dist > sensorRange {
+				return
+			}
+			relVel := snap.Vel
 			if ctx.SelfTransform != nil {
-				relVel = tr.Vel.Sub(ctx.SelfTransform.Vel)
+				relVel = snap.Vel.Sub(ctx.SelfTransform.Vel)
 			}
 			tClosest, dClosest := closestApproach(distVec.Scale(-1), relVel)
-			targeting := ctx.Self != nil && missile.Target == ctx.SelfEntity && ctx.SelfEntity != 0
+			targeting := perceivedHeadingAt(snap, selfPos)
+			if cheat >= 1 || (cheat > 0 && r.simRand().Float64() < cheat) {
+				targeting = ctx.Self != nil && missile.Target == ctx.SelfEntity && ctx.SelfEntity != 0
+			}
 			ctx.Threats = append(ctx.Threats, AIMissileThreat{
 				Entity:            e,
-				Pos:               tr.Pos,
-				Vel:               tr.Vel,
+				Pos:               snap.Pos,
+				Vel:               snap.Vel,
+				SeenAt:            snap.T,
 				AgroRadius:        missile.AgroRadius,
 				TargetingSelf:     targeting,
 				Distance:          dist,
@@ -264,6 +332,22 @@ func buildAIContext(r *Room, self *Player) *AIContext {
 	return ctx
 }
 
+// aiTargetingCos is how closely a missile's perceived heading must point at a
+// bot (about 25 degrees) for the bot to treat it as aimed at itself.
+const aiTargetingCos = 0.9
+
+// perceivedHeadingAt reports whether a perceived missile is flying toward pos,
+// which is all an honest bot can tell about what the missile is chasing.
+func perceivedHeadingAt(snap Snapshot, pos Vec2) bool {
+	speed := snap.Vel.Len()
+	toPos := pos.Sub(snap.Pos)
+	dist := toPos.Len()
+	if speed <= 1e-6 || dist <= 1e-6 {
+		return false
+	}
+	return snap.Vel.Dot(toPos)/(speed*dist) >= aiTargetingCos
+}
+
 func closestApproach(relativePos Vec2, relativeVel Vec2) (float64, float64) {
 	speedSq := relativeVel.Dot(relativeVel)
 	if speedSq <= 1e-6 {
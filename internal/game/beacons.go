@@ -39,6 +39,12 @@ type BeaconDirector struct {
 	ActiveObjectives  map[string]ObjectiveEvaluator
 	ObjectiveProgress map[string]float64
 	currentTemplate   *MissionTemplate
+	convoy            EntityID // Escort mission's convoy, if any
+	owner             string   // Player who accepted the current mission
+	failureChecks     []*missionFailureCheck
+	retryAt           map[string]float64    // Mission ID -> time a failed mission can be retried
+	stallSeen         map[string]float64    // Player ID -> last observed StallUntil
+	party             *playerBeaconProgress // Shared by every player in co-op
 }
 
 // MissionSpec configures a campaign mission's beacons and encounter behaviour.
@@ -67,7 +73,8 @@ type BeaconLayout struct {
 	Pinned     bool
 }
 
-// playerBeaconProgress tracks per-player mission progression.
+// playerBeaconProgress tracks per-player mission progression. In co-op every
+// player shares one record.
 type playerBeaconProgress struct {
 	PlayerID          string
 	CurrentIndex      int
@@ -154,6 +161,7 @@ const (
 	EncounterDeltaCleared
 	EncounterDeltaTimeout
 	EncounterDeltaPurged
+	EncounterDeltaSourceDestroyed
 )
 
 // EncounterDelta records encounter transitions for websocket publishing.
@@ -201,12 +209,14 @@ func deriveSeed(roomID, missionID string) int64 {
 
 // NewBeaconDirector builds a mission director for the provided room/mission context.
 func NewBeaconDirector(roomID, missionID string, worldW, worldH float64) (*BeaconDirector, bool) {
+	contentMu.RLock()
 	spec, ok := missionSpecs[missionID]
 	if !ok {
 		spec, ok = missionSpecs["campaign-1"]
-		if !ok {
-			return nil, false
-		}
+	}
+	contentMu.RUnlock()
+	if !ok {
+		return nil, false
 	}
 	seed := deriveSeed(roomID, spec.ID)
 	layout := instantiateLayout(spec, seed, worldW, worldH)
@@ -374,7 +384,7 @@ func (d *BeaconDirector) Snapshot(now float64, worldW, worldH float64) BeaconSna
 	}
 
 	players := make([]BeaconSnapshotPlayer, 0, len(d.player))
-	for _, ps := range d.player {
+	for playerID, ps := range d.player {
 		if ps == nil {
 			continue
 		}
@@ -395,7 +405,7 @@ func (d *BeaconDirector) Snapshot(now float64, worldW, worldH float64) BeaconSna
 			cooldowns[id] = until
 		}
 		players = append(players, BeaconSnapshotPlayer{
-			PlayerID:     ps.PlayerID,
+			PlayerID:     playerID,
 			ActiveBeacon: ps.ActiveBeaconID,
 			CurrentIndex: ps.CurrentIndex,
 			HoldAccum:    ps.HoldAccum,
@@ -516,6 +526,7 @@ func (d *BeaconDirector) Tick(r *Room) {
 		return
 	}
 	d.pruneExpiredEncounters(r)
+	var party []*Player
 	for playerID, p := range r.Players {
 		if p == nil {
 			continue
@@ -525,7 +536,22 @@ func (d *BeaconDirector) Tick(r *Room) {
 		}
 		state := d.ensurePlayerState(playerID)
 		state.LastSeen = r.Now
-		d.updatePlayerProgress(r, p, state)
+		if d.party != nil {
+			party = append(party, p)
+		} else {
+			d.updatePlayerProgress(r, []*Player{p}, state)
+		}
+		d.observeHeatStalls(r, p)
+	}
+	if len(party) > 0 {
+		sort.Slice(party, func(i, j int) bool { return party[i].ID < party[j].ID })
+		d.updatePlayerProgress(r, party, d.party)
+	}
+
+	if len(d.ActiveObjectives) > 0 {
+		if reason := d.checkFailure(r); reason != "" {
+			d.failMission(r, reason)
+		}
 	}
 
 	if len(d.ActiveObjectives) > 0 {
@@ -543,7 +569,6 @@ func (d *BeaconDirector) Tick(r *Room) {
 					r.BroadcastObjectiveProgress(p, objID, progress)
 				}
 				if complete {
-					r.BroadcastObjectiveComplete(p, objID)
 					completed = append(completed, objID)
 					break
 				}
@@ -554,6 +579,19 @@ func (d *BeaconDirector) Tick(r *Room) {
 				delete(d.ActiveObjectives, id)
 				delete(d.ObjectiveProgress, id)
 			}
+			if len(d.ActiveObjectives) == 0 {
+				d.failureChecks = nil
+			}
+			for _, id := range completed {
+				r.broadcastMissionEventLocked(MissionEvent{Type: MissionEventObjectiveComplete, Target: id})
+			}
+			// Objectives are shared, so every player hears about them; the
+			// update after the last one reports the mission completed.
+			for _, p := range r.Players {
+				if p != nil && !p.IsBot {
+					r.BroadcastObjectiveComplete(p, completed[len(completed)-1])
+				}
+			}
 		}
 	}
 
@@ -858,6 +896,17 @@ func (d *BeaconDirector) AcceptMission(r *Room, p *Player, missionID string) err
 	if err := tmpl.Validate(); err != nil {
 		return err
 	}
+	if wait := d.retryAt[tmpl.ID] - r.Now; wait > 0 {
+		return fmt.Errorf("mission %s failed recently; retry in %.0fs", tmpl.ID, math.Ceil(wait))
+	}
+	// Spawn the convoy before touching the running mission, so a bad escort
+	// leaves it as it was.
+	var convoy EntityID
+	if tmpl.Archetype == ArchetypeEscort {
+		if convoy, err = d.spawnConvoyLocked(r, p, tmpl); err != nil {
+			return err
+		}
+	}
 	if d.ActiveObjectives == nil {
 		d.ActiveObjectives = make(map[string]ObjectiveEvaluator)
 	}
@@ -873,25 +922,28 @@ func (d *BeaconDirector) AcceptMission(r *Room, p *Player, missionID string) err
 
 	d.currentTemplate = tmpl
 	d.CurrentMissionID = tmpl.ID
+	d.owner = p.ID
+	if r.coop != nil {
+		clear(r.coop.applied)
+	}
+	r.removeConvoyLocked(d.convoy)
+	d.convoy = convoy
+	d.failureChecks = nil
+	for _, rule := range tmpl.failureRules() {
+		d.failureChecks = append(d.failureChecks, newMissionFailureCheck(r, rule))
+	}
 
 	switch tmpl.Archetype {
-	case ArchetypeTravel, ArchetypeEscort:
-		for _, beacon := range d.beacons {
-			objID := fmt.Sprintf("reach-%s", beacon.ID)
-			target := Vec2{
-				X: Clamp(beacon.Normalized.X, 0, 1) * r.WorldWidth,
-				Y: Clamp(beacon.Normalized.Y, 0, 1) * r.WorldHeight,
-			}
-			threshold := beacon.Radius
-			if threshold <= 0 {
-				threshold = math.Max(r.WorldWidth, r.WorldHeight) * 0.05
-			}
-			d.ActiveObjectives[objID] = &DistanceEvaluator{
-				TargetX:    target.X,
-				TargetY:    target.Y,
-				Threshold:  threshold,
-				Identifier: objID,
-			}
+	case ArchetypeEscort:
+		d.ActiveObjectives[convoyObjectiveID] = &EscortEvaluator{
+			Convoy:     convoy,
+			Identifier: convoyObjectiveID,
+		}
+		d.ObjectiveProgress[convoyObjectiveID] = 0
+	case ArchetypeTravel:
+		for i := range d.beacons {
+			objID := fmt.Sprintf("reach-%s", d.beacons[i].ID)
+			d.ActiveObjectives[objID] = d.reachEvaluator(r, &d.beacons[i], objID)
 			d.ObjectiveProgress[objID] = 0
 		}
 	case ArchetypeKill:
@@ -936,17 +988,46 @@ func (d *BeaconDirector) AcceptMission(r *Room, p *Player, missionID string) err
 		d.ObjectiveProgress[objID] = 0
 	}
 
+	r.broadcastMissionEventLocked(MissionEvent{Type: MissionEventStart})
 	return nil
 }
 
+// reachEvaluator returns an objective to fly within the beacon's radius.
+func (d *BeaconDirector) reachEvaluator(r *Room, beacon *BeaconLayout, objID string) *DistanceEvaluator {
+	threshold := beacon.Radius
+	if threshold <= 0 {
+		threshold = math.Max(r.WorldWidth, r.WorldHeight) * 0.05
+	}
+	return &DistanceEvaluator{
+		TargetX:    Clamp(beacon.Normalized.X, 0, 1) * r.WorldWidth,
+		TargetY:    Clamp(beacon.Normalized.Y, 0, 1) * r.WorldHeight,
+		Threshold:  threshold,
+		Identifier: objID,
+	}
+}
+
 func (d *BeaconDirector) ensurePlayerState(playerID string) *playerBeaconProgress {
 	if d == nil {
 		return nil
 	}
+	if d.party != nil {
+		if d.player[playerID] != d.party {
+			d.player[playerID] = d.party
+			d.snapshotDirty = true
+		}
+		return d.party
+	}
 	if state, ok := d.player[playerID]; ok && state != nil {
 		return state
 	}
-	state := &playerBeaconProgress{
+	state := d.newProgress(playerID)
+	d.player[playerID] = state
+	d.snapshotDirty = true
+	return state
+}
+
+func (d *BeaconDirector) newProgress(playerID string) *playerBeaconProgress {
+	return &playerBeaconProgress{
 		PlayerID:       playerID,
 		CurrentIndex:   0,
 		HoldAccum:      0,
@@ -957,9 +1038,33 @@ func (d *BeaconDirector) ensurePlayerState(playerID string) *playerBeaconProgres
 		Discovered:     make(map[string]bool),
 		Completed:      make(map[string]bool),
 	}
-	d.player[playerID] = state
+}
+
+// shareProgress switches the director to co-op: every player now reads and
+// advances one party record, seeded from whichever player got furthest.
+func (d *BeaconDirector) shareProgress() {
+	if d == nil || d.party != nil {
+		return
+	}
+	var best *playerBeaconProgress
+	for _, state := range d.player {
+		if state == nil {
+			continue
+		}
+		if best == nil || state.CurrentIndex > best.CurrentIndex ||
+			(state.CurrentIndex == best.CurrentIndex && state.PlayerID < best.PlayerID) {
+			best = state
+		}
+	}
+	if best == nil {
+		best = d.newProgress(coopPartyID)
+	}
+	best.PlayerID = coopPartyID
+	d.party = best
+	for id := range d.player {
+		d.player[id] = best
+	}
 	d.snapshotDirty = true
-	return state
 }
 
 func (d *BeaconDirector) activeBeaconID(index int) string {
@@ -969,7 +1074,17 @@ func (d *BeaconDirector) activeBeaconID(index int) string {
 	return d.beacons[index].ID
 }
 
-func (d *BeaconDirector) updatePlayerProgress(r *Room, p *Player, state *playerBeaconProgress) {
+// queueDelta queues a copy of the delta for every player in the crew.
+func (d *BeaconDirector) queueDelta(crew []*Player, delta BeaconDelta) {
+	for _, p := range crew {
+		delta.PlayerID = p.ID
+		d.pendingDeltas = append(d.pendingDeltas, delta)
+	}
+}
+
+// updatePlayerProgress advances state for the ships of its crew: the player
+// alone, or every party member in co-op.
+func (d *BeaconDirector) updatePlayerProgress(r *Room, crew []*Player, state *playerBeaconProgress) {
 	if state == nil {
 		return
 	}
@@ -997,25 +1112,36 @@ func (d *BeaconDirector) updatePlayerProgress(r *Room, p *Player, state *playerB
 	}
 	state.LastUpdate = r.Now
 
-	shipTransform := r.World.Transform(p.Ship)
-	if shipTransform == nil {
-		d.resetHoldProgress(state, beacon, r.Now)
-		return
-	}
-
 	targetPos := Vec2{
 		X: Clamp(beacon.Normalized.X, 0, 1) * r.WorldWidth,
 		Y: Clamp(beacon.Normalized.Y, 0, 1) * r.WorldHeight,
 	}
-	offset := shipTransform.Pos.Sub(targetPos)
-	distSq := offset.Dot(offset)
-
 	discoverRadius := beacon.Radius * 1.8
-	if distSq <= discoverRadius*discoverRadius && !state.Discovered[beacon.ID] {
+	ships, inside, near := 0, 0, false
+	for _, p := range crew {
+		shipTransform := r.World.Transform(p.Ship)
+		if shipTransform == nil {
+			continue
+		}
+		ships++
+		offset := shipTransform.Pos.Sub(targetPos)
+		distSq := offset.Dot(offset)
+		if distSq <= discoverRadius*discoverRadius {
+			near = true
+		}
+		if distSq <= beacon.Radius*beacon.Radius {
+			inside++
+		}
+	}
+	if ships == 0 {
+		d.resetHoldProgress(crew, state, beacon, r.Now)
+		return
+	}
+
+	if near && !state.Discovered[beacon.ID] {
 		state.Discovered[beacon.ID] = true
-		d.pendingDeltas = append(d.pendingDeltas, BeaconDelta{
+		d.queueDelta(crew, BeaconDelta{
 			Type:      BeaconDeltaDiscovered,
-			PlayerID:  p.ID,
 			BeaconID:  beacon.ID,
 			Ordinal:   beacon.Ordinal,
 			Timestamp: r.Now,
@@ -1023,21 +1149,21 @@ func (d *BeaconDirector) updatePlayerProgress(r *Room, p *Player, state *playerB
 	}
 
 	if until, ok := state.Cooldowns[beacon.ID]; ok && r.Now < until {
-		d.resetHoldProgress(state, beacon, r.Now)
+		d.resetHoldProgress(crew, state, beacon, r.Now)
 		return
 	}
 
-	if distSq <= beacon.Radius*beacon.Radius {
+	if inside > 0 {
+		// Every crew ship inside the ring adds to the hold.
 		state.HoldBeaconID = beacon.ID
-		state.HoldAccum = Clamp(state.HoldAccum+dt, 0, state.HoldRequired)
+		state.HoldAccum = Clamp(state.HoldAccum+dt*float64(inside), 0, state.HoldRequired)
 		progressDelta := math.Abs(state.HoldAccum - state.LastBroadcastHold)
 		if state.HoldAccum >= state.HoldRequired-d.holdEpsilon {
-			d.lockBeacon(r, p, state, beacon)
+			d.lockBeacon(r, crew, state, beacon)
 		} else if progressDelta >= d.holdBroadcastStep {
 			state.LastBroadcastHold = state.HoldAccum
-			d.pendingDeltas = append(d.pendingDeltas, BeaconDelta{
+			d.queueDelta(crew, BeaconDelta{
 				Type:         BeaconDeltaHoldProgress,
-				PlayerID:     p.ID,
 				BeaconID:     beacon.ID,
 				Ordinal:      beacon.Ordinal,
 				HoldAccum:    state.HoldAccum,
@@ -1049,9 +1175,8 @@ func (d *BeaconDirector) updatePlayerProgress(r *Room, p *Player, state *playerB
 		if state.HoldAccum > 0 {
 			state.HoldAccum = 0
 			state.LastBroadcastHold = 0
-			d.pendingDeltas = append(d.pendingDeltas, BeaconDelta{
+			d.queueDelta(crew, BeaconDelta{
 				Type:         BeaconDeltaHoldReset,
-				PlayerID:     p.ID,
 				BeaconID:     beacon.ID,
 				Ordinal:      beacon.Ordinal,
 				HoldRequired: state.HoldRequired,
@@ -1062,16 +1187,15 @@ func (d *BeaconDirector) updatePlayerProgress(r *Room, p *Player, state *playerB
 	}
 }
 
-func (d *BeaconDirector) resetHoldProgress(state *playerBeaconProgress, beacon BeaconLayout, now float64) {
+func (d *BeaconDirector) resetHoldProgress(crew []*Player, state *playerBeaconProgress, beacon BeaconLayout, now float64) {
 	if state == nil {
 		return
 	}
 	if state.HoldAccum > 0 {
 		state.HoldAccum = 0
 		state.LastBroadcastHold = 0
-		d.pendingDeltas = append(d.pendingDeltas, BeaconDelta{
+		d.queueDelta(crew, BeaconDelta{
 			Type:      BeaconDeltaHoldReset,
-			PlayerID:  state.PlayerID,
 			BeaconID:  beacon.ID,
 			Ordinal:   beacon.Ordinal,
 			Timestamp: now,
@@ -1080,15 +1204,14 @@ func (d *BeaconDirector) resetHoldProgress(state *playerBeaconProgress, beacon B
 	state.HoldBeaconID = ""
 }
 
-func (d *BeaconDirector) lockBeacon(r *Room, p *Player, state *playerBeaconProgress, beacon BeaconLayout) {
+func (d *BeaconDirector) lockBeacon(r *Room, crew []*Player, state *playerBeaconProgress, beacon BeaconLayout) {
 	state.Completed[beacon.ID] = true
 	state.HoldAccum = 0
 	state.LastBroadcastHold = 0
 	state.Cooldowns[beacon.ID] = r.Now + d.spec.RevisitCooldown
 
-	d.pendingDeltas = append(d.pendingDeltas, BeaconDelta{
+	d.queueDelta(crew, BeaconDelta{
 		Type:          BeaconDeltaBeaconLocked,
-		PlayerID:      p.ID,
 		BeaconID:      beacon.ID,
 		Ordinal:       beacon.Ordinal,
 		HoldAccum:     0,
@@ -1101,18 +1224,22 @@ func (d *BeaconDirector) lockBeacon(r *Room, p *Player, state *playerBeaconProgr
 	state.HoldBeaconID = ""
 	state.ActiveBeaconID = d.activeBeaconID(state.CurrentIndex)
 
-	r.HandleMissionStoryEventLocked(p, "mission:beacon-locked", beacon.Ordinal+1)
+	for _, p := range crew {
+		r.HandleMissionEventLocked(p, MissionEvent{Type: MissionEventBeaconLocked, Beacon: beacon.Ordinal + 1})
+	}
 
 	if state.CurrentIndex >= len(d.beacons) {
 		state.ActiveBeaconID = ""
-		d.pendingDeltas = append(d.pendingDeltas, BeaconDelta{
+		d.queueDelta(crew, BeaconDelta{
 			Type:      BeaconDeltaMissionCompleted,
-			PlayerID:  p.ID,
 			BeaconID:  beacon.ID,
 			Ordinal:   beacon.Ordinal,
 			Timestamp: r.Now,
 		})
-		r.HandleMissionStoryEventLocked(p, "mission:completed", 0)
+		for _, p := range crew {
+			r.HandleMissionEventLocked(p, MissionEvent{Type: MissionEventCompleted})
+			r.recordMissionCompletedLocked(p, d.missionID)
+		}
 	}
 	d.snapshotDirty = true
 }
@@ -1198,6 +1325,11 @@ func (d *BeaconDirector) pruneExpiredEncounters(r *Room) {
 			gc = append(gc, id)
 			continue
 		}
+		if encounterSourceDestroyed(r.World, enc.EntityIDs) {
+			d.endEncounter(r, id, enc, EncounterDeltaSourceDestroyed, "source destroyed")
+			gc = append(gc, id)
+			continue
+		}
 		alive := false
 		for _, entityID := range enc.EntityIDs {
 			if r.World.Exists(entityID) {
@@ -1216,6 +1348,13 @@ func (d *BeaconDirector) pruneExpiredEncounters(r *Room) {
 		}
 		d.endEncounter(r, id, enc, reason, desc)
 		gc = append(gc, id)
+		if reason == EncounterDeltaCleared {
+			r.broadcastMissionEventLocked(MissionEvent{
+				Type:   MissionEventEncounterCleared,
+				Beacon: d.beaconOrdinal(enc.BeaconID),
+				Target: enc.EncounterID,
+			})
+		}
 	}
 	for _, id := range gc {
 		delete(d.encounters, id)
@@ -1225,7 +1364,29 @@ func (d *BeaconDirector) pruneExpiredEncounters(r *Room) {
 	}
 }
 
+// endAllEncounters despawns every live encounter, e.g. when the room swaps this
+// director out for another mission's layout.
+func (d *BeaconDirector) endAllEncounters(r *Room, reason string) {
+	ids := make([]string, 0, len(d.encounters))
+	for id := range d.encounters {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if enc := d.encounters[id]; enc != nil {
+			d.endEncounter(r, id, enc, EncounterDeltaPurged, reason)
+		}
+		delete(d.encounters, id)
+	}
+	d.snapshotDirty = true
+}
+
 func (d *BeaconDirector) endEncounter(r *Room, id string, enc *EncounterState, delta EncounterDeltaType, reason string) {
+	for _, child := range encounterChildren(r.World, enc.EntityIDs) {
+		if r.World.Exists(child) {
+			r.World.RemoveEntity(child)
+		}
+	}
 	for _, entityID := range enc.EntityIDs {
 		if r.World.Exists(entityID) {
 			r.World.RemoveEntity(entityID)
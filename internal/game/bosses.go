@@ -0,0 +1,147 @@
+package game
+
+import (
+	"math/rand"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// BossSpec describes the central entity of a boss encounter.
+type BossSpec struct {
+	Hull     string
+	MaxHP    int    // Overrides the hull's HP when positive
+	Behavior string // AI behavior; empty leaves the boss unarmed
+	Weapon   WeaponProfile
+	Tags     map[string]bool
+	Phases   []BossPhase
+}
+
+// BossPhase is one stage of a boss fight. Phase 0 starts when the boss
+// spawns; each later phase starts once the boss's HP fraction falls to
+// HPBelow or After seconds have passed since the previous phase began,
+// whichever comes first. Zero triggers are ignored.
+type BossPhase struct {
+	Name        string
+	HPBelow     float64
+	After       float64
+	SpawnGroups []SpawnGroup      // Spawned around the boss on entry
+	WaypointGen WaypointGenerator // New patrol path around an unarmed boss; nil keeps the current one
+	HeatParams  *HeatParams       // Replaces the boss's heat params
+	Weapon      *WeaponProfile    // Replaces an armed boss's weapon
+	StoryNode   string            // Story node started for every human player
+}
+
+// spawnBossEntity creates the boss ship for a template and enters phase 0.
+func spawnBossEntity(r *Room, spec *BossSpec, pos Vec2, seed int64) EntityID {
+	id := r.SpawnShip(missionOwnerID, pos)
+	if spec.Hull != "" {
+		if hull, err := GetHull(spec.Hull); err == nil {
+			r.applyHullLocked(id, *hull)
+		}
+	}
+	if ship := r.World.ShipData(id); ship != nil && spec.MaxHP > 0 {
+		ship.MaxHP = spec.MaxHP
+		ship.HP = spec.MaxHP
+	}
+	if spec.Behavior != "" {
+		attachGunshipPilot(r, id, "Boss", spec.Behavior, spec.Weapon)
+	}
+	boss := &BossComponent{Phases: spec.Phases, Phase: -1, Seed: seed}
+	r.World.SetComponent(id, CompBoss, boss)
+	applyEntityTags(r, id, spec.Tags)
+	if len(boss.Phases) > 0 {
+		r.enterBossPhase(id, boss, 0)
+	}
+	return id
+}
+
+// nextPhaseTriggered reports whether the phase after the current one should start.
+func (b *BossComponent) nextPhaseTriggered(ship *ShipComponent, now float64) bool {
+	next := b.Phase + 1
+	if next >= len(b.Phases) {
+		return false
+	}
+	phase := b.Phases[next]
+	if phase.HPBelow > 0 && ship != nil && ship.MaxHP > 0 && float64(ship.HP)/float64(ship.MaxHP) <= phase.HPBelow {
+		return true
+	}
+	return phase.After > 0 && now-b.PhaseStartedAt >= phase.After
+}
+
+// enterBossPhase applies a phase's scripted transition.
+func (r *Room) enterBossPhase(id EntityID, boss *BossComponent, index int) {
+	boss.Phase = index
+	boss.PhaseStartedAt = r.Now
+	phase := boss.Phases[index]
+	tr := r.World.Transform(id)
+	if tr == nil {
+		return
+	}
+	seed := boss.Seed + int64(index+1)*104729
+
+	if len(phase.SpawnGroups) > 0 {
+		wave := &EncounterTemplate{SpawnGroups: phase.SpawnGroups, WaypointGen: phase.WaypointGen}
+		boss.Children = append(boss.Children, SpawnFromTemplate(r, wave, tr.Pos, seed)...)
+	}
+	if phase.WaypointGen != nil && r.World.Gunship(id) == nil {
+		path := phase.WaypointGen.Generate(tr.Pos, rand.New(rand.NewSource(seed)))
+		if route := r.World.Route(id); route != nil && len(path) > 0 {
+			speed := 0.0
+			if mov := r.World.Movement(id); mov != nil {
+				speed = mov.MaxSpeed * 0.5
+			}
+			route.Waypoints = route.Waypoints[:0]
+			for _, p := range path {
+				route.Waypoints = append(route.Waypoints, RouteWaypoint{Pos: clampVec(p, r.WorldWidth, r.WorldHeight), Speed: speed})
+			}
+			if follower := r.World.RouteFollower(id); follower != nil {
+				follower.Index = 0
+				follower.Hold = false
+			}
+			boss.Patrol = true
+		}
+	}
+	if phase.HeatParams != nil {
+		if heat := r.World.HeatData(id); heat != nil {
+			heat.P = SanitizeHeatParams(*phase.HeatParams)
+		}
+	}
+	if phase.Weapon != nil {
+		if g := r.World.Gunship(id); g != nil {
+			g.Weapon = *phase.Weapon
+		}
+	}
+	if phase.StoryNode != "" {
+		for _, p := range r.Players {
+			if p != nil && !p.IsBot {
+				r.tryStartStoryNodeLocked(p, dag.NodeID(phase.StoryNode))
+			}
+		}
+	}
+}
+
+// updateBosses advances boss phases and keeps patrolling bosses on their path.
+func updateBosses(r *Room) {
+	world := r.World
+	var bosses []EntityID
+	world.ForEach([]ComponentKey{CompBoss, CompShip}, func(id EntityID) {
+		if world.DestroyedData(id) == nil {
+			bosses = append(bosses, id)
+		}
+	})
+	for _, id := range bosses {
+		boss := world.Boss(id)
+		// A single heavy hit can cross several thresholds at once.
+		for boss.nextPhaseTriggered(world.ShipData(id), r.Now) {
+			r.enterBossPhase(id, boss, boss.Phase+1)
+		}
+		if !boss.Patrol {
+			continue
+		}
+		route := world.Route(id)
+		follower := world.RouteFollower(id)
+		if route != nil && follower != nil && len(route.Waypoints) > 0 && follower.Index >= len(route.Waypoints) {
+			follower.Index = 0
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package game
+
+import "testing"
+
+func spawnTestBoss(t *testing.T, room *Room, id string) EntityID {
+	t.Helper()
+	template, err := GetEncounter(id)
+	if err != nil {
+		t.Fatalf("failed to load encounter template: %v", err)
+	}
+	for _, entity := range SpawnFromTemplate(room, template, Vec2{X: 5000, Y: 5000}, 3) {
+		if room.World.Boss(entity) != nil {
+			return entity
+		}
+	}
+	t.Fatal("expected a boss entity")
+	return 0
+}
+
+func TestBossPhasesFollowHPThresholds(t *testing.T) {
+	room := newCombatTestRoom()
+	id := spawnTestBoss(t, room, "dreadnought")
+	boss := room.World.Boss(id)
+	ship := room.World.ShipData(id)
+	if ship.MaxHP != 12 || ship.HP != 12 {
+		t.Fatalf("expected boss HP override of 12, got %d/%d", ship.HP, ship.MaxHP)
+	}
+	if boss.Phase != 0 {
+		t.Fatalf("expected boss to start in phase 0, got %d", boss.Phase)
+	}
+
+	room.damageShip(id, 4, "pilot", nil)
+	updateBosses(room)
+	if boss.Phase != 1 {
+		t.Fatalf("expected escorts phase at 8/12 HP, got phase %d", boss.Phase)
+	}
+	if len(boss.Children) != 4 {
+		t.Fatalf("expected escorts to spawn, got %d children", len(boss.Children))
+	}
+
+	room.damageShip(id, 5, "pilot", nil)
+	updateBosses(room)
+	if boss.Phase != 2 {
+		t.Fatalf("expected overdrive phase at 3/12 HP, got phase %d", boss.Phase)
+	}
+	if heat := room.World.HeatData(id); heat.P.MarkerSpeed != 190 {
+		t.Fatalf("expected overdrive heat params, got marker %.1f", heat.P.MarkerSpeed)
+	}
+	if g := room.World.Gunship(id); g == nil || g.Weapon.Cooldown != 5 {
+		t.Fatalf("expected overdrive weapon, got %+v", g)
+	}
+
+	room.damageShip(id, ship.HP, "pilot", nil)
+	if !encounterSourceDestroyed(room.World, []EntityID{id}) {
+		t.Fatal("expected a destroyed boss to end its encounter")
+	}
+}
+
+func TestBossPhaseTimerAndSkippedThresholds(t *testing.T) {
+	room := newCombatTestRoom()
+	saved := currentRegistries()
+	reg := saved.clone()
+	reg.encounters["test-boss"] = EncounterTemplate{
+		ID: "test-boss",
+		Boss: &BossSpec{
+			MaxHP: 10,
+			Phases: []BossPhase{
+				{Name: "idle"},
+				{Name: "patrol", After: 30, WaypointGen: CircularPathGenerator{Radius: 500, PointCount: 4}},
+				{Name: "wounded", HPBelow: 0.5},
+				{Name: "last stand", HPBelow: 0.2},
+			},
+		},
+	}
+	installRegistries(reg)
+	t.Cleanup(func() { installRegistries(saved) })
+	id := spawnTestBoss(t, room, "test-boss")
+	boss := room.World.Boss(id)
+
+	room.Now = 29
+	updateBosses(room)
+	if boss.Phase != 0 {
+		t.Fatalf("timer phase should wait, got phase %d", boss.Phase)
+	}
+	room.Now = 30
+	updateBosses(room)
+	if boss.Phase != 1 || !boss.Patrol {
+		t.Fatalf("expected timed patrol phase, got phase %d patrol %v", boss.Phase, boss.Patrol)
+	}
+	if route := room.World.Route(id); len(route.Waypoints) != 4 {
+		t.Fatalf("expected a new patrol route, got %d waypoints", len(route.Waypoints))
+	}
+
+	room.damageShip(id, 9, "pilot", nil)
+	updateBosses(room)
+	if boss.Phase != 3 {
+		t.Fatalf("a heavy hit should cross every threshold, got phase %d", boss.Phase)
+	}
+}
@@ -0,0 +1,280 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// DefaultCampaignID is the campaign that numbered mission IDs such as "2"
+// refer to.
+const DefaultCampaignID = "signal-static"
+
+// Mission availability in the mission-select list
+const (
+	MissionStatusLocked    = "locked"
+	MissionStatusAvailable = "available"
+	MissionStatusCompleted = "completed"
+	MissionStatusCooldown  = "cooldown" // Failed recently; see RetryIn
+)
+
+// CampaignMission is one step of a campaign. It unlocks once the previous
+// mission has been completed and the player has every required story flag
+// and completed DAG node.
+type CampaignMission struct {
+	MissionID     string
+	RequiresFlags []string
+	RequiresNodes []dag.NodeID
+}
+
+// Campaign is an ordered list of missions played one after another.
+type Campaign struct {
+	ID          string
+	DisplayName string
+	Missions    []CampaignMission
+}
+
+// CampaignRegistry holds all defined campaigns.
+var CampaignRegistry = map[string]Campaign{
+	DefaultCampaignID: {
+		ID:          DefaultCampaignID,
+		DisplayName: "Signal In The Static",
+		Missions: []CampaignMission{
+			{MissionID: "campaign-1"},
+		},
+	},
+}
+
+// GetCampaign retrieves a campaign by ID.
+func GetCampaign(id string) (*Campaign, error) {
+	contentMu.RLock()
+	campaign, ok := CampaignRegistry[id]
+	contentMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("campaign not found: %s", id)
+	}
+	return &campaign, nil
+}
+
+// CampaignMissionID returns the ID of the nth (1-based) mission of a campaign.
+func CampaignMissionID(campaignID string, n int) (string, bool) {
+	campaign, err := GetCampaign(campaignID)
+	if err != nil || n < 1 || n > len(campaign.Missions) {
+		return "", false
+	}
+	return campaign.Missions[n-1].MissionID, true
+}
+
+// MissionAvailability is one entry of the mission-select list.
+type MissionAvailability struct {
+	MissionID   string   `json:"missionId"`
+	CampaignID  string   `json:"campaignId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Archetype   string   `json:"archetype"`
+	Status      string   `json:"status"`
+	Missing     []string `json:"missing,omitempty"` // Unmet requirements of a locked mission
+	RetryIn     float64  `json:"retryIn,omitempty"` // Seconds left on a failed mission's cooldown
+	Active      bool     `json:"active"`            // The mission the room is currently running
+}
+
+// MissionList is the mission:list payload.
+type MissionList struct {
+	Missions   []MissionAvailability `json:"missions"`
+	ServerTime float64               `json:"serverTime"`
+}
+
+// MissionListLocked lists every mission and whether the player can take it:
+// campaign missions in campaign order, then missions outside any campaign,
+// which are always available. Callers must hold r.Mu.
+func (r *Room) MissionListLocked(p *Player) MissionList {
+	contentMu.RLock()
+	campaigns := make([]Campaign, 0, len(CampaignRegistry))
+	for _, campaign := range CampaignRegistry {
+		campaigns = append(campaigns, campaign)
+	}
+	templates := TemplateRegistry
+	contentMu.RUnlock()
+	sort.Slice(campaigns, func(i, j int) bool { return campaigns[i].ID < campaigns[j].ID })
+
+	list := MissionList{Missions: []MissionAvailability{}, ServerTime: r.Now}
+	listed := make(map[string]bool)
+	for _, campaign := range campaigns {
+		for i := range campaign.Missions {
+			tmpl, ok := templates[campaign.Missions[i].MissionID]
+			if !ok {
+				continue
+			}
+			entry := r.missionAvailability(p, &tmpl)
+			entry.CampaignID = campaign.ID
+			if entry.Status != MissionStatusCompleted {
+				entry.Missing = campaignMissing(p, &campaign, i)
+				if len(entry.Missing) > 0 {
+					entry.Status = MissionStatusLocked
+					entry.RetryIn = 0
+				}
+			}
+			list.Missions = append(list.Missions, entry)
+			listed[tmpl.ID] = true
+		}
+	}
+	ids := make([]string, 0, len(templates))
+	for id := range templates {
+		if !listed[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		tmpl := templates[id]
+		list.Missions = append(list.Missions, r.missionAvailability(p, &tmpl))
+	}
+	return list
+}
+
+// missionAvailability reports a mission's status ignoring campaign unlocks.
+func (r *Room) missionAvailability(p *Player, tmpl *MissionTemplate) MissionAvailability {
+	entry := MissionAvailability{
+		MissionID:   tmpl.ID,
+		DisplayName: tmpl.DisplayName,
+		Archetype:   archetypeToString(tmpl.Archetype),
+		Status:      MissionStatusAvailable,
+	}
+	if d := r.missionDirector; d != nil {
+		entry.Active = d.CurrentMissionID == tmpl.ID && len(d.ActiveObjectives) > 0
+		if wait := d.retryAt[tmpl.ID] - r.Now; wait > 0 {
+			entry.Status = MissionStatusCooldown
+			entry.RetryIn = wait
+		}
+	}
+	if p != nil && p.CompletedMissions[tmpl.ID] {
+		entry.Status = MissionStatusCompleted
+		entry.RetryIn = 0
+	}
+	return entry
+}
+
+// campaignMissing lists what the player still needs to unlock the campaign's
+// ith mission.
+func campaignMissing(p *Player, campaign *Campaign, i int) []string {
+	var missing []string
+	if i > 0 {
+		prev := campaign.Missions[i-1].MissionID
+		if p == nil || !p.CompletedMissions[prev] {
+			missing = append(missing, "complete "+prev)
+		}
+	}
+	step := campaign.Missions[i]
+	for _, flag := range step.RequiresFlags {
+		if p == nil || !p.StoryFlags[flag] {
+			missing = append(missing, "flag "+flag)
+		}
+	}
+	for _, node := range step.RequiresNodes {
+		if p == nil || p.DagState == nil || p.DagState.GetStatus(node) != dag.StatusCompleted {
+			missing = append(missing, "node "+string(node))
+		}
+	}
+	return missing
+}
+
+// missionLocked returns the unmet requirements of every campaign that lists
+// the mission, or nil if the player may take it.
+func missionLocked(p *Player, missionID string) []string {
+	contentMu.RLock()
+	defer contentMu.RUnlock()
+	for _, campaign := range CampaignRegistry {
+		for i, step := range campaign.Missions {
+			if step.MissionID != missionID {
+				continue
+			}
+			if missing := campaignMissing(p, &campaign, i); len(missing) > 0 {
+				return missing
+			}
+		}
+	}
+	return nil
+}
+
+// SelectMissionLocked starts missionID for the player from the mission-select
+// list. Locked missions are refused. If the mission has its own beacon layout
+// the room switches to it; failure cooldowns carry over. Callers must hold r.Mu.
+func (r *Room) SelectMissionLocked(p *Player, missionID string) error {
+	if p == nil {
+		return fmt.Errorf("nil player")
+	}
+	d := r.missionDirector
+	if missionID == "" && d != nil {
+		missionID = d.MissionID()
+	}
+	if missing := missionLocked(p, missionID); len(missing) > 0 {
+		return fmt.Errorf("mission %s is locked: %s", missionID, strings.Join(missing, ", "))
+	}
+	if err := r.missionBusyLocked(p); err != nil {
+		return err
+	}
+	if d != nil && (d.MissionID() == missionID || !hasMissionSpec(missionID)) {
+		return d.AcceptMission(r, p, missionID)
+	}
+	next := r.EnsureBeaconDirectorLocked(missionID)
+	if next == nil {
+		return fmt.Errorf("no beacon layout for mission %s", missionID)
+	}
+	if d == nil || next == d {
+		return next.AcceptMission(r, p, missionID)
+	}
+	next.retryAt = d.retryAt
+	if err := next.AcceptMission(r, p, missionID); err != nil {
+		// Keep playing the old layout rather than a half-started one.
+		r.missionDirector = d
+		return err
+	}
+	r.removeConvoyLocked(d.convoy)
+	d.endAllEncounters(r, "mission changed")
+	next.pendingEncounters = append(d.pendingEncounters, next.pendingEncounters...)
+	return nil
+}
+
+// missionBusyLocked refuses to replace a mission another human is still flying.
+// The room has one director, so in co-op only the party leader may switch.
+func (r *Room) missionBusyLocked(p *Player) error {
+	d := r.missionDirector
+	if d == nil || len(d.ActiveObjectives) == 0 {
+		return nil
+	}
+	if r.coop != nil {
+		if leaderID := r.partyLeaderLocked(); leaderID != p.ID {
+			return fmt.Errorf("only the party leader can change missions")
+		}
+		return nil
+	}
+	owner := r.Players[d.owner]
+	if owner == nil || owner.IsBot || owner.ID == p.ID {
+		return nil
+	}
+	return fmt.Errorf("%s is still flying mission %s", owner.Name, d.CurrentMissionID)
+}
+
+// recordMissionCompletedLocked marks the mission completed for the player and
+// sends the updated mission list so newly unlocked missions show up.
+func (r *Room) recordMissionCompletedLocked(p *Player, missionID string) {
+	if p == nil || p.IsBot || missionID == "" || p.CompletedMissions[missionID] {
+		return
+	}
+	if p.CompletedMissions == nil {
+		p.CompletedMissions = make(map[string]bool)
+	}
+	p.CompletedMissions[missionID] = true
+	if tmpl, err := GetTemplate(missionID); err == nil && len(tmpl.Reward) > 0 {
+		r.applyStoryEffectsLocked(p, missionID+"/reward", tmpl.Reward)
+	}
+	p.SendMessage("mission:list", r.MissionListLocked(p))
+}
+
+func hasMissionSpec(id string) bool {
+	contentMu.RLock()
+	defer contentMu.RUnlock()
+	_, ok := missionSpecs[id]
+	return ok
+}
@@ -0,0 +1,221 @@
+package game
+
+import (
+	"testing"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+func registerTestCampaign(t *testing.T) {
+	t.Helper()
+	for _, id := range []string{"test-c1", "test-c2", "test-c3"} {
+		TemplateRegistry[id] = MissionTemplate{
+			ID:              id,
+			DisplayName:     id,
+			Archetype:       ArchetypeKill,
+			ObjectiveParams: map[string]interface{}{"requiredKills": 1, "targetTag": "test-drone"},
+		}
+	}
+	CampaignRegistry["test-campaign"] = Campaign{
+		ID: "test-campaign",
+		Missions: []CampaignMission{
+			{MissionID: "test-c1"},
+			{MissionID: "test-c2", RequiresFlags: []string{"test.flag"}},
+			{MissionID: "test-c3", RequiresNodes: []dag.NodeID{"upgrade.wingman.slot_1"}},
+		},
+	}
+	t.Cleanup(func() {
+		for _, id := range []string{"test-c1", "test-c2", "test-c3"} {
+			delete(TemplateRegistry, id)
+		}
+		delete(CampaignRegistry, "test-campaign")
+	})
+}
+
+func missionEntry(t *testing.T, list MissionList, id string) MissionAvailability {
+	t.Helper()
+	for _, entry := range list.Missions {
+		if entry.MissionID == id {
+			return entry
+		}
+	}
+	t.Fatalf("mission %s not listed", id)
+	return MissionAvailability{}
+}
+
+func TestCampaignUnlocksMissionsInOrder(t *testing.T) {
+	registerTestCampaign(t)
+	room := newCombatTestRoom()
+	room.EnsureBeaconDirectorLocked("campaign-1")
+	player := &Player{ID: "p1", Name: "P1", StoryFlags: map[string]bool{}, DagState: dag.NewState()}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+
+	list := room.MissionListLocked(player)
+	if entry := missionEntry(t, list, "test-c1"); entry.Status != MissionStatusAvailable || entry.CampaignID != "test-campaign" {
+		t.Fatalf("expected the first mission to be available, got %+v", entry)
+	}
+	if entry := missionEntry(t, list, "test-c2"); entry.Status != MissionStatusLocked || len(entry.Missing) != 2 {
+		t.Fatalf("expected the second mission to need the first and a flag, got %+v", entry)
+	}
+	if err := room.SelectMissionLocked(player, "test-c2"); err == nil {
+		t.Fatal("expected a locked mission to be refused")
+	}
+
+	if err := room.SelectMissionLocked(player, "test-c1"); err != nil {
+		t.Fatalf("failed to select the first mission: %v", err)
+	}
+	drone := room.World.NewEntity()
+	room.World.SetComponent(drone, CompTags, &TagComponent{Tags: map[string]bool{"test-drone": true}})
+	room.World.SetComponent(drone, CompDestroyed, &DestroyedComponent{DestroyedAt: room.Now})
+	player.PendingMessages = nil
+	room.missionDirector.Tick(room)
+	if !player.CompletedMissions["test-c1"] {
+		t.Fatal("expected completing the objectives to complete the mission")
+	}
+	var sawList bool
+	for _, msg := range player.PendingMessages {
+		if msg.Type == "mission:list" {
+			sawList = true
+		}
+	}
+	if !sawList {
+		t.Fatal("expected a mission list update once the mission was completed")
+	}
+
+	player.StoryFlags["test.flag"] = true
+	if err := room.SelectMissionLocked(player, "test-c2"); err != nil {
+		t.Fatalf("expected the second mission to unlock: %v", err)
+	}
+	if entry := missionEntry(t, room.MissionListLocked(player), "test-c3"); entry.Status != MissionStatusLocked {
+		t.Fatalf("expected the third mission to stay locked, got %+v", entry)
+	}
+	player.CompletedMissions["test-c2"] = true
+	player.DagState.SetStatus("upgrade.wingman.slot_1", dag.StatusCompleted)
+	if entry := missionEntry(t, room.MissionListLocked(player), "test-c3"); entry.Status != MissionStatusAvailable {
+		t.Fatalf("expected the third mission to unlock with the upgrade, got %+v", entry)
+	}
+}
+
+func TestSelectMissionSwitchesLayout(t *testing.T) {
+	missionSpecs["test-layout"] = MissionSpec{ID: "test-layout", BeaconCount: 2, MinDistance: 2000, MaxAttempts: 30, DensityFactor: 1}
+	TemplateRegistry["test-layout"] = MissionTemplate{ID: "test-layout", DisplayName: "Layout", Archetype: ArchetypeTravel}
+	t.Cleanup(func() {
+		delete(missionSpecs, "test-layout")
+		delete(TemplateRegistry, "test-layout")
+	})
+
+	room := newCombatTestRoom()
+	first := room.EnsureBeaconDirectorLocked("campaign-1")
+	first.retryAt = map[string]float64{"campaign-1": 100}
+	player := &Player{ID: "p1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+
+	if err := room.SelectMissionLocked(player, "test-layout"); err != nil {
+		t.Fatalf("failed to select mission: %v", err)
+	}
+	d := room.BeaconDirectorLocked()
+	if d == first || d.MissionID() != "test-layout" || d.CurrentMissionID != "test-layout" {
+		t.Fatalf("expected the room to switch to the mission's layout, got %s", d.MissionID())
+	}
+	if d.retryAt["campaign-1"] != 100 {
+		t.Fatal("expected failure cooldowns to carry over")
+	}
+}
+
+func TestSelectMissionRespectsOtherPilotsAndEndsEncounters(t *testing.T) {
+	missionSpecs["test-layout"] = MissionSpec{ID: "test-layout", BeaconCount: 2, MinDistance: 2000, MaxAttempts: 30, DensityFactor: 1}
+	TemplateRegistry["test-layout"] = MissionTemplate{ID: "test-layout", DisplayName: "Layout", Archetype: ArchetypeTravel}
+	t.Cleanup(func() {
+		delete(missionSpecs, "test-layout")
+		delete(TemplateRegistry, "test-layout")
+	})
+
+	room := newCombatTestRoom()
+	first := room.EnsureBeaconDirectorLocked("campaign-1")
+	pilot := &Player{ID: "p1", Name: "P1"}
+	other := &Player{ID: "p2", Name: "P2"}
+	for _, p := range []*Player{pilot, other} {
+		room.Players[p.ID] = p
+		p.Ship = room.SpawnShip(p.ID, Vec2{X: 100, Y: 100})
+	}
+	if err := first.AcceptMission(room, other, "campaign-1"); err != nil {
+		t.Fatalf("failed to accept mission: %v", err)
+	}
+	drone := room.World.NewEntity()
+	room.World.SetComponent(drone, CompTransform, &Transform{Pos: Vec2{X: 500, Y: 500}})
+	first.encounters["enc-1"] = &EncounterState{ID: "enc-1", EntityIDs: []EntityID{drone}, ExpiresAt: 100}
+
+	if err := room.SelectMissionLocked(pilot, "test-layout"); err == nil {
+		t.Fatal("expected the switch to be refused while another pilot flies a mission")
+	}
+	if room.BeaconDirectorLocked() != first {
+		t.Fatal("expected the refused switch to keep the current director")
+	}
+
+	delete(room.Players, other.ID)
+	if err := room.SelectMissionLocked(pilot, "test-layout"); err != nil {
+		t.Fatalf("failed to select mission: %v", err)
+	}
+	if room.World.Exists(drone) {
+		t.Fatal("expected the old director's encounter to be despawned")
+	}
+	deltas := room.BeaconDirectorLocked().pendingEncounters
+	if len(deltas) == 0 || deltas[0].Type != EncounterDeltaPurged || deltas[0].Encounter.ID != "enc-1" {
+		t.Fatalf("expected the purge to be published by the new director, got %+v", deltas)
+	}
+}
+
+func TestSelectMissionKeepsLayoutWhenAcceptFails(t *testing.T) {
+	missionSpecs["test-layout"] = MissionSpec{ID: "test-layout", BeaconCount: 2, MinDistance: 2000, MaxAttempts: 30, DensityFactor: 1}
+	TemplateRegistry["test-layout"] = MissionTemplate{
+		ID:              "test-layout",
+		DisplayName:     "Layout",
+		Archetype:       ArchetypeEscort,
+		ObjectiveParams: map[string]interface{}{"convoyHull": "no-such-hull"},
+	}
+	t.Cleanup(func() {
+		delete(missionSpecs, "test-layout")
+		delete(TemplateRegistry, "test-layout")
+	})
+
+	room := newCombatTestRoom()
+	first := room.EnsureBeaconDirectorLocked("campaign-1")
+	player := &Player{ID: "p1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+	drone := room.World.NewEntity()
+	room.World.SetComponent(drone, CompTransform, &Transform{Pos: Vec2{X: 500, Y: 500}})
+	first.encounters["enc-1"] = &EncounterState{ID: "enc-1", EntityIDs: []EntityID{drone}, ExpiresAt: 100}
+
+	if err := room.SelectMissionLocked(player, "test-layout"); err == nil {
+		t.Fatal("expected the broken escort to be refused")
+	}
+	if room.BeaconDirectorLocked() != first || !room.World.Exists(drone) {
+		t.Fatal("expected the room to stay on its old layout with its encounters")
+	}
+}
+
+func TestCheckContentReferencesCampaigns(t *testing.T) {
+	if err := dag.Init(dag.SeedNodes()); err != nil {
+		t.Fatalf("failed to init DAG: %v", err)
+	}
+	reg := currentRegistries().clone()
+	reg.campaigns["test-broken"] = Campaign{
+		ID: "test-broken",
+		Missions: []CampaignMission{
+			{MissionID: "no-such-mission"},
+			{MissionID: "campaign-1", RequiresNodes: []dag.NodeID{"no.such.node"}},
+		},
+	}
+	issues := reg.check(dag.GetGraph(), map[string]string{"campaign:test-broken": "campaign.json"})
+	if len(issues) != 2 {
+		t.Fatalf("expected two campaign issues, got %v", issues)
+	}
+	for _, issue := range issues {
+		if issue.Source != "campaign.json" {
+			t.Errorf("unexpected issue %s", issue)
+		}
+	}
+}
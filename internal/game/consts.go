@@ -21,6 +21,7 @@ const (
 	MissileLifetimeAgroPenalty  = 40.0
 	MissileLifetimeAgroRef      = 2000.0
 	MissileHitRadius            = 50.0
+	MissileInterceptRadius      = 40.0 // missile-vs-missile collision distance
 	MissileBaseCooldown         = 2.0
 	MissileCooldownScale        = 8.0
 
@@ -47,6 +48,30 @@ const (
 	MissileHeatKUp         = 28.0  // Heats up faster than ships
 	MissileHeatKDown       = 12.0  // Cools down slower than ships
 	MissileHeatExp         = 1.5   // Same response curve as ships
+
+	// Point-defense defaults for ships
+	PointDefenseRange     = 350.0 // Close-in engagement envelope
+	PointDefenseCooldown  = 0.8   // Seconds between shots
+	PointDefenseHeatCost  = 4.0   // Heat per shot
+	PointDefenseHitChance = 0.55  // Per-shot kill probability
+
+	// Ship subsystem damage defaults
+	SubsystemHitDamage     = 0.5    // Health lost by a subsystem per HP of hull damage
+	SubsystemMinEfficiency = 0.35   // Output of a fully destroyed subsystem
+	SubsystemRepairDelay   = 6.0    // Seconds after a hit before passive repair starts
+	SubsystemRepairRate    = 0.025  // Health regained per second by passive repair
+	SensorMaxRange         = 9200.0 // Perception range with undamaged sensors (covers the map)
+	RepairKitAmount        = 0.6    // Health restored to every subsystem by a repair kit
+
+	// Active heat management defaults
+	HeatVentAmount           = 50.0 // Heat dumped by an emergency vent
+	HeatVentCooldown         = 25.0 // Seconds between vents
+	HeatVentSignatureSeconds = 3.0  // How long a vent plume stays visible to others
+	RadiatorKDownMultiplier  = 3.0  // Cooling boost from deployed radiators while stationary
+	RadiatorToggleCooldown   = 2.0  // Seconds to deploy or stow radiators
+	RadiatorStationarySpeed  = 5.0  // Max speed at which deployed radiators work
+	HeatSinkAmount           = 35.0 // Heat absorbed by one heat sink
+	HeatSinkCooldown         = 4.0  // Seconds between heat sinks
 )
 
 // MissilePresetType represents different missile configurations
@@ -56,6 +81,7 @@ const (
 	MissilePresetScout MissilePresetType = iota
 	MissilePresetHunter
 	MissilePresetSniper
+	MissilePresetInterceptor
 )
 
 // GetMissilePreset returns a configured missile config for the given preset
@@ -109,6 +135,15 @@ func GetMissilePreset(preset MissilePresetType) MissileConfig {
 			},
 		})
 
+	case MissilePresetInterceptor:
+		// Fast, short-lived, hunts hostile missiles instead of ships
+		return SanitizeMissileConfig(MissileConfig{
+			Speed:      240.0,
+			AgroRadius: 500.0,
+			HeatParams: DefaultMissileHeatParams(),
+			Guidance:   MissileGuidanceInterceptor,
+		})
+
 	default:
 		return SanitizeMissileConfig(MissileConfig{
 			Speed:      150.0,
@@ -0,0 +1,402 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// ContentMission is a mission as written in a content file: the template plus
+// the beacon layout registered under the same ID.
+type ContentMission struct {
+	MissionTemplate
+	Layout MissionSpec
+}
+
+// ContentSet holds the missions, campaigns, encounters, spawn tables and DAG
+// nodes read from a content directory.
+type ContentSet struct {
+	Missions    []ContentMission
+	Campaigns   []Campaign
+	Encounters  []EncounterTemplate
+	SpawnTables []SpawnTable
+	Nodes       []*dag.Node
+	Sources     map[string]string // "kind:id" -> file that defined it
+}
+
+// contentFile is the top-level shape of a content file. Every section is
+// optional so content can be split across files however designers like.
+type contentFile struct {
+	Missions    []json.RawMessage
+	Campaigns   []json.RawMessage
+	Encounters  []json.RawMessage
+	SpawnTables []json.RawMessage
+	Nodes       []json.RawMessage
+}
+
+// LoadContentDir reads every *.json file under dir, in path order. Errors
+// name the file and the offending entry, e.g.
+// "missions.json: encounters[2]: unknown formation: wedge". A missing
+// directory yields an empty set.
+func LoadContentDir(dir string) (*ContentSet, error) {
+	set := &ContentSet{Sources: make(map[string]string)}
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return set, nil
+		}
+		return nil, fmt.Errorf("read content dir %q: %w", dir, err)
+	}
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(d.Name(), ".json") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read content dir %q: %w", dir, err)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read content %q: %w", path, err)
+		}
+		if err := set.parse(path, data); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return set, nil
+}
+
+// parse decodes one content file into the set.
+func (c *ContentSet) parse(source string, data []byte) error {
+	var file contentFile
+	if err := decodeContent(data, &file); err != nil {
+		return err
+	}
+	for i, raw := range file.Missions {
+		var m ContentMission
+		if err := decodeContent(raw, &m); err != nil {
+			return fmt.Errorf("missions[%d]: %w", i, err)
+		}
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("missions[%d]: %w", i, err)
+		}
+		if err := c.claim("mission", m.ID, source); err != nil {
+			return fmt.Errorf("missions[%d]: %w", i, err)
+		}
+		m.Layout.ID = m.ID
+		c.Missions = append(c.Missions, m)
+	}
+	for i, raw := range file.Campaigns {
+		var campaign Campaign
+		if err := decodeContent(raw, &campaign); err != nil {
+			return fmt.Errorf("campaigns[%d]: %w", i, err)
+		}
+		if campaign.ID == "" {
+			return fmt.Errorf("campaigns[%d].id: cannot be empty", i)
+		}
+		if err := c.claim("campaign", campaign.ID, source); err != nil {
+			return fmt.Errorf("campaigns[%d]: %w", i, err)
+		}
+		c.Campaigns = append(c.Campaigns, campaign)
+	}
+	for i, raw := range file.Encounters {
+		var t EncounterTemplate
+		if err := decodeContent(raw, &t); err != nil {
+			return fmt.Errorf("encounters[%d]: %w", i, err)
+		}
+		if err := validateEncounterContent(&t); err != nil {
+			return fmt.Errorf("encounters[%d]: %w", i, err)
+		}
+		if err := c.claim("encounter", t.ID, source); err != nil {
+			return fmt.Errorf("encounters[%d]: %w", i, err)
+		}
+		c.Encounters = append(c.Encounters, t)
+	}
+	for i, raw := range file.SpawnTables {
+		var table SpawnTable
+		if err := decodeContent(raw, &table); err != nil {
+			return fmt.Errorf("spawnTables[%d]: %w", i, err)
+		}
+		if table.ID == "" {
+			return fmt.Errorf("spawnTables[%d].id: cannot be empty", i)
+		}
+		if err := c.claim("spawnTable", table.ID, source); err != nil {
+			return fmt.Errorf("spawnTables[%d]: %w", i, err)
+		}
+		c.SpawnTables = append(c.SpawnTables, table)
+	}
+	for i, raw := range file.Nodes {
+		var node dag.Node
+		if err := decodeContent(raw, &node); err != nil {
+			return fmt.Errorf("nodes[%d]: %w", i, err)
+		}
+		if node.ID == "" {
+			return fmt.Errorf("nodes[%d].id: cannot be empty", i)
+		}
+		if err := c.claim("node", string(node.ID), source); err != nil {
+			return fmt.Errorf("nodes[%d]: %w", i, err)
+		}
+		c.Nodes = append(c.Nodes, &node)
+	}
+	return nil
+}
+
+// claim records where a definition came from and rejects duplicates.
+func (c *ContentSet) claim(kind, id, source string) error {
+	key := kind + ":" + id
+	if prev, ok := c.Sources[key]; ok {
+		return fmt.Errorf("duplicate %s %s (also defined in %s)", kind, id, prev)
+	}
+	c.Sources[key] = source
+	return nil
+}
+
+// contentMu guards the mission, campaign, encounter and spawn table registries
+// so a reload can swap them while rooms are running.
+var contentMu sync.RWMutex
+
+// contentRegistries is one generation of the content registries.
+type contentRegistries struct {
+	templates   map[string]MissionTemplate
+	specs       map[string]MissionSpec
+	campaigns   map[string]Campaign
+	encounters  map[string]EncounterTemplate
+	spawnTables map[string]SpawnTable
+}
+
+// builtinContent is the content compiled into the server; every reload starts
+// from it so content removed from disk disappears again.
+var builtinContent = currentRegistries().clone()
+
+// currentRegistries returns the live registries. Callers hold contentMu
+// unless nothing can be reloading.
+func currentRegistries() contentRegistries {
+	return contentRegistries{
+		templates:   TemplateRegistry,
+		specs:       missionSpecs,
+		campaigns:   CampaignRegistry,
+		encounters:  EncounterRegistry,
+		spawnTables: SpawnTableRegistry,
+	}
+}
+
+func (reg contentRegistries) clone() contentRegistries {
+	return contentRegistries{
+		templates:   maps.Clone(reg.templates),
+		specs:       maps.Clone(reg.specs),
+		campaigns:   maps.Clone(reg.campaigns),
+		encounters:  maps.Clone(reg.encounters),
+		spawnTables: maps.Clone(reg.spawnTables),
+	}
+}
+
+func installRegistries(reg contentRegistries) {
+	contentMu.Lock()
+	defer contentMu.Unlock()
+	setRegistries(reg)
+}
+
+// setRegistries makes reg live. Callers must hold contentMu for writing.
+func setRegistries(reg contentRegistries) {
+	TemplateRegistry = reg.templates
+	missionSpecs = reg.specs
+	CampaignRegistry = reg.campaigns
+	EncounterRegistry = reg.encounters
+	SpawnTableRegistry = reg.spawnTables
+}
+
+// applyTo adds the set to reg, replacing definitions with the same ID.
+func (c *ContentSet) applyTo(reg contentRegistries) {
+	for _, m := range c.Missions {
+		reg.templates[m.ID] = m.MissionTemplate
+		reg.specs[m.ID] = m.Layout
+	}
+	for _, campaign := range c.Campaigns {
+		reg.campaigns[campaign.ID] = campaign
+	}
+	for _, t := range c.Encounters {
+		reg.encounters[t.ID] = t
+	}
+	for _, table := range c.SpawnTables {
+		reg.spawnTables[table.ID] = table
+	}
+}
+
+// ContentError lists the broken references that stopped a content load.
+type ContentError struct {
+	Issues []ContentIssue
+}
+
+func (e *ContentError) Error() string {
+	lines := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		lines[i] = issue.String()
+	}
+	return fmt.Sprintf("%d content problems:\n%s", len(e.Issues), strings.Join(lines, "\n"))
+}
+
+// ReloadContent loads dir over the built-in content, builds the DAG from the
+// built-in and content nodes and checks every reference between them. Only if
+// all of that succeeds are the registries and DAG swapped in, so rooms never
+// see a half-loaded set; on failure the current content stays live and the
+// error says why (a *ContentError for broken references).
+func ReloadContent(dir string) (*ContentSet, error) {
+	set, err := LoadContentDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	reg := builtinContent.clone()
+	set.applyTo(reg)
+	graph, err := dag.NewGraph(set.MergeNodes(dag.SeedNodes()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold contentMu from adding the generated missions until the new
+	// registries are live, so a mission generated meanwhile is not lost.
+	contentMu.Lock()
+	stale := applyGeneratedMissionsLocked(reg)
+	if issues := reg.check(graph, set.Sources); len(issues) > 0 {
+		contentMu.Unlock()
+		return nil, &ContentError{Issues: issues}
+	}
+	dropGeneratedMissionsLocked(stale)
+	setRegistries(reg)
+	contentMu.Unlock()
+	dag.SetGraph(graph)
+	return set, nil
+}
+
+// MergeNodes returns base with the set's nodes replacing those with the same
+// ID and the rest appended, ready for dag.Init.
+func (c *ContentSet) MergeNodes(base []*dag.Node) []*dag.Node {
+	index := make(map[dag.NodeID]int, len(base))
+	merged := make([]*dag.Node, 0, len(base)+len(c.Nodes))
+	for _, node := range base {
+		index[node.ID] = len(merged)
+		merged = append(merged, node)
+	}
+	for _, node := range c.Nodes {
+		if i, ok := index[node.ID]; ok {
+			merged[i] = node
+			continue
+		}
+		index[node.ID] = len(merged)
+		merged = append(merged, node)
+	}
+	return merged
+}
+
+// validateEncounterContent checks the names an encounter refers to.
+func validateEncounterContent(t *EncounterTemplate) error {
+	if t.ID == "" {
+		return fmt.Errorf("id: cannot be empty")
+	}
+	if err := validateSpawnGroups("spawnGroups", t.SpawnGroups); err != nil {
+		return err
+	}
+	if t.Boss == nil {
+		return nil
+	}
+	if t.Boss.Hull != "" {
+		if _, err := GetHull(t.Boss.Hull); err != nil {
+			return fmt.Errorf("boss.hull: %w", err)
+		}
+	}
+	for i, phase := range t.Boss.Phases {
+		if err := validateSpawnGroups(fmt.Sprintf("boss.phases[%d].spawnGroups", i), phase.SpawnGroups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSpawnGroups(field string, groups []SpawnGroup) error {
+	for i, group := range groups {
+		if !spawnEntityTypes[group.EntityType] {
+			return fmt.Errorf("%s[%d].entityType: unknown entity type: %s", field, i, group.EntityType)
+		}
+		if group.Formation != "" && !formationNames[group.Formation] {
+			return fmt.Errorf("%s[%d].formation: unknown formation: %s", field, i, group.Formation)
+		}
+		if group.Hull != "" {
+			if _, err := GetHull(group.Hull); err != nil {
+				return fmt.Errorf("%s[%d].hull: %w", field, i, err)
+			}
+		}
+		if group.Behavior != "" {
+			if _, err := NewAIBehavior(group.Behavior); err != nil {
+				return fmt.Errorf("%s[%d].behavior: %w", field, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes an encounter whose waypointGen is a
+// WaypointGeneratorSpec such as {"type": "circular", "radius": 800}.
+func (t *EncounterTemplate) UnmarshalJSON(data []byte) error {
+	type plain EncounterTemplate
+	var raw struct {
+		*plain
+		WaypointGen *WaypointGeneratorSpec
+	}
+	raw.plain = (*plain)(t)
+	if err := decodeContent(data, &raw); err != nil {
+		return err
+	}
+	gen, err := waypointGeneratorFromSpec(raw.WaypointGen)
+	if err != nil {
+		return fmt.Errorf("waypointGen: %w", err)
+	}
+	t.WaypointGen = gen
+	return nil
+}
+
+// UnmarshalJSON decodes a boss phase whose waypointGen is a
+// WaypointGeneratorSpec.
+func (p *BossPhase) UnmarshalJSON(data []byte) error {
+	type plain BossPhase
+	var raw struct {
+		*plain
+		WaypointGen *WaypointGeneratorSpec
+	}
+	raw.plain = (*plain)(p)
+	if err := decodeContent(data, &raw); err != nil {
+		return err
+	}
+	gen, err := waypointGeneratorFromSpec(raw.WaypointGen)
+	if err != nil {
+		return fmt.Errorf("waypointGen: %w", err)
+	}
+	p.WaypointGen = gen
+	return nil
+}
+
+func waypointGeneratorFromSpec(spec *WaypointGeneratorSpec) (WaypointGenerator, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	return NewWaypointGenerator(*spec)
+}
+
+// decodeContent decodes one JSON value, rejecting fields the target type does
+// not have so typos in content files are reported instead of ignored.
+func decodeContent(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
@@ -0,0 +1,237 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// contentBuiltIn is the source reported for definitions compiled into the server.
+const contentBuiltIn = "built-in"
+
+// ContentIssue is a broken reference found by CheckContentReferences.
+type ContentIssue struct {
+	Source  string // File that defined the entry, or "built-in"
+	Field   string // Entry and field at fault, e.g. "missions[campaign-1].storyNodeID"
+	Message string
+}
+
+func (i ContentIssue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Source, i.Field, i.Message)
+}
+
+// contentChecker accumulates issues while walking a set of registries.
+type contentChecker struct {
+	reg     contentRegistries
+	graph   *dag.Graph
+	sources map[string]string
+	issues  []ContentIssue
+}
+
+func (c *contentChecker) report(kind, id, field, format string, args ...any) {
+	source, ok := c.sources[kind+":"+id]
+	if !ok {
+		source = contentBuiltIn
+	}
+	c.issues = append(c.issues, ContentIssue{Source: source, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// CheckContentReferences cross-checks every reference between the registered
+// missions, campaigns, encounters, spawn tables and the DAG in graph: story
+// nodes and story trigger nodes, campaign missions and requirements, encounter
+// refs, spawn table and spawner child encounters and the waves of
+// spawn_encounter story effects. Leftover payload directives are reported too.
+// sources maps "kind:id" to the file that defined it, as in
+// ContentSet.Sources; anything else is reported as built-in. Issues are sorted
+// by source and field.
+func CheckContentReferences(graph *dag.Graph, sources map[string]string) []ContentIssue {
+	contentMu.RLock()
+	defer contentMu.RUnlock()
+	return currentRegistries().check(graph, sources)
+}
+
+func (reg contentRegistries) check(graph *dag.Graph, sources map[string]string) []ContentIssue {
+	c := &contentChecker{reg: reg, graph: graph, sources: sources}
+	if graph == nil {
+		c.report("", "", "nodes", "DAG is not initialized")
+		return c.issues
+	}
+
+	for id, tmpl := range reg.templates {
+		field := fmt.Sprintf("missions[%s]", id)
+		if err := tmpl.Validate(); err != nil {
+			c.report("mission", id, field, "%v", err)
+		}
+		if tmpl.StoryNodeID != "" {
+			c.checkNode("mission", id, field+".storyNodeID", tmpl.StoryNodeID, dag.NodeKindStory)
+		}
+		if tmpl.FailureStoryNodeID != "" {
+			c.checkNode("mission", id, field+".failureStoryNodeID", tmpl.FailureStoryNodeID, dag.NodeKindStory)
+		}
+		for i, trigger := range tmpl.StoryTriggers {
+			if trigger.Node != "" {
+				c.checkNode("mission", id, fmt.Sprintf("%s.storyTriggers[%d].node", field, i), string(trigger.Node), dag.NodeKindStory)
+			}
+		}
+		for i, effect := range tmpl.Reward {
+			if err := effect.Validate(graph); err != nil {
+				c.report("mission", id, fmt.Sprintf("%s.reward[%d]", field, i), "%v", err)
+			}
+		}
+		for i, ref := range tmpl.EncounterRefs {
+			if !c.encounterRefExists(ref) {
+				c.report("mission", id, fmt.Sprintf("%s.encounterRefs[%d]", field, i), "unknown encounter: %s", ref)
+			}
+		}
+		if hull, ok := tmpl.ObjectiveParams["convoyHull"].(string); ok && tmpl.Archetype == ArchetypeEscort {
+			if _, err := GetHull(hull); err != nil {
+				c.report("mission", id, field+".objectiveParams.convoyHull", "%v", err)
+			}
+		}
+		if spec, ok := reg.specs[id]; ok && spec.SpawnTableID != "" {
+			if _, ok := reg.spawnTables[spec.SpawnTableID]; !ok {
+				c.report("mission", id, field+".layout.spawnTableID", "unknown spawn table: %s", spec.SpawnTableID)
+			}
+		}
+	}
+
+	for id, campaign := range reg.campaigns {
+		for i, step := range campaign.Missions {
+			field := fmt.Sprintf("campaigns[%s].missions[%d]", id, i)
+			if _, ok := reg.templates[step.MissionID]; !ok {
+				c.report("campaign", id, field+".missionID", "unknown mission: %s", step.MissionID)
+			}
+			for j, flag := range step.RequiresFlags {
+				if flag == "" {
+					c.report("campaign", id, fmt.Sprintf("%s.requiresFlags[%d]", field, j), "empty story flag")
+				}
+			}
+			for j, node := range step.RequiresNodes {
+				if graph.GetNode(node) == nil {
+					c.report("campaign", id, fmt.Sprintf("%s.requiresNodes[%d]", field, j), "unknown node: %s", node)
+				}
+			}
+		}
+	}
+
+	for id, tmpl := range reg.encounters {
+		field := fmt.Sprintf("encounters[%s]", id)
+		if err := validateEncounterContent(&tmpl); err != nil {
+			c.report("encounter", id, field, "%v", err)
+		}
+		c.checkChildTemplates(id, field+".spawnGroups", tmpl.SpawnGroups)
+		if tmpl.Boss != nil {
+			for i, phase := range tmpl.Boss.Phases {
+				phaseField := fmt.Sprintf("%s.boss.phases[%d]", field, i)
+				c.checkChildTemplates(id, phaseField+".spawnGroups", phase.SpawnGroups)
+				if phase.StoryNode != "" {
+					c.checkNode("encounter", id, phaseField+".storyNode", phase.StoryNode, dag.NodeKindStory)
+				}
+			}
+		}
+	}
+
+	for id, table := range reg.spawnTables {
+		for i, rule := range table.Rules {
+			for j, enc := range rule.Encounters {
+				if _, ok := reg.encounters[enc.EncounterID]; !ok {
+					c.report("spawnTable", id, fmt.Sprintf("spawnTables[%s].rules[%d].encounters[%d].encounterID", id, i, j), "unknown encounter: %s", enc.EncounterID)
+				}
+			}
+		}
+	}
+
+	for wave, encID := range waveEncounterMap {
+		if _, ok := reg.encounters[encID]; !ok {
+			c.report("", "", fmt.Sprintf("waves[%d]", wave), "unknown encounter: %s", encID)
+		}
+	}
+
+	for id, node := range graph.Nodes {
+		c.checkNodeEffects(string(id), node)
+	}
+
+	sort.Slice(c.issues, func(i, j int) bool {
+		if c.issues[i].Source != c.issues[j].Source {
+			return c.issues[i].Source < c.issues[j].Source
+		}
+		if c.issues[i].Field != c.issues[j].Field {
+			return c.issues[i].Field < c.issues[j].Field
+		}
+		return c.issues[i].Message < c.issues[j].Message
+	})
+	return c.issues
+}
+
+// checkNode reports ref unless it names a DAG node of the given kind.
+func (c *contentChecker) checkNode(kind, id, field, ref string, want dag.NodeKind) {
+	node := c.graph.GetNode(dag.NodeID(ref))
+	if node == nil {
+		c.report(kind, id, field, "unknown node: %s", ref)
+		return
+	}
+	if node.Kind != want {
+		c.report(kind, id, field, "node %s is %s, not %s", ref, node.Kind, want)
+	}
+}
+
+func (c *contentChecker) checkChildTemplates(id, field string, groups []SpawnGroup) {
+	for i, group := range groups {
+		if group.EntityType != "spawner" {
+			continue
+		}
+		if _, ok := c.reg.encounters[group.ChildTemplate]; !ok {
+			c.report("encounter", id, fmt.Sprintf("%s[%d].childTemplate", field, i), "unknown encounter: %s", group.ChildTemplate)
+		}
+	}
+}
+
+// legacyNodeDirectives are payload keys that story_effects replaced.
+var legacyNodeDirectives = []string{"grant_upgrade", "spawn_encounter", "encounter_wave", "encounter_beacon"}
+
+// checkNodeEffects checks what dag.Init cannot: that spawn_encounter effects
+// name a mission wave, and that no node still relies on payload directives.
+func (c *contentChecker) checkNodeEffects(id string, node *dag.Node) {
+	field := fmt.Sprintf("nodes[%s]", id)
+	for _, key := range legacyNodeDirectives {
+		if _, ok := node.Payload[key]; ok {
+			c.report("node", id, field+".payload."+key, "payload directives are no longer applied; use story_effects")
+		}
+	}
+	check := func(field string, effects []dag.StoryEffect) {
+		for i, effect := range effects {
+			if effect.Type != dag.StoryEffectSpawnEncounter {
+				continue
+			}
+			if _, ok := waveEncounterMap[effect.Wave]; !ok {
+				c.report("node", id, fmt.Sprintf("%s[%d].wave", field, i), "unknown wave: %d", effect.Wave)
+			}
+		}
+	}
+	check(field+".story_effects", node.StoryEffects)
+	if node.Dialogue != nil {
+		for _, choice := range node.Dialogue.Choices {
+			check(fmt.Sprintf("%s.dialogue.choices[%s].effects", field, choice.ID), choice.Effects)
+		}
+	}
+}
+
+// encounterRefExists reports whether ref names an encounter template or a
+// mission wave such as "wave-2".
+func (c *contentChecker) encounterRefExists(ref string) bool {
+	if _, ok := c.reg.encounters[ref]; ok {
+		return true
+	}
+	if n, ok := strings.CutPrefix(ref, "wave-"); ok {
+		wave, err := strconv.Atoi(n)
+		if err != nil {
+			return false
+		}
+		_, ok := waveEncounterMap[wave]
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,223 @@
+package game
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+const testContent = `{
+  "missions": [{
+    "id": "test-content-mission",
+    "displayName": "Test Mission",
+    "archetype": "kill",
+    "objectiveParams": {"requiredKills": 2, "targetTag": "drone"},
+    "layout": {"beaconCount": 3, "spawnTableID": "test-content-table"}
+  }],
+  "encounters": [{
+    "id": "test-content-encounter",
+    "displayName": "Test Encounter",
+    "spawnGroups": [{
+      "entityType": "gunship",
+      "count": {"min": 1, "max": 2},
+      "formation": "ring",
+      "weapon": {"warhead": "fragmentation", "guidance": "interceptor", "cooldown": 4}
+    }],
+    "waypointGen": {"type": "circular", "radius": 600, "pointCount": 4},
+    "boss": {"hull": "destroyer", "phases": [{"name": "open", "waypointGen": {"type": "random", "radius": 300, "pointCount": 3}}]}
+  }],
+  "spawnTables": [{
+    "id": "test-content-table",
+    "rules": [{"encounters": [{"encounterID": "test-content-encounter", "weight": 1}]}]
+  }],
+  "nodes": [{
+    "id": "upgrade.test.content",
+    "kind": "upgrade",
+    "label": "Test Upgrade",
+    "duration_s": 10,
+    "effects": [{"type": "heat_capacity", "value": 1.2}]
+  }]
+}`
+
+func writeContentFile(t *testing.T, dir, name, data string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadContentDir(t *testing.T) {
+	dir := t.TempDir()
+	writeContentFile(t, dir, "test.json", testContent)
+	set, err := LoadContentDir(dir)
+	if err != nil {
+		t.Fatalf("failed to load content: %v", err)
+	}
+	if len(set.Missions) != 1 || len(set.Encounters) != 1 || len(set.SpawnTables) != 1 || len(set.Nodes) != 1 {
+		t.Fatalf("unexpected content counts %+v", set)
+	}
+
+	mission := set.Missions[0]
+	if mission.Archetype != ArchetypeKill || mission.Layout.ID != mission.ID || mission.Layout.BeaconCount != 3 {
+		t.Fatalf("unexpected mission %+v", mission)
+	}
+	enc := set.Encounters[0]
+	if gen, ok := enc.WaypointGen.(CircularPathGenerator); !ok || gen.Radius != 600 || gen.PointCount != 4 {
+		t.Fatalf("expected a circular waypoint generator, got %#v", enc.WaypointGen)
+	}
+	weapon := enc.SpawnGroups[0].Weapon
+	if weapon.Warhead != WarheadFragmentation || weapon.Guidance != MissileGuidanceInterceptor {
+		t.Fatalf("unexpected weapon %+v", weapon)
+	}
+	if _, ok := enc.Boss.Phases[0].WaypointGen.(RandomPathGenerator); !ok {
+		t.Fatalf("expected a random boss phase path, got %#v", enc.Boss.Phases[0].WaypointGen)
+	}
+	node := set.Nodes[0]
+	if node.Kind != dag.NodeKindUpgrade || node.Effects[0].Type != dag.EffectHeatCapacity {
+		t.Fatalf("unexpected node %+v", node)
+	}
+	if set.Sources["encounter:test-content-encounter"] != filepath.Join(dir, "test.json") {
+		t.Fatalf("expected sources to record the file, got %v", set.Sources)
+	}
+
+	base := []*dag.Node{{ID: "upgrade.test.content", Label: "Old"}, {ID: "craft.other"}}
+	merged := set.MergeNodes(base)
+	if len(merged) != 2 || merged[0].Label != "Test Upgrade" {
+		t.Fatalf("expected content nodes to replace base nodes by ID, got %d nodes", len(merged))
+	}
+}
+
+func TestReloadContentSwapsOnlyValidContent(t *testing.T) {
+	saved, savedGraph := currentRegistries(), dag.GetGraph()
+	t.Cleanup(func() {
+		installRegistries(saved)
+		dag.SetGraph(savedGraph)
+	})
+
+	dir := t.TempDir()
+	writeContentFile(t, dir, "test.json", testContent)
+	if _, err := ReloadContent(dir); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if _, err := GetTemplate("test-content-mission"); err != nil {
+		t.Fatalf("expected the mission to be registered: %v", err)
+	}
+	if _, err := GetSpawnTable("test-content-table"); err != nil {
+		t.Fatalf("expected the spawn table to be registered: %v", err)
+	}
+	if dag.GetGraph().GetNode("upgrade.test.content") == nil || dag.GetGraph().GetNode("story.signal-static-1.start") == nil {
+		t.Fatal("expected the DAG to hold both seed and content nodes")
+	}
+
+	writeContentFile(t, dir, "broken.json", `{"spawnTables": [{"id": "broken", "rules": [{"encounters": [{"encounterID": "nowhere", "weight": 1}]}]}]}`)
+	_, err := ReloadContent(dir)
+	var contentErr *ContentError
+	if !errors.As(err, &contentErr) || len(contentErr.Issues) != 1 {
+		t.Fatalf("expected one broken reference, got %v", err)
+	}
+	if _, err := GetSpawnTable("broken"); err == nil {
+		t.Fatal("expected a failed reload to leave the registries alone")
+	}
+	if _, err := GetTemplate("test-content-mission"); err != nil {
+		t.Fatal("expected a failed reload to keep the previous content")
+	}
+
+	if err := os.Remove(filepath.Join(dir, "test.json")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "broken.json")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReloadContent(dir); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if _, err := GetTemplate("test-content-mission"); err == nil {
+		t.Fatal("expected content removed from disk to be unregistered")
+	}
+	if _, err := GetTemplate("campaign-1"); err != nil {
+		t.Fatal("expected built-in content to survive reloads")
+	}
+}
+
+func TestLoadContentDirRejectsBadContent(t *testing.T) {
+	cases := map[string]string{
+		"unknown field":     `{"encounters": [{"id": "x", "spawnGroup": []}]}`,
+		"unknown formation": `{"encounters": [{"id": "x", "spawnGroups": [{"entityType": "mine", "formation": "wedge"}]}]}`,
+		"entity type":       `{"encounters": [{"id": "x", "spawnGroups": [{"entityType": "dragon"}]}]}`,
+		"generator":         `{"encounters": [{"id": "x", "waypointGen": {"type": "spiral"}}]}`,
+		"warhead":           `{"encounters": [{"id": "x", "spawnGroups": [{"entityType": "gunship", "weapon": {"warhead": "nuke"}}]}]}`,
+		"archetype":         `{"missions": [{"id": "x", "displayName": "X", "archetype": "heist"}]}`,
+		"effect type":       `{"nodes": [{"id": "x", "effects": [{"type": "teleport"}]}]}`,
+		"missing id":        `{"spawnTables": [{"displayName": "X"}]}`,
+	}
+	for name, data := range cases {
+		dir := t.TempDir()
+		writeContentFile(t, dir, "bad.json", data)
+		if _, err := LoadContentDir(dir); err == nil {
+			t.Errorf("%s: expected an error", name)
+		} else if !strings.Contains(err.Error(), "bad.json") {
+			t.Errorf("%s: expected the error to name the file, got %v", name, err)
+		}
+	}
+
+	dir := t.TempDir()
+	writeContentFile(t, dir, "a.json", `{"spawnTables": [{"id": "dup"}]}`)
+	writeContentFile(t, dir, "b.json", `{"spawnTables": [{"id": "dup"}]}`)
+	if _, err := LoadContentDir(dir); err == nil || !strings.Contains(err.Error(), "duplicate spawnTable dup") {
+		t.Fatalf("expected a duplicate ID error, got %v", err)
+	}
+}
+
+func TestBundledContentLoads(t *testing.T) {
+	set, err := LoadContentDir(filepath.Join("..", "..", "configs", "content"))
+	if err != nil {
+		t.Fatalf("bundled content failed to load: %v", err)
+	}
+	if len(set.Missions) == 0 {
+		t.Fatal("expected bundled content to define a mission")
+	}
+}
+
+func TestCheckContentReferences(t *testing.T) {
+	if err := dag.Init(dag.SeedNodes()); err != nil {
+		t.Fatalf("failed to init DAG: %v", err)
+	}
+	if issues := CheckContentReferences(dag.GetGraph(), nil); len(issues) != 0 {
+		t.Fatalf("expected built-in content to be consistent, got %v", issues)
+	}
+
+	TemplateRegistry["test-broken"] = MissionTemplate{
+		ID:            "test-broken",
+		DisplayName:   "Broken",
+		StoryNodeID:   "upgrade.ship.speed_1",
+		EncounterRefs: []string{"wave-1", "wave-99", "no-such-encounter"},
+	}
+	SpawnTableRegistry["test-broken"] = SpawnTable{
+		ID:    "test-broken",
+		Rules: []SpawnRule{{Encounters: []WeightedEncounter{{EncounterID: "no-such-encounter", Weight: 1}}}},
+	}
+	t.Cleanup(func() {
+		delete(TemplateRegistry, "test-broken")
+		delete(SpawnTableRegistry, "test-broken")
+	})
+	sources := map[string]string{"mission:test-broken": "broken.json"}
+	issues := CheckContentReferences(dag.GetGraph(), sources)
+	want := map[string]string{
+		"missions[test-broken].storyNodeID":                           "broken.json",
+		"missions[test-broken].encounterRefs[1]":                      "broken.json",
+		"missions[test-broken].encounterRefs[2]":                      "broken.json",
+		"spawnTables[test-broken].rules[0].encounters[0].encounterID": contentBuiltIn,
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("expected %d issues, got %v", len(want), issues)
+	}
+	for _, issue := range issues {
+		if source, ok := want[issue.Field]; !ok || source != issue.Source {
+			t.Errorf("unexpected issue %s", issue)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package game
+
+import "fmt"
+
+// Tunables for escort convoys
+const (
+	convoyDefaultSpeed = 60.0 // Cruise speed when the mission leaves convoySpeed unset
+	convoyObjectiveID  = "escort-convoy"
+)
+
+// ConvoyComponent marks the protected ship of an escort mission. It flies its
+// route from the first mission beacon through the rest in order.
+type ConvoyComponent struct {
+	MissionID string
+	LeaderID  string // Human player escorting the convoy
+	Route     []Vec2 // Beacon positions, starting at the spawn point
+}
+
+// ConvoyStatus reports an escort mission's convoy in MissionUpdate.
+type ConvoyStatus struct {
+	HP        int     `json:"hp"`
+	MaxHP     int     `json:"maxHp"`
+	Leg       int     `json:"leg"`  // Legs completed
+	Legs      int     `json:"legs"` // Legs in the route
+	X         float64 `json:"x"`
+	Y         float64 `json:"y"`
+	Arrived   bool    `json:"arrived"`
+	Destroyed bool    `json:"destroyed"`
+}
+
+// spawnConvoyLocked launches the convoy for an escort mission at the first
+// beacon and routes it through the others. The convoy fights on the
+// escorting player's side. Optional objective params: convoySpeed and
+// convoyHull. Callers must hold r.Mu.
+func (d *BeaconDirector) spawnConvoyLocked(r *Room, p *Player, tmpl *MissionTemplate) (EntityID, error) {
+	if len(d.beacons) < 2 {
+		return 0, fmt.Errorf("escort mission %s needs at least two beacons", tmpl.ID)
+	}
+	speed, ok := floatFromParam(tmpl.ObjectiveParams["convoySpeed"])
+	if !ok || speed <= 0 {
+		speed = convoyDefaultSpeed
+	}
+	var hull *HullClass
+	if name, ok := tmpl.ObjectiveParams["convoyHull"].(string); ok && name != "" {
+		h, err := GetHull(name)
+		if err != nil {
+			return 0, fmt.Errorf("escort mission %s: %w", tmpl.ID, err)
+		}
+		hull = h
+	}
+
+	route := make([]Vec2, len(d.beacons))
+	for i := range d.beacons {
+		route[i] = d.beaconWorldPosition(&d.beacons[i], r)
+	}
+	id := r.SpawnShip(p.ID+"-convoy", route[0])
+	if hull != nil {
+		r.applyHullLocked(id, *hull)
+	}
+	if owner := r.World.Owner(id); owner != nil {
+		owner.LeaderID = p.ID
+	}
+	waypoints := make([]RouteWaypoint, 0, len(route)-1)
+	for _, pos := range route[1:] {
+		waypoints = append(waypoints, RouteWaypoint{Pos: pos, Speed: speed})
+	}
+	r.World.Route(id).Waypoints = waypoints
+	r.World.SetComponent(id, CompConvoy, &ConvoyComponent{
+		MissionID: tmpl.ID,
+		LeaderID:  p.ID,
+		Route:     route,
+	})
+	applyEntityTags(r, id, map[string]bool{"convoy": true})
+	return id, nil
+}
+
+// removeConvoyLocked retires a convoy left over from an earlier mission.
+func (r *Room) removeConvoyLocked(id EntityID) {
+	if id != 0 && r.World.Convoy(id) != nil {
+		r.World.RemoveEntity(id)
+	}
+}
+
+// convoyProgress returns the legs the convoy has completed, the legs in its
+// route and the fraction of the current leg flown.
+func convoyProgress(r *Room, id EntityID) (leg, legs int, frac float64) {
+	convoy := r.World.Convoy(id)
+	follower := r.World.RouteFollower(id)
+	tr := r.World.Transform(id)
+	if convoy == nil || follower == nil || tr == nil || len(convoy.Route) < 2 {
+		return 0, 0, 0
+	}
+	legs = len(convoy.Route) - 1
+	leg = follower.Index
+	if leg >= legs {
+		return legs, legs, 0
+	}
+	from, to := convoy.Route[leg], convoy.Route[leg+1]
+	if length := to.Sub(from).Len(); length > 0 {
+		frac = Clamp(1-to.Sub(tr.Pos).Len()/length, 0, 1)
+	}
+	return leg, legs, frac
+}
+
+// convoyStatus summarises a convoy for MissionUpdate, or nil if id is not one.
+func (r *Room) convoyStatus(id EntityID) *ConvoyStatus {
+	if id == 0 || r.World.Convoy(id) == nil {
+		return nil
+	}
+	leg, legs, _ := convoyProgress(r, id)
+	status := &ConvoyStatus{
+		Leg:       leg,
+		Legs:      legs,
+		Arrived:   legs > 0 && leg >= legs,
+		Destroyed: r.World.DestroyedData(id) != nil,
+	}
+	if ship := r.World.ShipData(id); ship != nil {
+		status.HP = ship.HP
+		status.MaxHP = ship.MaxHP
+	}
+	if tr := r.World.Transform(id); tr != nil {
+		status.X = tr.Pos.X
+		status.Y = tr.Pos.Y
+	}
+	return status
+}
+
+// EscortEvaluator completes once the convoy reaches the last beacon of its
+// route and fails if the convoy is destroyed on the way.
+type EscortEvaluator struct {
+	Convoy     EntityID
+	Identifier string
+}
+
+// Evaluate implements ObjectiveEvaluator.
+func (e *EscortEvaluator) Evaluate(r *Room, _ *Player) (bool, float64) {
+	if r == nil || r.World == nil || r.convoyLost(e.Convoy) {
+		return false, 0
+	}
+	leg, legs, frac := convoyProgress(r, e.Convoy)
+	if legs == 0 {
+		return false, 0
+	}
+	if leg >= legs {
+		return true, 1
+	}
+	return false, Clamp((float64(leg)+frac)/float64(legs), 0, 1)
+}
+
+// convoyLost reports whether the convoy has been destroyed or removed.
+func (r *Room) convoyLost(id EntityID) bool {
+	return r.World.Convoy(id) == nil || r.World.DestroyedData(id) != nil
+}
+
+// preferConvoyTargets narrows a mission entity's opponents to escort convoys
+// when it can see one, so encounters go for the convoy over its escort.
+func preferConvoyTargets(r *Room, ctx *AIContext) {
+	convoys := ctx.Opponents[:0:0]
+	for _, op := range ctx.Opponents {
+		if r.World.Convoy(op.Entity) != nil {
+			convoys = append(convoys, op)
+		}
+	}
+	if len(convoys) > 0 {
+		ctx.Opponents = convoys
+	}
+}
@@ -0,0 +1,147 @@
+package game
+
+import "testing"
+
+// escortRoom accepts a test escort mission for a single player and returns the
+// room, director, player and convoy.
+func escortRoom(t *testing.T) (*Room, *BeaconDirector, *Player, EntityID) {
+	t.Helper()
+	TemplateRegistry["test-escort"] = MissionTemplate{
+		ID:              "test-escort",
+		DisplayName:     "Test Escort",
+		Archetype:       ArchetypeEscort,
+		ObjectiveParams: map[string]interface{}{"convoySpeed": 50, "convoyHull": "destroyer"},
+	}
+	t.Cleanup(func() { delete(TemplateRegistry, "test-escort") })
+
+	room := newCombatTestRoom()
+	director, ok := NewBeaconDirector(room.ID, "campaign-1", room.WorldWidth, room.WorldHeight)
+	if !ok {
+		t.Fatal("expected beacon director")
+	}
+	room.missionDirector = director
+	player := &Player{ID: "p1", Name: "P1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+	if err := director.AcceptMission(room, player, "test-escort"); err != nil {
+		t.Fatalf("failed to accept escort mission: %v", err)
+	}
+	if director.convoy == 0 {
+		t.Fatal("expected the escort mission to spawn a convoy")
+	}
+	return room, director, player, director.convoy
+}
+
+func TestEscortMissionSpawnsConvoy(t *testing.T) {
+	room, director, player, convoy := escortRoom(t)
+
+	if ship := room.World.ShipData(convoy); ship == nil || ship.Hull != "destroyer" {
+		t.Fatalf("expected a destroyer convoy, got %+v", ship)
+	}
+	if !ownersAllied(room.World.Owner(convoy), room.World.Owner(player.Ship)) {
+		t.Fatal("expected the convoy to fly on the escorting player's side")
+	}
+	if ownersAllied(room.World.Owner(convoy), &OwnerComponent{PlayerID: missionOwnerID}) {
+		t.Fatal("expected mission entities to be hostile to the convoy")
+	}
+	route := room.World.Route(convoy)
+	if len(route.Waypoints) != len(director.beacons)-1 {
+		t.Fatalf("expected a waypoint per beacon after the first, got %d", len(route.Waypoints))
+	}
+
+	evaluator, ok := director.ActiveObjectives[convoyObjectiveID].(*EscortEvaluator)
+	if !ok {
+		t.Fatalf("expected an escort objective, got %v", director.ActiveObjectives)
+	}
+	if complete, progress := evaluator.Evaluate(room, player); complete || progress != 0 {
+		t.Fatalf("expected no progress at the start, got %v %.2f", complete, progress)
+	}
+	for i := 0; i < 40; i++ {
+		room.Now += Dt
+		updateRouteFollowers(room, Dt)
+	}
+	if _, progress := evaluator.Evaluate(room, player); progress <= 0 {
+		t.Fatal("expected progress once the convoy is under way")
+	}
+
+	room.World.RouteFollower(convoy).Index = len(route.Waypoints)
+	if complete, progress := evaluator.Evaluate(room, player); !complete || progress != 1 {
+		t.Fatalf("expected the escort to complete on arrival, got %v %.2f", complete, progress)
+	}
+	if status := room.convoyStatus(convoy); status == nil || !status.Arrived || status.HP != status.MaxHP {
+		t.Fatalf("unexpected convoy status %+v", status)
+	}
+}
+
+func TestEscortMissionFailsWhenConvoyDestroyed(t *testing.T) {
+	room, director, player, convoy := escortRoom(t)
+	player.PendingMessages = nil
+
+	room.damageShip(convoy, room.World.ShipData(convoy).HP, "", nil)
+	if room.World.DestroyedData(convoy) == nil {
+		t.Fatal("expected the convoy to be destroyed")
+	}
+	director.Tick(room)
+
+	if len(director.ActiveObjectives) != 0 {
+		t.Fatalf("expected a failed mission to clear its objectives, got %v", director.ActiveObjectives)
+	}
+	var failed *MissionUpdate
+	for _, msg := range player.PendingMessages {
+		if update, ok := msg.Payload.(MissionUpdate); ok && update.Status == "failed" {
+			failed = &update
+		}
+	}
+	if failed == nil {
+		t.Fatalf("expected a failed mission update, got %+v", player.PendingMessages)
+	}
+	if failed.Convoy == nil || !failed.Convoy.Destroyed {
+		t.Fatalf("expected the update to report the lost convoy, got %+v", failed.Convoy)
+	}
+}
+
+func TestMissionSeekersPreferConvoy(t *testing.T) {
+	room, _, player, convoy := escortRoom(t)
+	convoyPos := room.World.Transform(convoy).Pos
+	room.World.Transform(player.Ship).Pos = convoyPos.Add(Vec2{X: 150})
+	// Build up enough history for the seeker to see both ships through the light delay.
+	for i := 0; i < SimHz; i++ {
+		room.Now += Dt
+		updateRouteFollowers(room, Dt)
+	}
+	convoyPos = room.World.Transform(convoy).Pos
+
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 800})
+	start := convoyPos.Add(Vec2{X: 300})
+	seeker := room.LaunchMissile(missionOwnerID, 0, cfg, []RouteWaypoint{{Pos: start.Add(Vec2{Y: 500})}}, start, Vec2{})
+	updateMissileGuidance(room, Dt)
+
+	if target := room.World.MissileData(seeker).Target; target != convoy {
+		t.Fatalf("expected the seeker to lock the convoy (%d), got %d", convoy, target)
+	}
+}
+
+func TestFailedEscortAcceptKeepsRunningMission(t *testing.T) {
+	room, director, player, convoy := escortRoom(t)
+	TemplateRegistry["test-bad-escort"] = MissionTemplate{
+		ID:              "test-bad-escort",
+		DisplayName:     "Bad Escort",
+		Archetype:       ArchetypeEscort,
+		ObjectiveParams: map[string]interface{}{"convoyHull": "no-such-hull"},
+	}
+	t.Cleanup(func() { delete(TemplateRegistry, "test-bad-escort") })
+	checks := director.failureChecks
+
+	if err := director.AcceptMission(room, player, "test-bad-escort"); err == nil {
+		t.Fatal("expected an escort with an unknown hull to be refused")
+	}
+	if director.CurrentMissionID != "test-escort" || director.MissionTemplate().ID != "test-escort" {
+		t.Fatalf("expected the running mission to stay current, got %s", director.CurrentMissionID)
+	}
+	if director.convoy != convoy || room.World.Convoy(convoy) == nil {
+		t.Fatal("expected the running mission's convoy to survive")
+	}
+	if _, ok := director.ActiveObjectives[convoyObjectiveID]; !ok || len(director.failureChecks) != len(checks) {
+		t.Fatalf("expected the running objectives to survive, got %v", director.ActiveObjectives)
+	}
+}
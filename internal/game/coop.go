@@ -0,0 +1,246 @@
+package game
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// Co-op story choice modes
+const (
+	CoopChoiceVote   = "vote"   // The party's majority decides; ties go to the leader
+	CoopChoiceLeader = "leader" // Only the party leader's choice counts
+)
+
+// coopPartyID is the player ID recorded on the shared beacon progress.
+const coopPartyID = "party"
+
+// CoopParty makes a campaign room co-op: every human advances one shared
+// beacon progress record, and story choices are made once for the party.
+type CoopParty struct {
+	Choices  string // CoopChoiceVote or CoopChoiceLeader
+	LeaderID string
+
+	votes    map[dag.NodeID]map[string]string // Node -> player ID -> choice ID
+	resolved map[dag.NodeID]string            // Node -> choice the party made
+	applied  map[string]bool                  // Sources whose party-wide effects ran
+}
+
+// StoryVoteDTO tells party members how a story choice stands.
+type StoryVoteDTO struct {
+	NodeID   string         `json:"nodeId"`
+	Mode     string         `json:"mode"`
+	LeaderID string         `json:"leaderId"`
+	Votes    map[string]int `json:"votes"`   // Choice ID -> votes cast
+	Waiting  int            `json:"waiting"` // Members yet to choose
+	Resolved string         `json:"resolved,omitempty"`
+}
+
+// EnableCoopLocked makes the room co-op with the given story choice mode
+// ("" means vote). leaderID leads the party until they leave. Beacon progress
+// already made is merged into the furthest player's. Callers must hold r.Mu.
+func (r *Room) EnableCoopLocked(choices, leaderID string) error {
+	switch choices {
+	case "":
+		choices = CoopChoiceVote
+	case CoopChoiceVote, CoopChoiceLeader:
+	default:
+		return fmt.Errorf("unknown co-op choice mode %q", choices)
+	}
+	if r.coop == nil {
+		r.coop = &CoopParty{
+			LeaderID: leaderID,
+			votes:    make(map[dag.NodeID]map[string]string),
+			resolved: make(map[dag.NodeID]string),
+			applied:  make(map[string]bool),
+		}
+	}
+	r.coop.Choices = choices
+	r.missionDirector.shareProgress()
+	return nil
+}
+
+// CoopLocked returns the room's co-op party, or nil if the room is not co-op.
+func (r *Room) CoopLocked() *CoopParty {
+	return r.coop
+}
+
+// partyMembersLocked returns the room's human players sorted by ID.
+func (r *Room) partyMembersLocked() []*Player {
+	members := make([]*Player, 0, len(r.Players))
+	for _, p := range r.Players {
+		if p != nil && !p.IsBot {
+			members = append(members, p)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].ID < members[j].ID })
+	return members
+}
+
+// partyLeaderLocked returns the party leader, handing the role to the member
+// with the smallest ID if the leader has left.
+func (r *Room) partyLeaderLocked() string {
+	if p := r.Players[r.coop.LeaderID]; p != nil && !p.IsBot {
+		return r.coop.LeaderID
+	}
+	if members := r.partyMembersLocked(); len(members) > 0 {
+		r.coop.LeaderID = members[0].ID
+	}
+	return r.coop.LeaderID
+}
+
+// claim reports whether the party-wide effects of source have yet to run,
+// and marks them as run.
+func (c *CoopParty) claim(source string) bool {
+	if c.applied[source] {
+		return false
+	}
+	c.applied[source] = true
+	return true
+}
+
+// partyWideEffect reports whether the effect changes the room rather than
+// the player, so a co-op party applies it once instead of once per member.
+func partyWideEffect(t dag.StoryEffectType) bool {
+	switch t {
+	case dag.StoryEffectSpawnEncounter, dag.StoryEffectHeatParams,
+		dag.StoryEffectAddObjective, dag.StoryEffectRemoveObjective:
+		return true
+	}
+	return false
+}
+
+// handlePartyChoiceLocked records p's choice on a story node in a co-op room.
+// Once the party has decided, the winning choice applies to every member who
+// has finished the node; members who finish it later follow that choice.
+func (r *Room) handlePartyChoiceLocked(p *Player, parentNodeID dag.NodeID, choiceID string, graph *dag.Graph) {
+	party := r.coop
+	if resolved, ok := party.resolved[parentNodeID]; ok {
+		r.applyStoryChoiceLocked(p, parentNodeID, resolved, graph)
+		return
+	}
+	parent := graph.GetNode(parentNodeID)
+	if findStoryChoice(parent, choiceID) == nil {
+		log.Printf("[story] Unknown choice %s on %s", choiceID, parentNodeID)
+		return
+	}
+	leaderID := r.partyLeaderLocked()
+	if party.Choices == CoopChoiceLeader {
+		if p.ID != leaderID {
+			log.Printf("[story] Player %s chose %s on %s; waiting for leader %s", p.ID, choiceID, parentNodeID, leaderID)
+			return
+		}
+		r.resolvePartyChoiceLocked(parentNodeID, choiceID, graph)
+		return
+	}
+
+	votes := party.votes[parentNodeID]
+	if votes == nil {
+		votes = make(map[string]string)
+		party.votes[parentNodeID] = votes
+	}
+	votes[p.ID] = choiceID
+	log.Printf("[story] Player %s votes %s on %s", p.ID, choiceID, parentNodeID)
+	r.tallyPartyVoteLocked(parentNodeID, graph)
+}
+
+// tallyPartyVoteLocked counts the votes on a story node and settles it once
+// every member who has seen the node has voted.
+func (r *Room) tallyPartyVoteLocked(parentNodeID dag.NodeID, graph *dag.Graph) {
+	parent := graph.GetNode(parentNodeID)
+	if parent == nil {
+		return
+	}
+	votes := r.coop.votes[parentNodeID]
+
+	// Members who have seen the node vote; votes of members who left are dropped.
+	tally := make(map[string]int)
+	waiting := 0
+	for _, m := range r.partyMembersLocked() {
+		if m.DagState == nil {
+			continue
+		}
+		status := m.DagState.GetStatus(parentNodeID)
+		if status != dag.StatusInProgress && status != dag.StatusCompleted {
+			continue
+		}
+		if vote, ok := votes[m.ID]; ok {
+			tally[vote]++
+		} else {
+			waiting++
+		}
+	}
+	if waiting > 0 {
+		r.sendStoryVoteLocked(StoryVoteDTO{NodeID: string(parentNodeID), Votes: tally, Waiting: waiting})
+		return
+	}
+	winner := partyVoteWinner(parent, tally, votes[r.partyLeaderLocked()])
+	if winner == "" {
+		return
+	}
+	r.resolvePartyChoiceLocked(parentNodeID, winner, graph)
+}
+
+// partyMemberLeftLocked re-tallies the open votes so a member who leaves
+// without voting does not hold up the rest of the party.
+func (r *Room) partyMemberLeftLocked() {
+	graph := dag.GetGraph()
+	if graph == nil || len(r.coop.votes) == 0 {
+		return
+	}
+	nodes := make([]dag.NodeID, 0, len(r.coop.votes))
+	for id := range r.coop.votes {
+		nodes = append(nodes, id)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	for _, id := range nodes {
+		r.tallyPartyVoteLocked(id, graph)
+	}
+}
+
+// partyVoteWinner returns the choice with the most votes. Ties go to the
+// leader's vote, then to the choice listed first.
+func partyVoteWinner(parent *dag.Node, tally map[string]int, leaderVote string) string {
+	winner := ""
+	for _, choice := range parent.Dialogue.Choices {
+		n := tally[choice.ID]
+		if n == 0 {
+			continue
+		}
+		if winner == "" || n > tally[winner] || (n == tally[winner] && choice.ID == leaderVote) {
+			winner = choice.ID
+		}
+	}
+	return winner
+}
+
+// resolvePartyChoiceLocked settles the party's choice on a story node and
+// applies it to every member who has finished the node.
+func (r *Room) resolvePartyChoiceLocked(parentNodeID dag.NodeID, choiceID string, graph *dag.Graph) {
+	party := r.coop
+	party.resolved[parentNodeID] = choiceID
+	tally := make(map[string]int)
+	for _, vote := range party.votes[parentNodeID] {
+		tally[vote]++
+	}
+	delete(party.votes, parentNodeID)
+	log.Printf("[story] Party chose %s on %s", choiceID, parentNodeID)
+
+	for _, m := range r.partyMembersLocked() {
+		if m.DagState != nil && m.DagState.GetStatus(parentNodeID) == dag.StatusCompleted {
+			r.applyStoryChoiceLocked(m, parentNodeID, choiceID, graph)
+		}
+	}
+	r.sendStoryVoteLocked(StoryVoteDTO{NodeID: string(parentNodeID), Votes: tally, Resolved: choiceID})
+}
+
+// sendStoryVoteLocked sends the vote state to every party member.
+func (r *Room) sendStoryVoteLocked(vote StoryVoteDTO) {
+	vote.Mode = r.coop.Choices
+	vote.LeaderID = r.coop.LeaderID
+	for _, m := range r.partyMembersLocked() {
+		m.SendMessage("story:vote", vote)
+	}
+}
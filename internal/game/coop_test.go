@@ -0,0 +1,170 @@
+package game
+
+import (
+	"testing"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+func TestCoopBeaconHoldStacksAndLocksForParty(t *testing.T) {
+	room := newCombatTestRoom()
+	director, ok := NewBeaconDirector(room.ID, "campaign-1", room.WorldWidth, room.WorldHeight)
+	if !ok {
+		t.Fatal("expected beacon director")
+	}
+	room.missionDirector = director
+	beacon := director.beacons[0]
+	pos := Vec2{X: beacon.Normalized.X * room.WorldWidth, Y: beacon.Normalized.Y * room.WorldHeight}
+	for _, id := range []string{"p1", "p2"} {
+		p := &Player{ID: id}
+		room.Players[id] = p
+		p.Ship = room.SpawnShip(id, pos)
+	}
+	if err := room.EnableCoopLocked(CoopChoiceVote, "p1"); err != nil {
+		t.Fatalf("enabling co-op failed: %v", err)
+	}
+
+	room.Now = 1
+	director.Tick(room)
+	// Two ships in the ring hold twice as fast, so 60% of the hold time locks it.
+	room.Now += director.spec.HoldSeconds * 0.6
+	director.Tick(room)
+
+	p1, p2 := director.ensurePlayerState("p1"), director.ensurePlayerState("p2")
+	if p1 != p2 {
+		t.Fatal("expected party members to share beacon progress")
+	}
+	if p1.CurrentIndex != 1 || !p1.Completed[beacon.ID] {
+		t.Fatalf("expected the stacked hold to lock the first beacon, got index %d", p1.CurrentIndex)
+	}
+	locked := map[string]bool{}
+	for _, delta := range director.PendingDeltas() {
+		if delta.Type == BeaconDeltaBeaconLocked {
+			locked[delta.PlayerID] = true
+		}
+	}
+	if !locked["p1"] || !locked["p2"] {
+		t.Fatalf("expected every member to hear about the lock, got %v", locked)
+	}
+	if snap := director.Snapshot(room.Now, room.WorldWidth, room.WorldHeight); len(snap.Players) != 2 {
+		t.Fatalf("expected a snapshot entry per member, got %+v", snap.Players)
+	}
+}
+
+func coopStoryRoom(t *testing.T, choices string, ids ...string) (*Room, *dag.Graph) {
+	t.Helper()
+	nodes := []*dag.Node{
+		{
+			ID:        "story.test.ask",
+			Kind:      dag.NodeKindStory,
+			Label:     "Ask",
+			DurationS: 999999,
+			Dialogue: &dag.DialogueContent{
+				Choices: []dag.DialogueChoice{
+					{ID: "help", Effects: []dag.StoryEffect{
+						{Type: dag.StoryEffectSetFlag, Flag: "test.helped"},
+						{Type: dag.StoryEffectHeatParams, Heat: map[string]float64{"kUp": 2}},
+					}},
+					{ID: "leave", Effects: []dag.StoryEffect{{Type: dag.StoryEffectSetFlag, Flag: "test.left"}}},
+				},
+			},
+		},
+	}
+	if err := dag.Init(nodes); err != nil {
+		t.Fatalf("failed to init DAG: %v", err)
+	}
+	room := newCombatTestRoom()
+	for _, id := range ids {
+		p := &Player{ID: id}
+		room.Players[id] = p
+		p.EnsureDagState()
+		p.EnsureStoryState()
+		room.tryStartStoryNodeLocked(p, "story.test.ask")
+	}
+	if err := room.EnableCoopLocked(choices, ids[0]); err != nil {
+		t.Fatalf("enabling co-op failed: %v", err)
+	}
+	return room, dag.GetGraph()
+}
+
+func chooseStory(t *testing.T, room *Room, graph *dag.Graph, p *Player, choiceID string) {
+	t.Helper()
+	if err := dag.Complete(graph, p.DagState, "story.test.ask", NewRoomDagEffects(room, p)); err != nil {
+		t.Fatalf("completing the question failed: %v", err)
+	}
+	room.HandleStoryChoiceBranching(p, "story.test.ask", choiceID, graph)
+}
+
+func TestCoopStoryChoiceResolvedByVote(t *testing.T) {
+	room, graph := coopStoryRoom(t, CoopChoiceVote, "p1", "p2", "p3")
+	baseKUp := room.HeatParamsLocked().KUp
+
+	chooseStory(t, room, graph, room.Players["p1"], "leave")
+	chooseStory(t, room, graph, room.Players["p2"], "help")
+	for _, p := range room.Players {
+		if p.StoryFlags["test.helped"] || p.StoryFlags["test.left"] {
+			t.Fatalf("expected no choice before everyone voted, got %v for %s", p.StoryFlags, p.ID)
+		}
+	}
+	chooseStory(t, room, graph, room.Players["p3"], "help")
+
+	for _, p := range room.Players {
+		if !p.StoryFlags["test.helped"] || p.StoryFlags["test.left"] {
+			t.Fatalf("expected the majority choice for %s, got %v", p.ID, p.StoryFlags)
+		}
+	}
+	if got := room.HeatParamsLocked().KUp; got != baseKUp*2 {
+		t.Fatalf("expected the heat effect to apply once, kUp %.1f -> %.1f", baseKUp, got)
+	}
+}
+
+func TestCoopStoryChoiceResolvedByLeader(t *testing.T) {
+	room, graph := coopStoryRoom(t, CoopChoiceLeader, "p1", "p2")
+	leader, member := room.Players["p1"], room.Players["p2"]
+
+	chooseStory(t, room, graph, member, "help")
+	if member.StoryFlags["test.helped"] {
+		t.Fatal("expected a member's choice to wait for the leader")
+	}
+	chooseStory(t, room, graph, leader, "leave")
+	for _, p := range []*Player{leader, member} {
+		if !p.StoryFlags["test.left"] || p.StoryFlags["test.helped"] {
+			t.Fatalf("expected the leader's choice for %s, got %v", p.ID, p.StoryFlags)
+		}
+	}
+}
+
+func TestCoopVoteSettlesWhenMemberLeaves(t *testing.T) {
+	room, graph := coopStoryRoom(t, CoopChoiceVote, "p1", "p2", "p3")
+
+	chooseStory(t, room, graph, room.Players["p1"], "leave")
+	chooseStory(t, room, graph, room.Players["p2"], "help")
+	room.RemovePlayerLocked("p3")
+
+	for _, id := range []string{"p1", "p2"} {
+		p := room.Players[id]
+		if !p.StoryFlags["test.left"] || p.StoryFlags["test.helped"] {
+			t.Fatalf("expected the tie to go to the leader once p3 left, got %v for %s", p.StoryFlags, id)
+		}
+	}
+}
+
+func TestCoopAcceptMissionResetsPartyEffects(t *testing.T) {
+	room := newCombatTestRoom()
+	director := room.EnsureBeaconDirectorLocked("campaign-1")
+	player := &Player{ID: "p1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+	if err := room.EnableCoopLocked(CoopChoiceVote, "p1"); err != nil {
+		t.Fatalf("enabling co-op failed: %v", err)
+	}
+	if !room.coop.claim("story.test.ask/help") {
+		t.Fatal("expected the first claim to run the effects")
+	}
+	if err := director.AcceptMission(room, player, "campaign-1"); err != nil {
+		t.Fatalf("failed to accept mission: %v", err)
+	}
+	if !room.coop.claim("story.test.ask/help") {
+		t.Fatal("expected a new mission to run party-wide effects again")
+	}
+}
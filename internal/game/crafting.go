@@ -62,6 +62,14 @@ func (e *CraftingEffects) OnComplete(nodeID dag.NodeID, node *dag.Node) {
 
 		log.Printf("player %s crafted %s %s (heat: %.0f)", e.Player.ID, itemType, variantID, heatCapacity)
 	}
+
+	// Ship consumables have no heat capacity
+	if itemType == RepairKitItemType || itemType == HeatSinkItemType {
+		e.Player.EnsureInventory()
+		e.Player.Inventory.AddItem(itemType, variantID, 0, 1)
+
+		log.Printf("player %s crafted %s %s", e.Player.ID, itemType, variantID)
+	}
 }
 
 // OnCancel is called when a node is cancelled (no-op for crafting).
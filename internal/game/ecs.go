@@ -1,5 +1,10 @@
 package game
 
+import (
+	"fmt"
+	"slices"
+)
+
 type EntityID int64
 
 type ComponentKey string
@@ -18,8 +23,14 @@ type Movement struct {
 	MaxSpeed float64
 }
 
+// ShipComponent holds a ship's hull stats. Ships with subsystems are
+// destroyed when their hull subsystem's integrity runs out; HP mirrors that
+// integrity in whole points for clients and boss phases.
 type ShipComponent struct {
-	HP int
+	HP        int
+	MaxHP     int
+	HitRadius float64 // Missile contact radius (0 = MissileHitRadius)
+	Hull      string  // Hull class ID
 }
 
 type RouteWaypoint struct {
@@ -38,17 +49,135 @@ type RouteFollower struct {
 	override    RouteWaypoint
 }
 
+// MissileGuidance selects which kind of entity a missile hunts.
+type MissileGuidance int
+
+const (
+	// MissileGuidanceShip chases hostile ships (the default).
+	MissileGuidanceShip MissileGuidance = iota
+	// MissileGuidanceInterceptor chases hostile missiles instead of ships.
+	MissileGuidanceInterceptor
+)
+
+func (g MissileGuidance) String() string {
+	switch g {
+	case MissileGuidanceInterceptor:
+		return "interceptor"
+	default:
+		return "ship"
+	}
+}
+
+// MarshalText encodes the guidance mode by name.
+func (g MissileGuidance) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+// UnmarshalText parses a guidance name: "ship" or "interceptor".
+func (g *MissileGuidance) UnmarshalText(text []byte) error {
+	for _, m := range []MissileGuidance{MissileGuidanceShip, MissileGuidanceInterceptor} {
+		if m.String() == string(text) {
+			*g = m
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown missile guidance: %s", text)
+}
+
 type MissileComponent struct {
 	AgroRadius  float64
 	LaunchTime  float64
 	Lifetime    float64
 	Target      EntityID
 	ReturnIndex int
+	Guidance    MissileGuidance
+	Warhead     WarheadType
+}
+
+// PointDefenseComponent describes a ship-mounted close-in weapon that shoots
+// down incoming missiles it can perceive.
+type PointDefenseComponent struct {
+	Range     float64 // Engagement range in world units
+	Cooldown  float64 // Seconds between shots
+	HeatCost  float64 // Heat added to the ship per shot
+	HitChance float64 // Probability a shot destroys the missile [0,1]
+	ReadyAt   float64 // Room time when the next shot is available
+}
+
+// SubsystemsComponent tracks the health of a ship's damageable subsystems.
+// Each entry is in [0,1]; 1 is fully operational.
+type SubsystemsComponent struct {
+	Health      [subsystemCount]float64
+	RepairAfter float64 // Room time when passive repair may resume
+}
+
+// HeatAbilitiesComponent tracks the player-triggered heat management
+// abilities fitted to a ship.
+type HeatAbilitiesComponent struct {
+	VentReadyAt       float64 // Room time when the next vent is available
+	VentedAt          float64 // Room time of the last vent (0 = never)
+	SinkReadyAt       float64 // Room time when the next heat sink can be used
+	RadiatorsDeployed bool
+	RadiatorReadyAt   float64 // Room time when radiators can be toggled again
+}
+
+// GunshipComponent drives an armed mission entity. The pilot is a synthetic
+// player that is never added to Room.Players; behaviors plan for it exactly
+// as they would for a bot.
+type GunshipComponent struct {
+	Pilot      *Player
+	Behavior   AIBehavior
+	Weapon     WeaponProfile
+	NextPlanAt float64
+}
+
+// SpawnerComponent makes a mission entity, such as a carrier or drone hive,
+// periodically emit children from an encounter template.
+type SpawnerComponent struct {
+	Template    string  // EncounterTemplate ID of the children
+	Interval    float64 // Seconds between waves
+	MaxChildren int     // Cap on live children
+	NextSpawnAt float64
+	Waves       int // Waves emitted so far; varies the child placement seed
+	Children    []EntityID
+}
+
+// BossComponent tracks the scripted phases of a boss encounter's central entity.
+type BossComponent struct {
+	Phases         []BossPhase
+	Phase          int // Index of the current phase
+	PhaseStartedAt float64
+	Patrol         bool // Loop the route set by the current phase
+	Seed           int64
+	Children       []EntityID // Entities spawned by phase transitions
+}
+
+// WingmanComponent drives an AI wingman flying for a human leader. The pilot
+// is a synthetic player that is never added to Room.Players.
+type WingmanComponent struct {
+	LeaderID   string
+	Slot       int
+	Order      WingmanOrder
+	HoldPos    Vec2
+	Pilot      *Player
+	Behavior   AIBehavior
+	NextPlanAt float64
 }
 
 type OwnerComponent struct {
 	PlayerID string
 	Neutral  bool
+	// LeaderID is the human player this entity fights for, if not PlayerID
+	// (e.g. a wingman). Entities sharing a leader are allied.
+	LeaderID string
+}
+
+// side returns the player whose team the owner fights on.
+func (o *OwnerComponent) side() string {
+	if o.LeaderID != "" {
+		return o.LeaderID
+	}
+	return o.PlayerID
 }
 
 type HistoryComponent struct {
@@ -67,7 +196,9 @@ type MissileConfig struct {
 	Speed      float64
 	AgroRadius float64
 	Lifetime   float64
-	HeatParams HeatParams // Heat configuration for this missile
+	HeatParams HeatParams      // Heat configuration for this missile
+	Guidance   MissileGuidance // Target class the missile seeks
+	Warhead    WarheadType     // Blast profile on detonation
 }
 
 const (
@@ -82,6 +213,14 @@ const (
 	CompDestroyed     ComponentKey = "destroyed"
 	CompHeat          ComponentKey = "heat"
 	CompTags          ComponentKey = "tags"
+	CompPointDefense  ComponentKey = "point_defense"
+	CompSubsystems    ComponentKey = "subsystems"
+	CompHeatAbilities ComponentKey = "heat_abilities"
+	CompGunship       ComponentKey = "gunship"
+	CompSpawner       ComponentKey = "spawner"
+	CompBoss          ComponentKey = "boss"
+	CompWingman       ComponentKey = "wingman"
+	CompConvoy        ComponentKey = "convoy"
 )
 
 func SanitizeMissileConfig(cfg MissileConfig) MissileConfig {
@@ -105,6 +244,8 @@ func SanitizeMissileConfig(cfg MissileConfig) MissileConfig {
 		AgroRadius: agro,
 		Lifetime:   lifetime,
 		HeatParams: heatParams,
+		Guidance:   cfg.Guidance,
+		Warhead:    cfg.Warhead,
 	}
 }
 
@@ -130,6 +271,8 @@ func SanitizeMissileConfigWithCap(cfg MissileConfig, minSpeed, maxSpeed float64)
 		AgroRadius: agro,
 		Lifetime:   lifetime,
 		HeatParams: heatParams,
+		Guidance:   cfg.Guidance,
+		Warhead:    cfg.Warhead,
 	}
 }
 
@@ -247,6 +390,78 @@ func (w *World) Tags(id EntityID) *TagComponent {
 	return nil
 }
 
+func (w *World) PointDefense(id EntityID) *PointDefenseComponent {
+	if v, ok := w.GetComponent(id, CompPointDefense); ok {
+		if t, ok := v.(*PointDefenseComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) Subsystems(id EntityID) *SubsystemsComponent {
+	if v, ok := w.GetComponent(id, CompSubsystems); ok {
+		if t, ok := v.(*SubsystemsComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) HeatAbilities(id EntityID) *HeatAbilitiesComponent {
+	if v, ok := w.GetComponent(id, CompHeatAbilities); ok {
+		if t, ok := v.(*HeatAbilitiesComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) Gunship(id EntityID) *GunshipComponent {
+	if v, ok := w.GetComponent(id, CompGunship); ok {
+		if t, ok := v.(*GunshipComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) Spawner(id EntityID) *SpawnerComponent {
+	if v, ok := w.GetComponent(id, CompSpawner); ok {
+		if t, ok := v.(*SpawnerComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) Boss(id EntityID) *BossComponent {
+	if v, ok := w.GetComponent(id, CompBoss); ok {
+		if t, ok := v.(*BossComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) Wingman(id EntityID) *WingmanComponent {
+	if v, ok := w.GetComponent(id, CompWingman); ok {
+		if t, ok := v.(*WingmanComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) Convoy(id EntityID) *ConvoyComponent {
+	if v, ok := w.GetComponent(id, CompConvoy); ok {
+		if t, ok := v.(*ConvoyComponent); ok {
+			return t
+		}
+	}
+	return nil
+}
+
 func newWorld() *World {
 	return &World{
 		nextEntity: 0,
@@ -296,6 +511,9 @@ func (w *World) RemoveEntity(id EntityID) {
 	}
 }
 
+// ForEach calls fn for every entity that has all the required components, in
+// entity ID order so seeded simulations replay the same way. Entities removed
+// by an earlier call are skipped.
 func (w *World) ForEach(required []ComponentKey, fn func(EntityID)) {
 	if len(required) == 0 {
 		return
@@ -304,6 +522,7 @@ func (w *World) ForEach(required []ComponentKey, fn func(EntityID)) {
 	if first == nil {
 		return
 	}
+	ids := make([]EntityID, 0, len(first))
 	for id := range first {
 		match := true
 		for _, key := range required[1:] {
@@ -316,6 +535,12 @@ func (w *World) ForEach(required []ComponentKey, fn func(EntityID)) {
 			}
 		}
 		if match {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		if _, ok := first[id]; ok {
 			fn(id)
 		}
 	}
@@ -18,6 +18,7 @@ type EncounterTemplate struct {
 	Tags           map[string]bool
 	MaxConcurrency int
 	Cooldown       float64
+	Boss           *BossSpec // Spawned at the encounter center after the groups
 }
 
 // SpawnGroup defines a cluster of entities to spawn.
@@ -29,6 +30,17 @@ type SpawnGroup struct {
 	SpeedRange SpeedRange
 	AgroRange  AgroRange
 	Tags       map[string]bool
+	// Gunship groups only: AI behavior name (default offensive), hull class
+	// and the weapon the gunship fires.
+	Behavior string
+	Hull     string
+	Weapon   WeaponProfile
+	// Spawner groups only: the encounter template emitted every
+	// SpawnInterval seconds while fewer than MaxChildren are alive. Hull
+	// also applies to spawners.
+	ChildTemplate string
+	SpawnInterval float64
+	MaxChildren   int
 }
 
 // CountRange represents an inclusive range for entity counts.
@@ -104,6 +116,34 @@ func (g RandomPathGenerator) Generate(center Vec2, rng *rand.Rand) []Vec2 {
 	return waypoints
 }
 
+// WaypointGeneratorSpec names a waypoint generator and its settings so
+// content files can describe patrol paths.
+type WaypointGeneratorSpec struct {
+	Type       string
+	Radius     float64
+	PointCount int
+	Clockwise  bool
+}
+
+// WaypointGeneratorRegistry builds waypoint generators by type name.
+var WaypointGeneratorRegistry = map[string]func(spec WaypointGeneratorSpec) WaypointGenerator{
+	"circular": func(spec WaypointGeneratorSpec) WaypointGenerator {
+		return CircularPathGenerator{Radius: spec.Radius, PointCount: spec.PointCount, Clockwise: spec.Clockwise}
+	},
+	"random": func(spec WaypointGeneratorSpec) WaypointGenerator {
+		return RandomPathGenerator{PointCount: spec.PointCount, Radius: spec.Radius}
+	},
+}
+
+// NewWaypointGenerator builds the generator described by spec.
+func NewWaypointGenerator(spec WaypointGeneratorSpec) (WaypointGenerator, error) {
+	build, ok := WaypointGeneratorRegistry[spec.Type]
+	if !ok {
+		return nil, fmt.Errorf("waypoint generator not found: %s", spec.Type)
+	}
+	return build(spec), nil
+}
+
 // EncounterRegistry holds all defined encounter templates.
 var EncounterRegistry = map[string]EncounterTemplate{
 	"minefield-basic": {
@@ -197,6 +237,113 @@ var EncounterRegistry = map[string]EncounterTemplate{
 		MaxConcurrency: 2,
 		Cooldown:       50,
 	},
+	"gunship-raid": {
+		ID:            "gunship-raid",
+		DisplayName:   "Gunship Raid",
+		EncounterType: "gunship",
+		SpawnGroups: []SpawnGroup{
+			{
+				EntityType: "gunship",
+				Count:      CountRange{Min: 1, Max: 2},
+				Formation:  "line",
+				Tags:       map[string]bool{"gunship": true, "hostile": true, "mobile": true, "armed": true},
+				Behavior:   AIBehaviorOffensive,
+				Hull:       "destroyer",
+				Weapon: WeaponProfile{
+					MissileSpeed: 140,
+					AgroRadius:   500,
+					Cooldown:     8,
+					Ammo:         6,
+				},
+			},
+		},
+		Lifetime:       240,
+		Tags:           map[string]bool{"tier-3": true, "gunship": true, "mobile": true, "hostile": true, "armed": true},
+		MaxConcurrency: 1,
+		Cooldown:       90,
+	},
+	"drone-wing": {
+		ID:            "drone-wing",
+		DisplayName:   "Drone Wing",
+		EncounterType: "seeker",
+		SpawnGroups: []SpawnGroup{
+			{
+				EntityType: "seeker",
+				Count:      CountRange{Min: 2, Max: 3},
+				Formation:  "cluster",
+				HeatParams: HeatParams{Max: 60, KUp: 22, KDown: 15},
+				SpeedRange: SpeedRange{Min: 70, Max: 110},
+				AgroRange:  AgroRange{Min: 500, Max: 700},
+				Tags:       map[string]bool{"drone": true, "hostile": true, "mobile": true},
+			},
+		},
+		HeatProfile:    HeatParams{Max: 60, KUp: 22, KDown: 15},
+		Lifetime:       90,
+		Tags:           map[string]bool{"tier-2": true, "drone": true, "mobile": true, "hostile": true},
+		MaxConcurrency: 1,
+		Cooldown:       40,
+	},
+	"carrier-group": {
+		ID:            "carrier-group",
+		DisplayName:   "Carrier Group",
+		EncounterType: "carrier",
+		SpawnGroups: []SpawnGroup{
+			{
+				EntityType:    "spawner",
+				Count:         CountRange{Min: 1, Max: 1},
+				Tags:          map[string]bool{"carrier": true, "spawner": true, "hostile": true, "objective": true},
+				Hull:          "destroyer",
+				ChildTemplate: "drone-wing",
+				SpawnInterval: 20,
+				MaxChildren:   6,
+			},
+		},
+		Lifetime:       300,
+		Tags:           map[string]bool{"tier-3": true, "carrier": true, "spawner": true, "hostile": true},
+		MaxConcurrency: 1,
+		Cooldown:       120,
+	},
+	"dreadnought": {
+		ID:            "dreadnought",
+		DisplayName:   "Dreadnought",
+		EncounterType: "boss",
+		Boss: &BossSpec{
+			Hull:     "destroyer",
+			MaxHP:    12,
+			Behavior: AIBehaviorOffensive,
+			Weapon:   WeaponProfile{MissileSpeed: 120, AgroRadius: 500, Cooldown: 10},
+			Tags:     map[string]bool{"boss": true, "hostile": true, "objective": true},
+			Phases: []BossPhase{
+				{Name: "approach"},
+				{
+					Name:    "escorts",
+					HPBelow: 0.67,
+					SpawnGroups: []SpawnGroup{
+						{
+							EntityType: "seeker",
+							Count:      CountRange{Min: 4, Max: 4},
+							Formation:  "ring",
+							HeatParams: HeatParams{Max: 60, KUp: 22, KDown: 15},
+							SpeedRange: SpeedRange{Min: 80, Max: 110},
+							AgroRange:  AgroRange{Min: 600, Max: 800},
+							Tags:       map[string]bool{"escort": true, "hostile": true, "mobile": true},
+						},
+					},
+				},
+				{
+					Name:       "overdrive",
+					HPBelow:    0.33,
+					After:      150,
+					HeatParams: &HeatParams{Max: 130, WarnAt: 100, OverheatAt: 130, StallSeconds: 2, MarkerSpeed: 190, Exp: 1.5, KUp: 20, KDown: 22},
+					Weapon:     &WeaponProfile{MissileSpeed: 160, AgroRadius: 650, Cooldown: 5},
+				},
+			},
+		},
+		Lifetime:       420,
+		Tags:           map[string]bool{"tier-3": true, "boss": true, "hostile": true},
+		MaxConcurrency: 1,
+		Cooldown:       300,
+	},
 }
 
 // GetEncounter retrieves an encounter template by ID.
@@ -204,7 +351,9 @@ func GetEncounter(id string) (*EncounterTemplate, error) {
 	if id == "" {
 		return nil, fmt.Errorf("encounter template not found: empty id")
 	}
+	contentMu.RLock()
 	template, ok := EncounterRegistry[id]
+	contentMu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("encounter template not found: %s", id)
 	}
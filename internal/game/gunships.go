@@ -0,0 +1,151 @@
+package game
+
+// Tunables for armed mission entities
+const (
+	gunshipPlanInterval  = 0.5  // Seconds between gunship plans
+	gunshipUnlimitedAmmo = 99   // Ammo reported to behaviors when Weapon.Ammo is 0
+	gunshipDefaultSpeed  = 120  // Missile speed when the profile leaves it unset
+	gunshipDefaultAgro   = 500  // Seeker radius when the profile leaves it unset
+	gunshipDefaultReload = 6.0  // Seconds between launches when unset
+	gunshipMissileHeat   = 80.0 // Heat capacity of the pilot's missile stack
+)
+
+// WeaponProfile describes the missiles an armed mission entity fires. Zero
+// fields fall back to gunship defaults.
+type WeaponProfile struct {
+	MissileSpeed float64
+	AgroRadius   float64
+	Warhead      WarheadType
+	Guidance     MissileGuidance
+	Cooldown     float64 // Seconds between launches
+	Ammo         int     // Missiles carried; 0 means unlimited
+}
+
+func (w WeaponProfile) missileConfig() MissileConfig {
+	speed := w.MissileSpeed
+	if speed <= 0 {
+		speed = gunshipDefaultSpeed
+	}
+	agro := w.AgroRadius
+	if agro <= 0 {
+		agro = gunshipDefaultAgro
+	}
+	return SanitizeMissileConfig(MissileConfig{
+		Speed:      speed,
+		AgroRadius: agro,
+		Guidance:   w.Guidance,
+		Warhead:    w.Warhead,
+	})
+}
+
+func (w WeaponProfile) cooldown() float64 {
+	if w.Cooldown > 0 {
+		return w.Cooldown
+	}
+	return gunshipDefaultReload
+}
+
+// spawnGunshipEntity creates a mission-owned ship that runs the group's AI
+// behavior and fires its weapon profile.
+func spawnGunshipEntity(r *Room, pos Vec2, group SpawnGroup) EntityID {
+	behaviorName := group.Behavior
+	if behaviorName == "" {
+		behaviorName = AIBehaviorOffensive
+	}
+	if _, err := NewAIBehavior(behaviorName); err != nil {
+		return 0
+	}
+	id := r.SpawnShip(missionOwnerID, pos)
+	if group.Hull != "" {
+		if hull, err := GetHull(group.Hull); err == nil {
+			r.applyHullLocked(id, *hull)
+		}
+	}
+	attachGunshipPilot(r, id, "Gunship", behaviorName, group.Weapon)
+	applyEntityTags(r, id, group.Tags)
+	return id
+}
+
+// attachGunshipPilot arms a mission ship with a synthetic pilot running the
+// named behavior.
+func attachGunshipPilot(r *Room, id EntityID, name, behaviorName string, weapon WeaponProfile) bool {
+	behavior, err := NewAIBehavior(behaviorName)
+	if err != nil {
+		return false
+	}
+	pilot := &Player{ID: missionOwnerID, Name: name, Ship: id, IsBot: true}
+	pilot.EnsureInventory()
+	ammo := weapon.Ammo
+	if ammo <= 0 {
+		ammo = gunshipUnlimitedAmmo
+	}
+	pilot.Inventory.AddItem("missile", "gunship", gunshipMissileHeat, ammo)
+
+	r.World.SetComponent(id, CompGunship, &GunshipComponent{
+		Pilot:    pilot,
+		Behavior: behavior,
+		Weapon:   weapon,
+	})
+	return true
+}
+
+// updateGunships plans for every armed mission entity. Route commands are
+// applied to the gunship; missile launches are replaced by the gunship's
+// weapon profile. Other commands, such as crafting, are ignored.
+func updateGunships(r *Room) {
+	world := r.World
+	world.ForEach([]ComponentKey{CompGunship, CompTransform}, func(id EntityID) {
+		if world.DestroyedData(id) != nil {
+			return
+		}
+		g := world.Gunship(id)
+		if g == nil || g.Behavior == nil || g.Pilot == nil || r.Now < g.NextPlanAt {
+			return
+		}
+		g.NextPlanAt = r.Now + gunshipPlanInterval
+		ctx := buildAIContext(r, g.Pilot, 0)
+		preferConvoyTargets(r, ctx)
+		ctx.Difficulty = AIDifficultyRegistry[DefaultAIDifficultyID]
+		for _, cmd := range g.Behavior.Plan(ctx) {
+			switch c := cmd.(type) {
+			case aiCommandSetShipRoute, aiCommandClearShipRoute:
+				c.apply(r, g.Pilot)
+			case aiCommandLaunchMissile:
+				r.fireGunshipMissile(id, g, c.waypoints)
+			}
+		}
+	})
+}
+
+// fireGunshipMissile launches one missile from the gunship's weapon along the
+// behavior's chosen waypoints.
+func (r *Room) fireGunshipMissile(id EntityID, g *GunshipComponent, waypoints []RouteWaypoint) EntityID {
+	tr := r.World.Transform(id)
+	if tr == nil || len(waypoints) == 0 || r.Now < g.Pilot.MissileReadyAt {
+		return 0
+	}
+	if aiMissileAmmo(g.Pilot) == 0 {
+		return 0
+	}
+	cfg := g.Weapon.missileConfig()
+	// Keep the behavior's heat-safe leg speeds, capped at the weapon's speed,
+	// then re-check them against the weapon's own missile heat.
+	route := make([]RouteWaypoint, len(waypoints))
+	for i, wp := range waypoints {
+		speed := wp.Speed
+		if speed <= 0 || speed > cfg.Speed {
+			speed = cfg.Speed
+		}
+		route[i] = RouteWaypoint{Pos: wp.Pos, Speed: speed}
+	}
+	route = clampMissileWaypointsToHeat(cfg.HeatParams, tr.Pos, route, cfg.HeatParams.OverheatAt*missileHeatCapRatio, MissileMinSpeed, cfg.Speed)
+	missileID := r.LaunchMissile(missionOwnerID, id, cfg, route, tr.Pos, tr.Vel)
+	if missileID == 0 {
+		return 0
+	}
+	if g.Weapon.Ammo > 0 {
+		g.Pilot.Inventory.RemoveItem("missile", "gunship", gunshipMissileHeat, 1)
+	}
+	g.Pilot.MissileReadyAt = r.Now + g.Weapon.cooldown()/SubsystemEfficiency(r.World, id, SubsystemLaunchers)
+	return missileID
+}
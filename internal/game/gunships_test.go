@@ -0,0 +1,147 @@
+package game
+
+import "testing"
+
+func gunshipTemplate(weapon WeaponProfile) *EncounterTemplate {
+	return &EncounterTemplate{
+		ID: "test-gunships",
+		SpawnGroups: []SpawnGroup{{
+			EntityType: "gunship",
+			Count:      CountRange{Min: 1, Max: 1},
+			Tags:       map[string]bool{"armed": true},
+			Weapon:     weapon,
+		}},
+	}
+}
+
+// gunshipDuelRoom spawns a gunship and a stationary player ship that the
+// gunship has been able to see for a while.
+func gunshipDuelRoom(t *testing.T, weapon WeaponProfile) (*Room, EntityID, *Player) {
+	t.Helper()
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 3000, Y: 1000})
+
+	spawned := SpawnFromTemplate(room, gunshipTemplate(weapon), Vec2{X: 1000, Y: 1000}, 1)
+	if len(spawned) != 1 {
+		t.Fatalf("expected one gunship, got %d", len(spawned))
+	}
+	for step := 1; step <= 120; step++ {
+		room.Now = float64(step) * Dt
+		room.World.HistoryComponent(player.Ship).History.push(Snapshot{T: room.Now, Pos: Vec2{X: 3000, Y: 1000}})
+	}
+	return room, spawned[0], player
+}
+
+func countMissionMissiles(room *Room) int {
+	count := 0
+	room.World.ForEach([]ComponentKey{CompMissile, CompOwner}, func(id EntityID) {
+		if room.World.Owner(id).PlayerID == missionOwnerID {
+			count++
+		}
+	})
+	return count
+}
+
+func TestSpawnFromTemplateCreatesGunship(t *testing.T) {
+	room, id, _ := gunshipDuelRoom(t, WeaponProfile{Ammo: 4})
+
+	ship := room.World.ShipData(id)
+	if ship == nil || ship.HP <= 0 {
+		t.Fatal("expected gunship to have ship HP")
+	}
+	if owner := room.World.Owner(id); owner == nil || owner.PlayerID != missionOwnerID {
+		t.Fatalf("expected mission owner, got %+v", owner)
+	}
+	g := room.World.Gunship(id)
+	if g == nil {
+		t.Fatal("expected gunship component")
+	}
+	if _, ok := g.Behavior.(*OffensiveBehavior); !ok {
+		t.Fatalf("expected default offensive behavior, got %T", g.Behavior)
+	}
+	if ammo := aiMissileAmmo(g.Pilot); ammo != 4 {
+		t.Fatalf("expected 4 missiles, got %d", ammo)
+	}
+	if _, ok := room.Players[missionOwnerID]; ok {
+		t.Fatal("gunship pilot must not join the room's players")
+	}
+	if tags := room.World.Tags(id); tags == nil || !tags.Tags["armed"] {
+		t.Fatal("expected spawn group tags on the gunship")
+	}
+}
+
+func TestGunshipFiresWeaponProfile(t *testing.T) {
+	room, id, _ := gunshipDuelRoom(t, WeaponProfile{MissileSpeed: 130, AgroRadius: 450, Cooldown: 5, Ammo: 2})
+
+	updateGunships(room)
+	if got := countMissionMissiles(room); got != 1 {
+		t.Fatalf("expected gunship to fire one missile, got %d", got)
+	}
+	room.World.ForEach([]ComponentKey{CompMissile}, func(m EntityID) {
+		if speed := room.World.Movement(m).MaxSpeed; speed != 130 {
+			t.Fatalf("expected profile missile speed 130, got %.1f", speed)
+		}
+		if agro := room.World.MissileData(m).AgroRadius; agro != 450 {
+			t.Fatalf("expected profile agro radius 450, got %.1f", agro)
+		}
+	})
+	g := room.World.Gunship(id)
+	if ammo := aiMissileAmmo(g.Pilot); ammo != 1 {
+		t.Fatalf("expected one missile left, got %d", ammo)
+	}
+
+	g.NextPlanAt = 0
+	updateGunships(room)
+	if got := countMissionMissiles(room); got != 1 {
+		t.Fatalf("expected weapon cooldown to block a second launch, got %d missiles", got)
+	}
+}
+
+func TestGunshipDestructionAndAlliance(t *testing.T) {
+	room, id, player := gunshipDuelRoom(t, WeaponProfile{})
+
+	mineOwner := &OwnerComponent{PlayerID: missionOwnerID, Neutral: true}
+	if !ownersAllied(mineOwner, room.World.Owner(id)) {
+		t.Fatal("mission hazards should not target gunships")
+	}
+	if ownersAllied(mineOwner, room.World.Owner(player.Ship)) {
+		t.Fatal("mission hazards should still target players")
+	}
+
+	hp := room.World.ShipData(id).HP
+	room.damageShip(id, hp, player.ID, nil)
+	if room.World.DestroyedData(id) == nil {
+		t.Fatal("expected gunship to be destroyed")
+	}
+	if player.Kills != 1 {
+		t.Fatalf("expected attacker to be credited, got %d kills", player.Kills)
+	}
+	updateGunships(room)
+	if got := countMissionMissiles(room); got != 0 {
+		t.Fatalf("destroyed gunships must not fire, got %d missiles", got)
+	}
+}
+
+func TestGunshipKeepsPlannedLegSpeeds(t *testing.T) {
+	room, id, player := gunshipDuelRoom(t, WeaponProfile{MissileSpeed: 130})
+	g := room.World.Gunship(id)
+	target := room.World.Transform(player.Ship).Pos
+	waypoints := []RouteWaypoint{{Pos: target, Speed: 90}, {Pos: target.Add(Vec2{X: 200}), Speed: 400}}
+
+	missile := room.fireGunshipMissile(id, g, waypoints)
+	if missile == 0 {
+		t.Fatal("expected the gunship to launch")
+	}
+	route := room.World.Route(missile)
+	if route == nil || len(route.Waypoints) != 2 {
+		t.Fatalf("expected the planned route, got %+v", route)
+	}
+	if got := route.Waypoints[0].Speed; got > 90 {
+		t.Fatalf("expected the slow leg to stay at or below 90, got %.1f", got)
+	}
+	if got := route.Waypoints[1].Speed; got > 130 {
+		t.Fatalf("expected the fast leg capped at the weapon speed, got %.1f", got)
+	}
+}
@@ -0,0 +1,311 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// Defaults and rewards for training episodes
+const (
+	gymDefaultTicksPerStep = 4    // One step per bot plan interval
+	gymDefaultMaxSteps     = 1500 // Five minutes of simulated time
+	gymStartingMissiles    = 10   // Same stock bots start with
+	gymKillReward          = 1.0
+	gymDeathReward         = -1.0
+)
+
+// GymConfig describes a training episode. Agents are controlled through
+// Step; opponents are regular bots running the named behaviors.
+type GymConfig struct {
+	Agents       int        `json:"agents"`         // Learning agents, named agent-0..agent-N-1
+	Opponents    []string   `json:"opponents"`      // AI behavior per scripted opponent
+	Difficulty   string     `json:"difficulty"`     // Opponent difficulty
+	Hull         string     `json:"hull"`           // Hull for every ship; empty uses the default
+	TicksPerStep int        `json:"ticks_per_step"` // Simulation ticks per Step
+	MaxSteps     int        `json:"max_steps"`      // Steps before the episode is done
+	WorldWidth   float64    `json:"world_width"`
+	WorldHeight  float64    `json:"world_height"`
+	Heat         HeatParams `json:"-"` // Room heat defaults; zero uses DefaultHeatParams
+}
+
+// GymWaypoint is a route or missile waypoint in an action.
+type GymWaypoint struct {
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Speed float64 `json:"speed,omitempty"` // Missiles use their configured speed when 0
+}
+
+// GymAction is what an agent does in one step. Fields map onto the same
+// AICommands bots issue; empty fields leave the ship as it is.
+type GymAction struct {
+	Stop    bool          `json:"stop,omitempty"`    // Clear the ship route
+	Route   []GymWaypoint `json:"route,omitempty"`   // Replace the ship route
+	Missile []GymWaypoint `json:"missile,omitempty"` // Launch a missile along this path
+	Craft   string        `json:"craft,omitempty"`   // Start a DAG node, e.g. a missile craft
+}
+
+// GymShipObservation is a perceived enemy ship. Like a player, the agent only
+// sees where it was, not its health.
+type GymShipObservation struct {
+	Pos    Vec2    `json:"pos"`
+	Vel    Vec2    `json:"vel"`
+	SeenAt float64 `json:"seen_at"`
+}
+
+// GymThreatObservation is a perceived hostile missile.
+type GymThreatObservation struct {
+	Pos               Vec2    `json:"pos"`
+	Vel               Vec2    `json:"vel"`
+	Distance          float64 `json:"distance"`
+	TimeToClosest     float64 `json:"time_to_closest"`
+	DistanceAtClosest float64 `json:"distance_at_closest"`
+	TargetingSelf     bool    `json:"targeting_self"`
+}
+
+// GymObservation is an agent's view of the world. Enemy ships and missiles
+// come from the same light-delayed perception bots and players use.
+type GymObservation struct {
+	Now          float64                `json:"now"`
+	Pos          Vec2                   `json:"pos"`
+	Vel          Vec2                   `json:"vel"`
+	HP           int                    `json:"hp"`
+	MaxHP        int                    `json:"max_hp"`
+	Heat         float64                `json:"heat"`
+	OverheatAt   float64                `json:"overheat_at"`
+	MissileReady bool                   `json:"missile_ready"`
+	Ammo         int                    `json:"ammo"`
+	Opponents    []GymShipObservation   `json:"opponents"`
+	Threats      []GymThreatObservation `json:"threats"`
+}
+
+// GymEnv wraps a Room for offline training. It ticks the room directly, so
+// episodes run as fast as the simulation allows instead of at SimHz.
+type GymEnv struct {
+	Config GymConfig
+	Room   *Room
+	Agents map[string]*Player
+
+	steps  int
+	deaths map[string]int // Player ID -> deaths already rewarded
+}
+
+// NewGymEnv validates the config and fills in defaults. Call Reset to start
+// the first episode.
+func NewGymEnv(cfg GymConfig) (*GymEnv, error) {
+	if cfg.Agents <= 0 {
+		cfg.Agents = 1
+	}
+	if cfg.Difficulty == "" {
+		cfg.Difficulty = DefaultAIDifficultyID
+	}
+	if cfg.TicksPerStep <= 0 {
+		cfg.TicksPerStep = gymDefaultTicksPerStep
+	}
+	if cfg.MaxSteps <= 0 {
+		cfg.MaxSteps = gymDefaultMaxSteps
+	}
+	if cfg.WorldWidth <= 0 {
+		cfg.WorldWidth = WorldW
+	}
+	if cfg.WorldHeight <= 0 {
+		cfg.WorldHeight = WorldH
+	}
+	if cfg.Heat.Max <= 0 {
+		cfg.Heat = DefaultHeatParams()
+	}
+	for _, name := range cfg.Opponents {
+		if _, err := NewAIBehavior(name); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := GetAIDifficulty(cfg.Difficulty); err != nil {
+		return nil, err
+	}
+	if cfg.Hull != "" {
+		if _, err := GetHull(cfg.Hull); err != nil {
+			return nil, err
+		}
+	}
+	return &GymEnv{Config: cfg}, nil
+}
+
+// AgentIDs returns the agent IDs in sorted order.
+func (e *GymEnv) AgentIDs() []string {
+	ids := make([]string, 0, len(e.Agents))
+	for id := range e.Agents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Reset starts a new episode in a fresh room. Spawn positions are drawn from
+// the seed: agents on the left of the map, opponents on the right.
+func (e *GymEnv) Reset(seed int64) (map[string]GymObservation, error) {
+	cfg := e.Config
+	rng := rand.New(rand.NewSource(seed))
+	room := newRoom(fmt.Sprintf("gym-%d", seed), cfg.Heat)
+	room.SeedLocked(seed)
+	room.SetWorldSize(cfg.WorldWidth, cfg.WorldHeight)
+	spawnPos := func(minX, maxX float64) Vec2 {
+		return Vec2{
+			X: room.WorldWidth * (minX + (maxX-minX)*rng.Float64()),
+			Y: room.WorldHeight * (0.2 + 0.6*rng.Float64()),
+		}
+	}
+
+	e.Room = room
+	e.Agents = make(map[string]*Player, cfg.Agents)
+	e.deaths = make(map[string]int, cfg.Agents)
+	e.steps = 0
+
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+	for i := 0; i < cfg.Agents; i++ {
+		p := &Player{
+			ID:            fmt.Sprintf("agent-%d", i),
+			Name:          fmt.Sprintf("Agent %d", i),
+			MissileConfig: SanitizeMissileConfig(MissileConfig{Speed: ShipMaxSpeed * 0.75, AgroRadius: 800}),
+		}
+		p.EnsureMissileRoutes()
+		p.EnsureDagState()
+		p.EnsureInventory()
+		p.Inventory.AddItem("missile", "basic", 80, gymStartingMissiles)
+		p.Ship = room.SpawnShip(p.ID, spawnPos(0.1, 0.35))
+		room.Players[p.ID] = p
+		if cfg.Hull != "" {
+			if err := room.SetPlayerHullLocked(p, cfg.Hull); err != nil {
+				return nil, err
+			}
+		}
+		e.Agents[p.ID] = p
+	}
+	for _, behavior := range cfg.Opponents {
+		_, err := room.AddConfiguredBotLocked(BotSpawnOptions{
+			Behavior:   behavior,
+			Difficulty: cfg.Difficulty,
+			Hull:       cfg.Hull,
+			Pos:        spawnPos(0.65, 0.9),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.observeLocked(), nil
+}
+
+// Step applies one action per agent, advances the room by TicksPerStep ticks
+// and returns each agent's observation and reward. Agents reward +1 per kill
+// and -1 per death. Actions for unknown agents are ignored.
+func (e *GymEnv) Step(actions map[string]GymAction) (map[string]GymObservation, map[string]float64, bool) {
+	room := e.Room
+	if room == nil {
+		return nil, nil, true
+	}
+	room.Mu.Lock()
+	for id, action := range actions {
+		if p := e.Agents[id]; p != nil {
+			for _, cmd := range action.commands(p) {
+				cmd.apply(room, p)
+			}
+		}
+	}
+	room.Mu.Unlock()
+
+	for i := 0; i < e.Config.TicksPerStep; i++ {
+		room.Tick()
+	}
+	e.steps++
+
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+	rewards := make(map[string]float64, len(e.Agents))
+	for id := range e.Agents {
+		rewards[id] = 0
+	}
+	// Player.Kills only counts kills of bots, so credit each death in the room
+	// to its killer instead; this also rewards agents for killing each other.
+	for id, p := range room.Players {
+		died := p.Deaths - e.deaths[id]
+		if died <= 0 {
+			continue
+		}
+		e.deaths[id] = p.Deaths
+		if _, ok := e.Agents[id]; ok {
+			rewards[id] += float64(died) * gymDeathReward
+		}
+		if _, ok := e.Agents[p.KilledBy]; ok && p.KilledBy != id {
+			rewards[p.KilledBy] += float64(died) * gymKillReward
+		}
+	}
+	return e.observeLocked(), rewards, e.steps >= e.Config.MaxSteps
+}
+
+// commands translates an action into AICommands for the agent.
+func (a GymAction) commands(p *Player) []AICommand {
+	var cmds []AICommand
+	if a.Stop {
+		cmds = append(cmds, CommandClearShipRoute())
+	}
+	if len(a.Route) > 0 {
+		cmds = append(cmds, CommandSetShipRoute(gymWaypoints(a.Route)))
+	}
+	if len(a.Missile) > 0 {
+		cmds = append(cmds, CommandLaunchMissile(p.MissileConfig, gymWaypoints(a.Missile)))
+	}
+	if a.Craft != "" {
+		cmds = append(cmds, CommandDagStart(a.Craft))
+	}
+	return cmds
+}
+
+func gymWaypoints(in []GymWaypoint) []RouteWaypoint {
+	out := make([]RouteWaypoint, len(in))
+	for i, wp := range in {
+		out[i] = RouteWaypoint{Pos: Vec2{X: wp.X, Y: wp.Y}, Speed: wp.Speed}
+	}
+	return out
+}
+
+// observeLocked builds every agent's observation. Callers must hold Room.Mu.
+func (e *GymEnv) observeLocked() map[string]GymObservation {
+	obs := make(map[string]GymObservation, len(e.Agents))
+	for id, p := range e.Agents {
+		ctx := buildAIContext(e.Room, p, 0)
+		o := GymObservation{
+			Now:          ctx.Now,
+			MissileReady: ctx.MissileReady(),
+			Ammo:         aiMissileAmmo(p),
+			Opponents:    make([]GymShipObservation, 0, len(ctx.Opponents)),
+			Threats:      make([]GymThreatObservation, 0, len(ctx.Threats)),
+		}
+		if ctx.SelfTransform != nil {
+			o.Pos = ctx.SelfTransform.Pos
+			o.Vel = ctx.SelfTransform.Vel
+		}
+		if ctx.SelfShip != nil {
+			o.HP = ctx.SelfShip.HP
+			o.MaxHP = ctx.SelfShip.MaxHP
+		}
+		if ctx.SelfHeat != nil {
+			o.Heat = ctx.SelfHeat.S.Value
+			o.OverheatAt = ctx.SelfHeat.P.OverheatAt
+		}
+		for _, op := range ctx.Opponents {
+			o.Opponents = append(o.Opponents, GymShipObservation{Pos: op.Transform.Pos, Vel: op.Transform.Vel, SeenAt: op.SeenAt})
+		}
+		for _, th := range ctx.Threats {
+			o.Threats = append(o.Threats, GymThreatObservation{
+				Pos:               th.Pos,
+				Vel:               th.Vel,
+				Distance:          th.Distance,
+				TimeToClosest:     th.TimeToClosest,
+				DistanceAtClosest: th.DistanceAtClosest,
+				TargetingSelf:     th.TargetingSelf,
+			})
+		}
+		obs[id] = o
+	}
+	return obs
+}
@@ -0,0 +1,96 @@
+package game
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// gymRequest is one line of the gym protocol. Commands are "reset" (with an
+// optional config that replaces the current one), "step" and "close".
+type gymRequest struct {
+	Cmd     string               `json:"cmd"`
+	Seed    int64                `json:"seed,omitempty"`
+	Config  *GymConfig           `json:"config,omitempty"`
+	Actions map[string]GymAction `json:"actions,omitempty"`
+}
+
+// gymResponse answers every request with one line.
+type gymResponse struct {
+	Agents       []string                  `json:"agents,omitempty"`
+	Observations map[string]GymObservation `json:"observations,omitempty"`
+	Rewards      map[string]float64        `json:"rewards,omitempty"`
+	Done         bool                      `json:"done"`
+	Error        string                    `json:"error,omitempty"`
+}
+
+// ServeGym runs the gym protocol: newline-delimited JSON requests on in and
+// one JSON response per request on out. It returns when in is exhausted or a
+// close command arrives. Request errors are reported in the response and do
+// not end the session.
+func ServeGym(in io.Reader, out io.Writer, cfg GymConfig) error {
+	env, err := NewGymEnv(cfg)
+	if err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	enc := json.NewEncoder(out)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var req gymRequest
+		var resp gymResponse
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			resp.Error = fmt.Sprintf("invalid request: %v", err)
+		} else {
+			switch req.Cmd {
+			case "reset":
+				resp = env.handleReset(req)
+			case "step":
+				resp = env.handleStep(req)
+			case "close":
+				return enc.Encode(gymResponse{Done: true})
+			default:
+				resp.Error = fmt.Sprintf("unknown command: %s", req.Cmd)
+			}
+		}
+		if err := enc.Encode(resp); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func (e *GymEnv) handleReset(req gymRequest) gymResponse {
+	if req.Config != nil {
+		// Heat params are not part of the wire config; keep the server's.
+		cfg := *req.Config
+		cfg.Heat = e.Config.Heat
+		next, err := NewGymEnv(cfg)
+		if err != nil {
+			return gymResponse{Error: err.Error()}
+		}
+		*e = *next
+	}
+	obs, err := e.Reset(req.Seed)
+	if err != nil {
+		return gymResponse{Error: err.Error()}
+	}
+	return gymResponse{Agents: e.AgentIDs(), Observations: obs}
+}
+
+func (e *GymEnv) handleStep(req gymRequest) gymResponse {
+	if e.Room == nil {
+		return gymResponse{Error: "reset before stepping"}
+	}
+	for id := range req.Actions {
+		if e.Agents[id] == nil {
+			return gymResponse{Error: fmt.Sprintf("unknown agent: %s", id)}
+		}
+	}
+	obs, rewards, done := e.Step(req.Actions)
+	return gymResponse{Observations: obs, Rewards: rewards, Done: done}
+}
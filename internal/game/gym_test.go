@@ -0,0 +1,195 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGymResetAndStep(t *testing.T) {
+	env, err := NewGymEnv(GymConfig{Opponents: []string{AIBehaviorDefensive}, MaxSteps: 3})
+	if err != nil {
+		t.Fatalf("failed to create env: %v", err)
+	}
+	obs, err := env.Reset(7)
+	if err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	agent := obs["agent-0"]
+	if agent.HP <= 0 || agent.Ammo != gymStartingMissiles || !agent.MissileReady {
+		t.Fatalf("unexpected initial observation %+v", agent)
+	}
+
+	again, _ := env.Reset(7)
+	if again["agent-0"].Pos != agent.Pos {
+		t.Fatal("expected the same seed to reproduce spawn positions")
+	}
+
+	target := agent.Pos.Add(Vec2{X: 500})
+	action := GymAction{
+		Route:   []GymWaypoint{{X: target.X, Y: target.Y, Speed: 100}},
+		Missile: []GymWaypoint{{X: target.X, Y: target.Y}},
+	}
+	var done bool
+	var rewards map[string]float64
+	for step := 1; step <= 3; step++ {
+		if done {
+			t.Fatalf("episode ended early at step %d", step)
+		}
+		obs, rewards, done = env.Step(map[string]GymAction{"agent-0": action})
+	}
+	if !done {
+		t.Fatal("expected the episode to end after MaxSteps")
+	}
+	if _, ok := rewards["agent-0"]; !ok {
+		t.Fatal("expected a reward for every agent")
+	}
+	agent = obs["agent-0"]
+	wantNow := 3 * float64(gymDefaultTicksPerStep) * Dt
+	if agent.Now < wantNow-1e-9 || agent.Now > wantNow+1e-9 {
+		t.Fatalf("expected %.2fs of simulated time, got %.2f", wantNow, agent.Now)
+	}
+	if agent.Vel.X <= 0 {
+		t.Fatalf("expected the route action to move the ship, got velocity %+v", agent.Vel)
+	}
+	if agent.Ammo != gymStartingMissiles-1 {
+		t.Fatalf("expected one missile launched, ammo %d", agent.Ammo)
+	}
+}
+
+func TestGymResetReplaysEpisode(t *testing.T) {
+	env, err := NewGymEnv(GymConfig{Opponents: []string{AIBehaviorDefensive, AIBehaviorOffensive}, Difficulty: "hard"})
+	if err != nil {
+		t.Fatalf("failed to create env: %v", err)
+	}
+	run := func() map[string]GymObservation {
+		if _, err := env.Reset(3); err != nil {
+			t.Fatalf("reset failed: %v", err)
+		}
+		var obs map[string]GymObservation
+		for step := 0; step < 60; step++ {
+			obs, _, _ = env.Step(nil)
+		}
+		return obs
+	}
+	first := run()
+	if again := run(); !reflect.DeepEqual(first, again) {
+		t.Fatalf("expected the same seed to replay the episode, got %+v and %+v", first, again)
+	}
+}
+
+func TestGymRewardsKillsAndDeaths(t *testing.T) {
+	env, err := NewGymEnv(GymConfig{Agents: 2})
+	if err != nil {
+		t.Fatalf("failed to create env: %v", err)
+	}
+	if _, err := env.Reset(1); err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	room := env.Room
+	bot, err := room.AddConfiguredBotLocked(BotSpawnOptions{Pos: Vec2{X: 6000, Y: 3000}})
+	if err != nil {
+		t.Fatalf("failed to add bot: %v", err)
+	}
+	victim := env.Agents["agent-1"]
+	room.damageShip(bot.Ship, room.World.ShipData(bot.Ship).HP, "agent-0", nil)
+	room.damageShip(victim.Ship, room.World.ShipData(victim.Ship).HP, bot.ID, nil)
+
+	_, rewards, _ := env.Step(nil)
+	if rewards["agent-0"] != gymKillReward {
+		t.Fatalf("expected kill reward, got %.1f", rewards["agent-0"])
+	}
+	if rewards["agent-1"] != gymDeathReward {
+		t.Fatalf("expected death penalty, got %.1f", rewards["agent-1"])
+	}
+	_, rewards, _ = env.Step(nil)
+	if rewards["agent-0"] != 0 || rewards["agent-1"] != 0 {
+		t.Fatalf("expected rewards to be per step, got %+v", rewards)
+	}
+}
+
+func TestGymRewardsAgentKillingAgent(t *testing.T) {
+	env, err := NewGymEnv(GymConfig{Agents: 2})
+	if err != nil {
+		t.Fatalf("failed to create env: %v", err)
+	}
+	if _, err := env.Reset(1); err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	room := env.Room
+	victim := env.Agents["agent-1"]
+	room.damageShip(victim.Ship, room.World.ShipData(victim.Ship).HP, "agent-0", nil)
+
+	_, rewards, _ := env.Step(nil)
+	if rewards["agent-0"] != gymKillReward {
+		t.Fatalf("expected the killer to be rewarded, got %.1f", rewards["agent-0"])
+	}
+	if rewards["agent-1"] != gymDeathReward {
+		t.Fatalf("expected death penalty, got %.1f", rewards["agent-1"])
+	}
+}
+
+func TestGymObservationsAreLightDelayed(t *testing.T) {
+	env, err := NewGymEnv(GymConfig{Opponents: []string{AIBehaviorDefensive}})
+	if err != nil {
+		t.Fatalf("failed to create env: %v", err)
+	}
+	obs, err := env.Reset(3)
+	if err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	if len(obs["agent-0"].Opponents) != 0 {
+		t.Fatal("expected no opponents before their light arrives")
+	}
+	for i := 0; i < 100 && len(obs["agent-0"].Opponents) == 0; i++ {
+		obs, _, _ = env.Step(nil)
+	}
+	seen := obs["agent-0"].Opponents
+	if len(seen) != 1 {
+		t.Fatalf("expected the opponent to become visible, got %d", len(seen))
+	}
+	if seen[0].SeenAt >= obs["agent-0"].Now {
+		t.Fatalf("expected a delayed snapshot, seen at %.2f now %.2f", seen[0].SeenAt, obs["agent-0"].Now)
+	}
+}
+
+func TestServeGymProtocol(t *testing.T) {
+	in := strings.NewReader(strings.Join([]string{
+		`{"cmd":"reset","seed":2,"config":{"agents":1,"max_steps":1}}`,
+		`{"cmd":"step","actions":{"nobody":{}}}`,
+		`{"cmd":"step","actions":{"agent-0":{"stop":true}}}`,
+		`{"cmd":"warp"}`,
+		`{"cmd":"close"}`,
+		`{"cmd":"step"}`,
+	}, "\n"))
+	var out bytes.Buffer
+	if err := ServeGym(in, &out, GymConfig{}); err != nil {
+		t.Fatalf("serve failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected one response per request up to close, got %d", len(lines))
+	}
+	var responses []gymResponse
+	for _, line := range lines {
+		var resp gymResponse
+		if err := json.Unmarshal([]byte(line), &resp); err != nil {
+			t.Fatalf("invalid response %q: %v", line, err)
+		}
+		responses = append(responses, resp)
+	}
+	if len(responses[0].Agents) != 1 || responses[0].Error != "" {
+		t.Fatalf("unexpected reset response %+v", responses[0])
+	}
+	if responses[1].Error == "" {
+		t.Fatal("expected an error for an unknown agent")
+	}
+	if !responses[2].Done || responses[2].Error != "" {
+		t.Fatalf("expected the one-step episode to finish, got %+v", responses[2])
+	}
+	if responses[3].Error == "" {
+		t.Fatal("expected an error for an unknown command")
+	}
+}
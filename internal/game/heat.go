@@ -44,6 +44,12 @@ func (h *HeatComponent) IsStalled(now float64) bool {
 //	if dev >= 0: Ḣ = +KUp * (dev/MarkerSpeed)^Exp
 //	else:        Ḣ = -KDown * (|dev|/MarkerSpeed)^Exp
 func UpdateHeat(h *HeatComponent, speed float64, dt, now float64) {
+	UpdateHeatWithCooling(h, speed, dt, now, 1.0)
+}
+
+// UpdateHeatWithCooling is UpdateHeat with KDown scaled by coolingScale,
+// used for damaged subsystems and deployed radiators.
+func UpdateHeatWithCooling(h *HeatComponent, speed float64, dt, now, coolingScale float64) {
 	Vn := math.Max(h.P.MarkerSpeed, 1e-6) // Avoid division by zero
 	dev := speed - h.P.MarkerSpeed
 	p := h.P.Exp
@@ -54,7 +60,7 @@ func UpdateHeat(h *HeatComponent, speed float64, dt, now float64) {
 		hdot = h.P.KUp * math.Pow(dev/Vn, p)
 	} else {
 		// Below marker: heat dissipates
-		hdot = -h.P.KDown * math.Pow(math.Abs(dev)/Vn, p)
+		hdot = -h.P.KDown * coolingScale * math.Pow(math.Abs(dev)/Vn, p)
 	}
 
 	// Integrate heat change
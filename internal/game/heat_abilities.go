@@ -0,0 +1,110 @@
+package game
+
+import "fmt"
+
+// Heat sink inventory item produced by the heat sink craft node.
+const (
+	HeatSinkItemType  = "heat_sink"
+	HeatSinkVariantID = "standard"
+)
+
+// newHeatAbilities returns a heat ability mount with every ability ready.
+func newHeatAbilities() *HeatAbilitiesComponent {
+	return &HeatAbilitiesComponent{}
+}
+
+// Reset stows radiators and clears every cooldown.
+func (a *HeatAbilitiesComponent) Reset() {
+	*a = HeatAbilitiesComponent{}
+}
+
+// VentVisibleAt reports whether a vent plume would be visible in light
+// emitted at time t. Observers should pass the perceived snapshot time so the
+// signature arrives with the usual light delay.
+func (a *HeatAbilitiesComponent) VentVisibleAt(t float64) bool {
+	if a == nil || a.VentedAt <= 0 {
+		return false
+	}
+	return t >= a.VentedAt && t < a.VentedAt+HeatVentSignatureSeconds
+}
+
+// radiatorCooling returns the KDown multiplier from deployed radiators. They
+// only help while the ship is holding still.
+func radiatorCooling(a *HeatAbilitiesComponent, speed float64) float64 {
+	if a == nil || !a.RadiatorsDeployed || speed > RadiatorStationarySpeed {
+		return 1
+	}
+	return RadiatorKDownMultiplier
+}
+
+// heatAbilitiesFor returns the player's ship heat state and ability mount.
+func (r *Room) heatAbilitiesFor(p *Player) (*HeatComponent, *HeatAbilitiesComponent, error) {
+	if p == nil {
+		return nil, nil, fmt.Errorf("nil player")
+	}
+	heat := r.World.HeatData(p.Ship)
+	abilities := r.World.HeatAbilities(p.Ship)
+	if heat == nil || abilities == nil {
+		return nil, nil, fmt.Errorf("player %s has no ship", p.ID)
+	}
+	return heat, abilities, nil
+}
+
+// VentHeatLocked performs an emergency vent, dumping HeatVentAmount heat at
+// the cost of a plume other players can see. Callers must hold r.Mu.
+func (r *Room) VentHeatLocked(p *Player) error {
+	heat, abilities, err := r.heatAbilitiesFor(p)
+	if err != nil {
+		return err
+	}
+	if r.Now < abilities.VentReadyAt {
+		return fmt.Errorf("vent on cooldown for %.1fs", abilities.VentReadyAt-r.Now)
+	}
+	heat.S.Value -= HeatVentAmount
+	if heat.S.Value < 0 {
+		heat.S.Value = 0
+	}
+	abilities.VentedAt = r.Now
+	abilities.VentReadyAt = r.Now + HeatVentCooldown
+	return nil
+}
+
+// SetRadiatorsLocked deploys or stows the ship's radiators. Callers must hold
+// r.Mu.
+func (r *Room) SetRadiatorsLocked(p *Player, deployed bool) error {
+	_, abilities, err := r.heatAbilitiesFor(p)
+	if err != nil {
+		return err
+	}
+	if abilities.RadiatorsDeployed == deployed {
+		return nil
+	}
+	if r.Now < abilities.RadiatorReadyAt {
+		return fmt.Errorf("radiators busy for %.1fs", abilities.RadiatorReadyAt-r.Now)
+	}
+	abilities.RadiatorsDeployed = deployed
+	abilities.RadiatorReadyAt = r.Now + RadiatorToggleCooldown
+	return nil
+}
+
+// UseHeatSinkLocked consumes a heat sink from the player's inventory to
+// absorb HeatSinkAmount heat. Callers must hold r.Mu.
+func (r *Room) UseHeatSinkLocked(p *Player) error {
+	heat, abilities, err := r.heatAbilitiesFor(p)
+	if err != nil {
+		return err
+	}
+	if r.Now < abilities.SinkReadyAt {
+		return fmt.Errorf("heat sink on cooldown for %.1fs", abilities.SinkReadyAt-r.Now)
+	}
+	p.EnsureInventory()
+	if !p.Inventory.RemoveItem(HeatSinkItemType, HeatSinkVariantID, 0, 1) {
+		return fmt.Errorf("no heat sinks in inventory")
+	}
+	heat.S.Value -= HeatSinkAmount
+	if heat.S.Value < 0 {
+		heat.S.Value = 0
+	}
+	abilities.SinkReadyAt = r.Now + HeatSinkCooldown
+	return nil
+}
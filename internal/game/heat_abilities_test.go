@@ -0,0 +1,87 @@
+package game
+
+import "testing"
+
+func newHeatAbilityTestPlayer(room *Room) *Player {
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+	return player
+}
+
+func TestEmergencyVentDumpsHeatAndLeavesSignature(t *testing.T) {
+	room := newCombatTestRoom()
+	player := newHeatAbilityTestPlayer(room)
+	heat := room.World.HeatData(player.Ship)
+	heat.S.Value = 80
+
+	room.Now = 10
+	if err := room.VentHeatLocked(player); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if heat.S.Value != 80-HeatVentAmount {
+		t.Fatalf("expected vent to dump heat, got %.1f", heat.S.Value)
+	}
+	abilities := room.World.HeatAbilities(player.Ship)
+	if !abilities.VentVisibleAt(room.Now) || abilities.VentVisibleAt(room.Now+HeatVentSignatureSeconds) {
+		t.Fatal("expected vent plume to be visible only for the signature window")
+	}
+	if abilities.VentVisibleAt(room.Now - 1) {
+		t.Fatal("light emitted before the vent should not show the plume")
+	}
+	if err := room.VentHeatLocked(player); err == nil {
+		t.Fatal("expected vent to be on cooldown")
+	}
+}
+
+func TestDeployedRadiatorsCoolOnlyWhileStationary(t *testing.T) {
+	room := newCombatTestRoom()
+	player := newHeatAbilityTestPlayer(room)
+	heat := room.World.HeatData(player.Ship)
+
+	heat.S.Value = 50
+	updateRouteFollowers(room, 1)
+	baseline := 50 - heat.S.Value
+
+	if err := room.SetRadiatorsLocked(player, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	heat.S.Value = 50
+	updateRouteFollowers(room, 1)
+	if cooled := 50 - heat.S.Value; cooled <= baseline*(RadiatorKDownMultiplier-0.01) {
+		t.Fatalf("expected deployed radiators to boost cooling beyond %.2f, got %.2f", baseline, cooled)
+	}
+
+	if got := radiatorCooling(room.World.HeatAbilities(player.Ship), ShipMaxSpeed); got != 1 {
+		t.Fatalf("radiators should not help while moving, got %.2f", got)
+	}
+	if err := room.SetRadiatorsLocked(player, false); err == nil {
+		t.Fatal("expected radiator toggle cooldown")
+	}
+}
+
+func TestHeatSinkConsumesInventory(t *testing.T) {
+	room := newCombatTestRoom()
+	player := newHeatAbilityTestPlayer(room)
+	heat := room.World.HeatData(player.Ship)
+	heat.S.Value = 60
+
+	if err := room.UseHeatSinkLocked(player); err == nil {
+		t.Fatal("expected error without a heat sink")
+	}
+	player.EnsureInventory()
+	player.Inventory.AddItem(HeatSinkItemType, HeatSinkVariantID, 0, 2)
+
+	if err := room.UseHeatSinkLocked(player); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if heat.S.Value != 60-HeatSinkAmount {
+		t.Fatalf("expected heat sink to absorb heat, got %.1f", heat.S.Value)
+	}
+	if err := room.UseHeatSinkLocked(player); err == nil {
+		t.Fatal("expected heat sink cooldown")
+	}
+	if n := player.Inventory.GetItemCount(HeatSinkItemType, HeatSinkVariantID); n != 1 {
+		t.Fatalf("expected one heat sink left, got %d", n)
+	}
+}
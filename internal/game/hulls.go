@@ -0,0 +1,226 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+)
+
+// HullAbility names a special trait granted by a hull class.
+type HullAbility string
+
+const (
+	HullAbilityNone HullAbility = ""
+	// HullAbilityPointDefense fits an upgraded point-defense mount.
+	HullAbilityPointDefense HullAbility = "point_defense"
+	// HullAbilityColdRunning reduces the heat spike taken from missile hits.
+	HullAbilityColdRunning HullAbility = "cold_running"
+)
+
+// HullClass defines the base stats of a ship hull. Heat values are scale
+// factors applied to the room's default heat parameters so hulls keep working
+// when the world config changes.
+type HullClass struct {
+	ID                        string
+	DisplayName               string
+	MaxHP                     int
+	MaxSpeed                  float64
+	HeatCapacityScale         float64 // Scales Max, WarnAt and OverheatAt
+	HeatKUpScale              float64 // Scales heating rate
+	HeatKDownScale            float64 // Scales cooling rate
+	MissileCooldownMultiplier float64 // Scales the launch cooldown
+	HitRadius                 float64 // Missile contact radius against this hull
+	Ability                   HullAbility
+}
+
+// DefaultHullID is the hull given to players that do not pick one.
+const DefaultHullID = "frigate"
+
+// HullRegistry holds all defined hull classes.
+var HullRegistry = map[string]HullClass{
+	"frigate": {
+		ID:                        "frigate",
+		DisplayName:               "Frigate",
+		MaxHP:                     ShipMaxHP,
+		MaxSpeed:                  ShipMaxSpeed,
+		HeatCapacityScale:         1.0,
+		HeatKUpScale:              1.0,
+		HeatKDownScale:            1.0,
+		MissileCooldownMultiplier: 1.0,
+		HitRadius:                 MissileHitRadius,
+	},
+	"interceptor": {
+		ID:                        "interceptor",
+		DisplayName:               "Interceptor",
+		MaxHP:                     2,
+		MaxSpeed:                  300.0,
+		HeatCapacityScale:         0.85,
+		HeatKUpScale:              1.0,
+		HeatKDownScale:            1.25,
+		MissileCooldownMultiplier: 1.2,
+		HitRadius:                 40.0,
+		Ability:                   HullAbilityColdRunning,
+	},
+	"destroyer": {
+		ID:                        "destroyer",
+		DisplayName:               "Destroyer",
+		MaxHP:                     5,
+		MaxSpeed:                  190.0,
+		HeatCapacityScale:         1.3,
+		HeatKUpScale:              1.1,
+		HeatKDownScale:            0.9,
+		MissileCooldownMultiplier: 0.75,
+		HitRadius:                 65.0,
+		Ability:                   HullAbilityPointDefense,
+	},
+}
+
+// GetHull retrieves a hull class by ID.
+func GetHull(id string) (*HullClass, error) {
+	if id == "" {
+		return nil, fmt.Errorf("hull class not found: empty id")
+	}
+	hull, ok := HullRegistry[id]
+	if !ok {
+		return nil, fmt.Errorf("hull class not found: %s", id)
+	}
+	return &hull, nil
+}
+
+// ResolveHull returns the hull for id, falling back to the default hull.
+func ResolveHull(id string) HullClass {
+	if hull, err := GetHull(id); err == nil {
+		return *hull
+	}
+	return HullRegistry[DefaultHullID]
+}
+
+// HullIDs returns the registered hull IDs in sorted order.
+func HullIDs() []string {
+	ids := make([]string, 0, len(HullRegistry))
+	for id := range HullRegistry {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// HeatParams derives this hull's heat profile from the room defaults.
+func (h HullClass) HeatParams(base HeatParams) HeatParams {
+	params := base
+	if h.HeatCapacityScale > 0 {
+		params.Max *= h.HeatCapacityScale
+		params.WarnAt *= h.HeatCapacityScale
+		params.OverheatAt *= h.HeatCapacityScale
+	}
+	// The cruise speed that holds heat steady follows the hull's top speed.
+	if h.MaxSpeed > 0 {
+		params.MarkerSpeed *= h.MaxSpeed / ShipMaxSpeed
+	}
+	if h.HeatKUpScale > 0 {
+		params.KUp *= h.HeatKUpScale
+	}
+	if h.HeatKDownScale > 0 {
+		params.KDown *= h.HeatKDownScale
+	}
+	if h.Ability == HullAbilityColdRunning {
+		params.MissileSpikeMin *= 0.5
+		params.MissileSpikeMax *= 0.5
+	}
+	return params
+}
+
+// PointDefense returns the point-defense mount fitted to this hull.
+func (h HullClass) PointDefense() *PointDefenseComponent {
+	pd := newPointDefense()
+	if h.Ability == HullAbilityPointDefense {
+		pd.Range *= 1.4
+		pd.Cooldown *= 0.6
+		pd.HitChance = Clamp(pd.HitChance*1.3, 0, 1)
+	}
+	return pd
+}
+
+// hullForOwner returns the hull class selected by the given player.
+func (r *Room) hullForOwner(owner string) HullClass {
+	if p := r.Players[owner]; p != nil {
+		return ResolveHull(p.Hull)
+	}
+	return ResolveHull(DefaultHullID)
+}
+
+// applyHullLocked fits a ship entity with the stats of the given hull. The
+// ship keeps its share of health, its heat, its subsystem damage and its
+// cooldowns, so a refit is never a free repair; respawns reset those.
+func (r *Room) applyHullLocked(shipID EntityID, hull HullClass) {
+	if mov := r.World.Movement(shipID); mov != nil {
+		mov.MaxSpeed = hull.MaxSpeed
+	}
+	if ship := r.World.ShipData(shipID); ship != nil {
+		health := 1.0
+		if subs := r.World.Subsystems(shipID); subs != nil {
+			health = subs.Integrity()
+		} else if ship.MaxHP > 0 {
+			health = Clamp(float64(ship.HP)/float64(ship.MaxHP), 0, 1)
+		}
+		ship.Hull = hull.ID
+		ship.MaxHP = hull.MaxHP
+		ship.HP = hullHP(health, hull.MaxHP)
+		ship.HitRadius = hull.HitRadius
+	}
+	if heat := r.World.HeatData(shipID); heat != nil {
+		heat.P = hull.HeatParams(r.HeatParamsLocked())
+	}
+	pd := hull.PointDefense()
+	if old := r.World.PointDefense(shipID); old != nil {
+		pd.ReadyAt = old.ReadyAt
+	}
+	r.World.SetComponent(shipID, CompPointDefense, pd)
+	if r.World.Subsystems(shipID) == nil {
+		r.World.SetComponent(shipID, CompSubsystems, newSubsystems())
+	}
+	if r.World.HeatAbilities(shipID) == nil {
+		r.World.SetComponent(shipID, CompHeatAbilities, newHeatAbilities())
+	}
+}
+
+// SetPlayerHullLocked selects a hull class for the player and refits their
+// current ship; respawns use the selected hull too. Callers must hold r.Mu.
+func (r *Room) SetPlayerHullLocked(p *Player, hullID string) error {
+	if p == nil {
+		return fmt.Errorf("nil player")
+	}
+	hull, err := GetHull(hullID)
+	if err != nil {
+		return err
+	}
+	p.Hull = hull.ID
+	if p.Ship != 0 {
+		r.applyHullLocked(p.Ship, *hull)
+	}
+	return nil
+}
+
+// MissileCooldownFor returns the launch cooldown for the player's hull at the
+// given ship speed. Damaged launchers stretch the cooldown further.
+func (r *Room) MissileCooldownFor(p *Player, speed float64) float64 {
+	cooldown := MissileCooldownForSpeed(speed)
+	if p == nil {
+		return cooldown
+	}
+	hull := ResolveHull(p.Hull)
+	if hull.MissileCooldownMultiplier > 0 {
+		cooldown *= hull.MissileCooldownMultiplier
+	}
+	if p.Ship != 0 && r.World != nil {
+		cooldown /= SubsystemEfficiency(r.World, p.Ship, SubsystemLaunchers)
+	}
+	return cooldown
+}
+
+// shipHitRadius returns the missile contact radius for a ship entity.
+func shipHitRadius(ship *ShipComponent) float64 {
+	if ship == nil || ship.HitRadius <= 0 {
+		return MissileHitRadius
+	}
+	return ship.HitRadius
+}
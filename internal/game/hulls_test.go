@@ -0,0 +1,141 @@
+package game
+
+import "testing"
+
+func TestSpawnShipUsesPlayerHull(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot", Hull: "destroyer"}
+	room.Players[player.ID] = player
+
+	ship := room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+	hull := HullRegistry["destroyer"]
+
+	data := room.World.ShipData(ship)
+	if data.HP != hull.MaxHP || data.MaxHP != hull.MaxHP || data.Hull != hull.ID {
+		t.Fatalf("expected destroyer stats, got %+v", *data)
+	}
+	if mov := room.World.Movement(ship); mov.MaxSpeed != hull.MaxSpeed {
+		t.Fatalf("expected max speed %.1f, got %.1f", hull.MaxSpeed, mov.MaxSpeed)
+	}
+	defaults := DefaultHeatParams()
+	if heat := room.World.HeatData(ship); heat.P.Max != defaults.Max*hull.HeatCapacityScale {
+		t.Fatalf("expected scaled heat capacity, got %.1f", heat.P.Max)
+	}
+	if pd := room.World.PointDefense(ship); pd.Range <= PointDefenseRange {
+		t.Fatalf("expected point-defense ability to extend range, got %.1f", pd.Range)
+	}
+}
+
+func TestHullMarkerSpeedFollowsTopSpeed(t *testing.T) {
+	base := DefaultHeatParams()
+	interceptor := HullRegistry["interceptor"].HeatParams(base)
+	frigate := HullRegistry["frigate"].HeatParams(base)
+	destroyer := HullRegistry["destroyer"].HeatParams(base)
+	if !(interceptor.MarkerSpeed > frigate.MarkerSpeed && frigate.MarkerSpeed > destroyer.MarkerSpeed) {
+		t.Fatalf("expected faster hulls to cruise faster, got %.1f / %.1f / %.1f",
+			interceptor.MarkerSpeed, frigate.MarkerSpeed, destroyer.MarkerSpeed)
+	}
+	if frigate.MarkerSpeed != base.MarkerSpeed {
+		t.Fatalf("expected the default hull to keep the base marker, got %.1f", frigate.MarkerSpeed)
+	}
+}
+
+func TestSetPlayerHullRefitsShip(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+
+	if hp := room.World.ShipData(player.Ship).HP; hp != ShipMaxHP {
+		t.Fatalf("expected default hull HP %d, got %d", ShipMaxHP, hp)
+	}
+
+	if err := room.SetPlayerHullLocked(player, "interceptor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hull := HullRegistry["interceptor"]
+	if data := room.World.ShipData(player.Ship); data.MaxHP != hull.MaxHP || data.HitRadius != hull.HitRadius {
+		t.Fatalf("expected interceptor refit, got %+v", *data)
+	}
+	if err := room.SetPlayerHullLocked(player, "battleship"); err == nil {
+		t.Fatal("expected error for unknown hull")
+	}
+	if player.Hull != "interceptor" {
+		t.Fatalf("unknown hull should not replace selection, got %s", player.Hull)
+	}
+}
+
+func TestHullChangeKeepsShipState(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+
+	ship := room.World.ShipData(player.Ship)
+	room.World.Subsystems(player.Ship).Health[SubsystemHull] = 0.5
+	room.World.HeatData(player.Ship).S.Value = 40
+	room.World.Subsystems(player.Ship).Health[SubsystemLaunchers] = 0.25
+	room.World.HeatAbilities(player.Ship).VentReadyAt = 99
+
+	if err := room.SetPlayerHullLocked(player, "interceptor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hull := HullRegistry["interceptor"]
+	if ship.HP != (hull.MaxHP+1)/2 {
+		t.Fatalf("expected the refit to keep half health, got %d/%d", ship.HP, ship.MaxHP)
+	}
+	if heat := room.World.HeatData(player.Ship).S.Value; heat != 40 {
+		t.Fatalf("expected the refit to keep heat, got %.1f", heat)
+	}
+	if health := room.World.Subsystems(player.Ship).Health[SubsystemLaunchers]; health != 0.25 {
+		t.Fatalf("expected the refit to keep subsystem damage, got %.2f", health)
+	}
+	if ready := room.World.HeatAbilities(player.Ship).VentReadyAt; ready != 99 {
+		t.Fatalf("expected the refit to keep ability cooldowns, got %.1f", ready)
+	}
+
+	// Respawns use the selected hull at full health
+	player.Hull = "destroyer"
+	room.reSpawnShip(player.Ship)
+	if ship.Hull != "destroyer" || ship.HP != HullRegistry["destroyer"].MaxHP {
+		t.Fatalf("expected a fresh destroyer on respawn, got %+v", *ship)
+	}
+}
+
+func TestHeatReloadKeepsHullAndMissileHeat(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+	if err := room.SetPlayerHullLocked(player, "interceptor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	missile := room.World.NewEntity()
+	missileHeat := &HeatComponent{P: HeatParams{Max: 50, MarkerSpeed: 300, KUp: 9}}
+	room.World.SetComponent(missile, CompHeat, missileHeat)
+
+	reloaded := DefaultHeatParams()
+	reloaded.MarkerSpeed *= 2
+	room.rederiveHeatLocked(reloaded)
+
+	want := HullRegistry["interceptor"].HeatParams(room.heatDefaults)
+	if got := room.World.HeatData(player.Ship).P; got != want {
+		t.Fatalf("expected the interceptor's view of the new defaults, got %+v want %+v", got, want)
+	}
+	if missileHeat.P.Max != 50 || missileHeat.P.KUp != 9 {
+		t.Fatalf("expected missile heat to be left alone, got %+v", missileHeat.P)
+	}
+}
+
+func TestMissileCooldownUsesHullMultiplier(t *testing.T) {
+	room := newCombatTestRoom()
+	base := MissileCooldownForSpeed(100)
+
+	if got := room.MissileCooldownFor(&Player{}, 100); got != base {
+		t.Fatalf("expected default cooldown %.2f, got %.2f", base, got)
+	}
+	want := base * HullRegistry["destroyer"].MissileCooldownMultiplier
+	if got := room.MissileCooldownFor(&Player{Hull: "destroyer"}, 100); got != want {
+		t.Fatalf("expected destroyer cooldown %.2f, got %.2f", want, got)
+	}
+}
@@ -71,3 +71,22 @@ func (inv *Inventory) RemoveItem(itemType, variantID string, heatCapacity float6
 	}
 	return false
 }
+
+// RemoveItems removes up to quantity of an item variant across all of its
+// stacks, whatever their heat capacity, and returns how many were removed.
+func (inv *Inventory) RemoveItems(itemType, variantID string, quantity int) int {
+	removed := 0
+	kept := inv.Items[:0]
+	for _, item := range inv.Items {
+		if item.Type == itemType && item.VariantID == variantID && removed < quantity {
+			take := min(item.Quantity, quantity-removed)
+			item.Quantity -= take
+			removed += take
+		}
+		if item.Quantity > 0 {
+			kept = append(kept, item)
+		}
+	}
+	inv.Items = kept
+	return removed
+}
@@ -60,11 +60,11 @@ func SpawnFromTemplate(r *Room, template *EncounterTemplate, center Vec2, seed i
 			waypoints := waypointsOrDefault(template.WaypointGen, center, rng)
 			for i := 0; i < count; i++ {
 				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
-				speed := randomBetween(group.SpeedRange.Min, group.SpeedRange.Max)
+				speed := randomBetween(rng, group.SpeedRange.Min, group.SpeedRange.Max)
 				if speed <= 0 {
 					speed = 20
 				}
-				agro := randomBetween(group.AgroRange.Min, group.AgroRange.Max)
+				agro := randomBetween(rng, group.AgroRange.Min, group.AgroRange.Max)
 				if agro < 0 {
 					agro = MissileMinAgroRadius
 				}
@@ -76,11 +76,11 @@ func SpawnFromTemplate(r *Room, template *EncounterTemplate, center Vec2, seed i
 		case "seeker":
 			for i := 0; i < count; i++ {
 				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
-				speed := randomBetween(group.SpeedRange.Min, group.SpeedRange.Max)
+				speed := randomBetween(rng, group.SpeedRange.Min, group.SpeedRange.Max)
 				if speed <= 0 {
 					speed = 80
 				}
-				agro := randomBetween(group.AgroRange.Min, group.AgroRange.Max)
+				agro := randomBetween(rng, group.AgroRange.Min, group.AgroRange.Max)
 				if agro < 0 {
 					agro = MissileMinAgroRadius
 				}
@@ -89,11 +89,33 @@ func SpawnFromTemplate(r *Room, template *EncounterTemplate, center Vec2, seed i
 					spawned = append(spawned, id)
 				}
 			}
+		case "gunship":
+			for i := 0; i < count; i++ {
+				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
+				id := spawnGunshipEntity(r, pos, group)
+				if id != 0 {
+					spawned = append(spawned, id)
+				}
+			}
+		case "spawner":
+			for i := 0; i < count; i++ {
+				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
+				id := spawnSpawnerEntity(r, pos, group)
+				if id != 0 {
+					spawned = append(spawned, id)
+				}
+			}
 		default:
 			// Unknown entity type: skip
 		}
 	}
 
+	if template.Boss != nil {
+		if id := spawnBossEntity(r, template.Boss, clampVec(center, r.WorldWidth, r.WorldHeight), seed); id != 0 {
+			spawned = append(spawned, id)
+		}
+	}
+
 	return spawned
 }
 
@@ -167,11 +189,11 @@ func SpawnFromTemplateWithContext(r *Room, template *EncounterTemplate, ctx Spaw
 			waypoints := waypointsOrDefault(template.WaypointGen, center, rng)
 			for i := 0; i < count; i++ {
 				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
-				speed := randomBetween(group.SpeedRange.Min, group.SpeedRange.Max)
+				speed := randomBetween(rng, group.SpeedRange.Min, group.SpeedRange.Max)
 				if speed <= 0 {
 					speed = 20
 				}
-				agro := randomBetween(group.AgroRange.Min, group.AgroRange.Max)
+				agro := randomBetween(rng, group.AgroRange.Min, group.AgroRange.Max)
 				if agro < 0 {
 					agro = MissileMinAgroRadius
 				}
@@ -186,11 +208,11 @@ func SpawnFromTemplateWithContext(r *Room, template *EncounterTemplate, ctx Spaw
 		case "seeker":
 			for i := 0; i < count; i++ {
 				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
-				speed := randomBetween(group.SpeedRange.Min, group.SpeedRange.Max)
+				speed := randomBetween(rng, group.SpeedRange.Min, group.SpeedRange.Max)
 				if speed <= 0 {
 					speed = 80
 				}
-				agro := randomBetween(group.AgroRange.Min, group.AgroRange.Max)
+				agro := randomBetween(rng, group.AgroRange.Min, group.AgroRange.Max)
 				if agro < 0 {
 					agro = MissileMinAgroRadius
 				}
@@ -202,11 +224,33 @@ func SpawnFromTemplateWithContext(r *Room, template *EncounterTemplate, ctx Spaw
 					spawned = append(spawned, id)
 				}
 			}
+		case "gunship":
+			for i := 0; i < count; i++ {
+				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
+				id := spawnGunshipEntity(r, pos, group)
+				if id != 0 {
+					spawned = append(spawned, id)
+				}
+			}
+		case "spawner":
+			for i := 0; i < count; i++ {
+				pos := clampVec(positions[i], r.WorldWidth, r.WorldHeight)
+				id := spawnSpawnerEntity(r, pos, group)
+				if id != 0 {
+					spawned = append(spawned, id)
+				}
+			}
 		default:
 			// Unknown entity type: skip
 		}
 	}
 
+	if template.Boss != nil {
+		if id := spawnBossEntity(r, template.Boss, clampVec(center, r.WorldWidth, r.WorldHeight), seed); id != 0 {
+			spawned = append(spawned, id)
+		}
+	}
+
 	return spawned
 }
 
@@ -283,8 +327,9 @@ func spawnMineEntity(r *Room, pos Vec2, heatParams HeatParams, lifetime float64,
 	cfg := MissileConfig{
 		Speed:      0,
 		AgroRadius: 0,
-		Lifetime:   sampleLifetime(lifetime),
+		Lifetime:   sampleLifetime(r.simRand(), lifetime),
 		HeatParams: SanitizeHeatParams(heatParams),
+		Warhead:    WarheadProximityMine,
 	}
 	route := []RouteWaypoint{{Pos: pos, Speed: 0}}
 	id := r.LaunchMissile(missionOwnerID, 0, cfg, route, pos, Vec2{})
@@ -299,7 +344,7 @@ func spawnPatrollerEntity(r *Room, start Vec2, speed, agro float64, waypoints []
 	cfg := MissileConfig{
 		Speed:      speed,
 		AgroRadius: agro,
-		Lifetime:   sampleLifetime(lifetime),
+		Lifetime:   sampleLifetime(r.simRand(), lifetime),
 		HeatParams: SanitizeHeatParams(heatParams),
 	}
 
@@ -318,7 +363,7 @@ func spawnSeekerEntity(r *Room, start Vec2, target Vec2, speed, agro float64, he
 	cfg := MissileConfig{
 		Speed:      speed,
 		AgroRadius: agro,
-		Lifetime:   sampleLifetime(lifetime),
+		Lifetime:   sampleLifetime(r.simRand(), lifetime),
 		HeatParams: SanitizeHeatParams(heatParams),
 	}
 	start = clampVec(start, r.WorldWidth, r.WorldHeight)
@@ -332,6 +377,19 @@ func spawnSeekerEntity(r *Room, start Vec2, target Vec2, speed, agro float64, he
 	return id
 }
 
+// destroyUnpilotedShip marks a ship with no player behind it, such as a
+// gunship, carrier or wingman, destroyed and credits a human attacker. These
+// ships do not respawn.
+func (r *Room) destroyUnpilotedShip(id EntityID, attackerID string) {
+	if r.World.DestroyedData(id) != nil {
+		return
+	}
+	r.World.SetComponent(id, CompDestroyed, &DestroyedComponent{DestroyedAt: r.Now})
+	if attacker := r.Players[attackerID]; attacker != nil && !attacker.IsBot {
+		attacker.Kills++
+	}
+}
+
 func applyEntityTags(r *Room, id EntityID, tags map[string]bool) {
 	if r == nil || id == 0 || len(tags) == 0 {
 		return
@@ -353,6 +411,24 @@ func applyEntityTags(r *Room, id EntityID, tags map[string]bool) {
 	}
 }
 
+// spawnEntityTypes lists the SpawnGroup entity types the spawners understand.
+var spawnEntityTypes = map[string]bool{
+	"mine":      true,
+	"patroller": true,
+	"seeker":    true,
+	"gunship":   true,
+	"spawner":   true,
+}
+
+// formationNames lists the formations generateFormation lays out; any other
+// name stacks the group on the center.
+var formationNames = map[string]bool{
+	"ring":      true,
+	"cluster":   true,
+	"line":      true,
+	"scattered": true,
+}
+
 func generateFormation(formation string, center Vec2, count int, rng *rand.Rand) []Vec2 {
 	positions := make([]Vec2, count)
 	switch formation {
@@ -404,7 +480,7 @@ func generateFormation(formation string, center Vec2, count int, rng *rand.Rand)
 	return positions
 }
 
-func sampleLifetime(max float64) float64 {
+func sampleLifetime(rng *rand.Rand, max float64) float64 {
 	if max <= 0 {
 		return MissileMaxLifetime
 	}
@@ -412,10 +488,10 @@ func sampleLifetime(max float64) float64 {
 	if min <= 0 {
 		min = max * 0.5
 	}
-	return randomBetween(min, max)
+	return randomBetween(rng, min, max)
 }
 
-func randomBetween(a, b float64) float64 {
+func randomBetween(rng *rand.Rand, a, b float64) float64 {
 	if math.IsNaN(a) || math.IsInf(a, 0) {
 		a = 0
 	}
@@ -427,7 +503,7 @@ func randomBetween(a, b float64) float64 {
 	}
 	lo := math.Min(a, b)
 	hi := math.Max(a, b)
-	return lo + rand.Float64()*(hi-lo)
+	return lo + rng.Float64()*(hi-lo)
 }
 
 func clampVec(v Vec2, maxX, maxY float64) Vec2 {
@@ -0,0 +1,207 @@
+package game
+
+import (
+	"fmt"
+	"log"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// FailureType names a way a mission can be lost.
+type FailureType string
+
+const (
+	FailureTimeout    FailureType = "timeout"     // Limit seconds pass without completing
+	FailureDeaths     FailureType = "deaths"      // A player's ship is destroyed Limit times
+	FailureEscortLost FailureType = "escort_lost" // The escort convoy is destroyed
+	FailureHeatStalls FailureType = "heat_stalls" // A player's ship overheats and stalls Limit times
+)
+
+// FailureRule ends the active mission in failure once its condition is met.
+type FailureRule struct {
+	Type  FailureType
+	Limit float64 // Seconds for timeout, a count for deaths and heat stalls
+}
+
+// Validate checks the rule's type and limit.
+func (f FailureRule) Validate() error {
+	switch f.Type {
+	case FailureTimeout, FailureDeaths, FailureHeatStalls:
+		if f.Limit <= 0 {
+			return fmt.Errorf("failure rule %s needs a positive limit", f.Type)
+		}
+	case FailureEscortLost:
+	default:
+		return fmt.Errorf("unknown failure rule: %s", f.Type)
+	}
+	return nil
+}
+
+// failureRules returns the template's rules plus those implied by
+// FailureTimeout and the escort archetype.
+func (t *MissionTemplate) failureRules() []FailureRule {
+	rules := append([]FailureRule(nil), t.FailureRules...)
+	has := func(typ FailureType) bool {
+		for _, rule := range rules {
+			if rule.Type == typ {
+				return true
+			}
+		}
+		return false
+	}
+	if t.FailureTimeout > 0 && !has(FailureTimeout) {
+		rules = append(rules, FailureRule{Type: FailureTimeout, Limit: t.FailureTimeout})
+	}
+	if t.Archetype == ArchetypeEscort && !has(FailureEscortLost) {
+		rules = append(rules, FailureRule{Type: FailureEscortLost})
+	}
+	return rules
+}
+
+// missionFailureCheck watches one failure rule of the active mission. Counts
+// start from the moment the mission was accepted.
+type missionFailureCheck struct {
+	rule       FailureRule
+	startedAt  float64
+	baseDeaths map[string]int
+	stallUntil map[string]float64
+	stalls     map[string]int
+}
+
+func newMissionFailureCheck(r *Room, rule FailureRule) *missionFailureCheck {
+	c := &missionFailureCheck{
+		rule:       rule,
+		startedAt:  r.Now,
+		baseDeaths: make(map[string]int),
+		stallUntil: make(map[string]float64),
+		stalls:     make(map[string]int),
+	}
+	c.observe(r)
+	return c
+}
+
+// observe records the death baseline of players seen for the first time and
+// counts stalls that started since the last call.
+func (c *missionFailureCheck) observe(r *Room) {
+	for id, p := range r.Players {
+		if p == nil || p.IsBot {
+			continue
+		}
+		if _, ok := c.baseDeaths[id]; !ok {
+			c.baseDeaths[id] = p.Deaths
+		}
+		if heat := r.World.HeatData(p.Ship); heat != nil {
+			if last, ok := c.stallUntil[id]; ok && heat.S.StallUntil > last {
+				c.stalls[id]++
+			}
+			c.stallUntil[id] = heat.S.StallUntil
+		}
+	}
+}
+
+// failed returns why the mission is lost, or "" while the rule holds.
+func (c *missionFailureCheck) failed(r *Room, d *BeaconDirector) string {
+	c.observe(r)
+	switch c.rule.Type {
+	case FailureTimeout:
+		if r.Now-c.startedAt >= c.rule.Limit {
+			return "time ran out"
+		}
+	case FailureDeaths:
+		for id, p := range r.Players {
+			if base, ok := c.baseDeaths[id]; ok && p != nil && float64(p.Deaths-base) >= c.rule.Limit {
+				return fmt.Sprintf("%s was destroyed %d times", p.Name, p.Deaths-base)
+			}
+		}
+	case FailureHeatStalls:
+		for id, stalls := range c.stalls {
+			if float64(stalls) >= c.rule.Limit {
+				name := id
+				if p := r.Players[id]; p != nil && p.Name != "" {
+					name = p.Name
+				}
+				return fmt.Sprintf("%s stalled %d times", name, stalls)
+			}
+		}
+	case FailureEscortLost:
+		if d.convoy != 0 && r.convoyLost(d.convoy) {
+			return "the convoy was destroyed"
+		}
+	}
+	return ""
+}
+
+// checkFailure returns why the active mission is lost, or "".
+func (d *BeaconDirector) checkFailure(r *Room) string {
+	for _, check := range d.failureChecks {
+		if reason := check.failed(r, d); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+// failMission ends the active mission: every human player is told why, loses
+// the beacon progress the template's FailureRollback calls for and is shown
+// its failure story node. The mission cannot be accepted again until its
+// Cooldown has passed.
+func (d *BeaconDirector) failMission(r *Room, reason string) {
+	tmpl := d.MissionTemplate()
+	log.Printf("[mission] %s failed in room %s: %s", d.CurrentMissionID, r.ID, reason)
+	rolledBack := make(map[*playerBeaconProgress]bool)
+	for playerID, p := range r.Players {
+		if p == nil || p.IsBot {
+			continue
+		}
+		r.BroadcastMissionFailed(p, reason)
+		if tmpl == nil {
+			continue
+		}
+		// A co-op party shares one record; roll it back once.
+		if state := d.ensurePlayerState(playerID); tmpl.FailureRollback != 0 && !rolledBack[state] {
+			rolledBack[state] = true
+			d.rollbackProgress(state, tmpl.FailureRollback)
+		}
+		if tmpl.FailureStoryNodeID != "" {
+			r.tryStartStoryNodeLocked(p, dag.NodeID(tmpl.FailureStoryNodeID))
+		}
+		r.HandleMissionEventLocked(p, MissionEvent{Type: MissionEventFailed})
+	}
+	if tmpl != nil && tmpl.Cooldown > 0 {
+		if d.retryAt == nil {
+			d.retryAt = make(map[string]float64)
+		}
+		d.retryAt[tmpl.ID] = r.Now + tmpl.Cooldown
+	}
+	if d.convoy != 0 && !r.convoyLost(d.convoy) {
+		r.removeConvoyLocked(d.convoy)
+	}
+	d.convoy = 0
+	d.failureChecks = nil
+	for id := range d.ActiveObjectives {
+		delete(d.ActiveObjectives, id)
+		delete(d.ObjectiveProgress, id)
+	}
+}
+
+// rollbackProgress undoes the player's last n beacon locks, or all of them
+// when n is negative.
+func (d *BeaconDirector) rollbackProgress(state *playerBeaconProgress, n int) {
+	if state == nil {
+		return
+	}
+	if n < 0 || n > state.CurrentIndex {
+		n = state.CurrentIndex
+	}
+	for i := 0; i < n; i++ {
+		state.CurrentIndex--
+		if state.CurrentIndex < len(d.beacons) {
+			delete(state.Completed, d.beacons[state.CurrentIndex].ID)
+		}
+	}
+	state.HoldAccum = 0
+	state.LastBroadcastHold = 0
+	state.HoldBeaconID = ""
+	state.ActiveBeaconID = d.activeBeaconID(state.CurrentIndex)
+	d.snapshotDirty = true
+}
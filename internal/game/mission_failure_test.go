@@ -0,0 +1,138 @@
+package game
+
+import "testing"
+
+// failureRoom registers tmpl and returns a room whose only player has accepted it.
+func failureRoom(t *testing.T, tmpl MissionTemplate) (*Room, *BeaconDirector, *Player) {
+	t.Helper()
+	TemplateRegistry[tmpl.ID] = tmpl
+	t.Cleanup(func() { delete(TemplateRegistry, tmpl.ID) })
+
+	room := newCombatTestRoom()
+	director, ok := NewBeaconDirector(room.ID, "campaign-1", room.WorldWidth, room.WorldHeight)
+	if !ok {
+		t.Fatal("expected beacon director")
+	}
+	room.missionDirector = director
+	player := &Player{ID: "p1", Name: "P1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+	if err := director.AcceptMission(room, player, tmpl.ID); err != nil {
+		t.Fatalf("failed to accept mission: %v", err)
+	}
+	return room, director, player
+}
+
+func lastMissionUpdate(p *Player) *MissionUpdate {
+	var last *MissionUpdate
+	for _, msg := range p.PendingMessages {
+		if update, ok := msg.Payload.(MissionUpdate); ok {
+			last = &update
+		}
+	}
+	return last
+}
+
+func TestMissionTimeoutRollsBackAndCoolsDown(t *testing.T) {
+	room, director, player := failureRoom(t, MissionTemplate{
+		ID:              "test-timeout",
+		DisplayName:     "Timeout",
+		Archetype:       ArchetypeKill,
+		ObjectiveParams: map[string]interface{}{"requiredKills": 5},
+		FailureTimeout:  10,
+		Cooldown:        30,
+		FailureRollback: -1,
+	})
+	state := director.ensurePlayerState(player.ID)
+	for i := 0; i < 2; i++ {
+		state.Completed[director.beacons[i].ID] = true
+	}
+	state.CurrentIndex = 2
+
+	room.Now += 5
+	director.Tick(room)
+	if len(director.ActiveObjectives) == 0 {
+		t.Fatal("expected the mission to still be running before the timeout")
+	}
+
+	room.Now += 5
+	director.Tick(room)
+	update := lastMissionUpdate(player)
+	if update == nil || update.Status != "failed" || update.Reason != "time ran out" {
+		t.Fatalf("expected a timed out mission update, got %+v", update)
+	}
+	if len(director.ActiveObjectives) != 0 {
+		t.Fatal("expected a failed mission to clear its objectives")
+	}
+	if state.CurrentIndex != 0 || len(state.Completed) != 0 || state.ActiveBeaconID != director.beacons[0].ID {
+		t.Fatalf("expected beacon progress to be reset, got index %d completed %v", state.CurrentIndex, state.Completed)
+	}
+
+	if err := director.AcceptMission(room, player, "test-timeout"); err == nil {
+		t.Fatal("expected the retry cooldown to block the mission")
+	}
+	room.Now += 30
+	if err := director.AcceptMission(room, player, "test-timeout"); err != nil {
+		t.Fatalf("expected the mission to be available after its cooldown: %v", err)
+	}
+}
+
+func TestMissionFailsOnDeathsAndStalls(t *testing.T) {
+	room, director, player := failureRoom(t, MissionTemplate{
+		ID:              "test-deaths",
+		DisplayName:     "Deaths",
+		Archetype:       ArchetypeKill,
+		ObjectiveParams: map[string]interface{}{"requiredKills": 5},
+		FailureRules:    []FailureRule{{Type: FailureDeaths, Limit: 2}},
+		FailureRollback: 1,
+	})
+	state := director.ensurePlayerState(player.ID)
+	state.Completed[director.beacons[0].ID] = true
+	state.CurrentIndex = 1
+
+	player.Deaths++
+	director.Tick(room)
+	if len(director.ActiveObjectives) == 0 {
+		t.Fatal("expected one death to be survivable")
+	}
+	player.Deaths++
+	director.Tick(room)
+	if update := lastMissionUpdate(player); update == nil || update.Status != "failed" {
+		t.Fatalf("expected the second death to fail the mission, got %+v", update)
+	}
+	if state.CurrentIndex != 0 || state.Completed[director.beacons[0].ID] {
+		t.Fatal("expected the last beacon lock to be undone")
+	}
+
+	room, director, player = failureRoom(t, MissionTemplate{
+		ID:              "test-stalls",
+		DisplayName:     "Stalls",
+		Archetype:       ArchetypeKill,
+		ObjectiveParams: map[string]interface{}{"requiredKills": 5},
+		FailureRules:    []FailureRule{{Type: FailureHeatStalls, Limit: 2}},
+	})
+	heat := room.World.HeatData(player.Ship)
+	for i := 1; i <= 2; i++ {
+		room.Now += 5
+		heat.S.StallUntil = room.Now + heat.P.StallSeconds
+		director.Tick(room)
+	}
+	if update := lastMissionUpdate(player); update == nil || update.Status != "failed" {
+		t.Fatalf("expected two stalls to fail the mission, got %+v", update)
+	}
+}
+
+func TestFailureRuleValidation(t *testing.T) {
+	tmpl := MissionTemplate{ID: "x", DisplayName: "X", FailureRules: []FailureRule{{Type: "meltdown"}}}
+	if err := tmpl.Validate(); err == nil {
+		t.Fatal("expected an unknown failure rule to be rejected")
+	}
+	tmpl.FailureRules = []FailureRule{{Type: FailureDeaths}}
+	if err := tmpl.Validate(); err == nil {
+		t.Fatal("expected a deaths rule without a limit to be rejected")
+	}
+	tmpl.FailureRules = []FailureRule{{Type: FailureEscortLost}}
+	if err := tmpl.Validate(); err != nil {
+		t.Fatalf("expected escort_lost to need no limit: %v", err)
+	}
+}
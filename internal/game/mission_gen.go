@@ -0,0 +1,361 @@
+package game
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// Difficulty range of generated missions
+const (
+	MinMissionDifficulty = 1
+	MaxMissionDifficulty = 5
+)
+
+// maxGeneratedMissions bounds how many generated missions stay registered;
+// registering another drops the oldest one no room is playing.
+const maxGeneratedMissions = 32
+
+// MissionGenerateCooldown is how long, in seconds, a player waits between
+// mission:generate requests.
+const MissionGenerateCooldown = 20.0
+
+// GeneratedMission is a mission built by GenerateMission: the template, the
+// beacon layout registered under the same ID and the spawn table the layout
+// draws encounters from.
+type GeneratedMission struct {
+	Template   MissionTemplate
+	Layout     MissionSpec
+	SpawnTable SpawnTable
+	Seed       int64
+	Difficulty int
+}
+
+// Generated missions currently registered, oldest first. Guarded by contentMu.
+var (
+	generatedMissions     = map[string]GeneratedMission{}
+	generatedMissionOrder []string
+)
+
+// generatedNames are combined into generated mission display names.
+var generatedNames = [2][]string{
+	{"Silent", "Broken", "Distant", "Cold", "Red", "Hollow", "Drifting", "Last"},
+	{"Relay", "Vigil", "Static", "Lantern", "Meridian", "Echo", "Harbor", "Wake"},
+}
+
+// generatedRewards are the missile variants a generated mission pays out,
+// from easiest to hardest difficulty.
+var generatedRewards = []struct {
+	variant string
+	heat    float64
+}{
+	{"basic", 80},
+	{"basic", 80},
+	{"long_range", 120},
+	{"high_heat", 150},
+	{"extended", 200},
+}
+
+// DailyMissionSeed returns the seed of the daily challenge for day's UTC date.
+func DailyMissionSeed(day time.Time) int64 {
+	y, m, d := day.UTC().Date()
+	return int64(y*10000 + int(m)*100 + d)
+}
+
+// GenerateMission builds a side mission from seed and difficulty
+// (MinMissionDifficulty to MaxMissionDifficulty). The same seed, difficulty
+// and encounter registry always give the same mission.
+func GenerateMission(seed int64, difficulty int) (GeneratedMission, error) {
+	return generateMission(fmt.Sprintf("gen-%x-d%d", uint64(seed), difficulty), seed, difficulty)
+}
+
+// GenerateDailyMission builds the daily challenge for day's UTC date: every
+// server generates the same mission for the same day and difficulty.
+func GenerateDailyMission(day time.Time, difficulty int) (GeneratedMission, error) {
+	seed := DailyMissionSeed(day)
+	m, err := generateMission(fmt.Sprintf("daily-%d-d%d", seed, difficulty), seed, difficulty)
+	if err != nil {
+		return m, err
+	}
+	m.Template.DisplayName = "Daily: " + m.Template.DisplayName
+	return m, nil
+}
+
+func generateMission(id string, seed int64, difficulty int) (GeneratedMission, error) {
+	if difficulty < MinMissionDifficulty || difficulty > MaxMissionDifficulty {
+		return GeneratedMission{}, fmt.Errorf("difficulty %d is outside %d-%d", difficulty, MinMissionDifficulty, MaxMissionDifficulty)
+	}
+	rng := rand.New(rand.NewSource(seed*31 + int64(difficulty)))
+	name := generatedNames[0][rng.Intn(len(generatedNames[0]))] + " " + generatedNames[1][rng.Intn(len(generatedNames[1]))]
+
+	archetype := generateArchetype(rng, difficulty)
+	beacons := 3 + difficulty/2 + rng.Intn(2)
+	hold := math.Round((4+1.5*float64(difficulty)+2*rng.Float64())*2) / 2
+
+	table, err := generateSpawnTable(rng, id+"-spawns", name, difficulty)
+	if err != nil {
+		return GeneratedMission{}, err
+	}
+	refs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, rule := range table.Rules {
+		for _, enc := range rule.Encounters {
+			if !seen[enc.EncounterID] {
+				seen[enc.EncounterID] = true
+				refs = append(refs, enc.EncounterID)
+			}
+		}
+	}
+	sort.Strings(refs)
+
+	tmpl := MissionTemplate{
+		ID:              id,
+		DisplayName:     name,
+		Archetype:       archetype,
+		ObjectiveParams: map[string]interface{}{},
+		EncounterRefs:   refs,
+		Cooldown:        30,
+		FailureRules:    []FailureRule{{Type: FailureDeaths, Limit: float64(MaxMissionDifficulty + 1 - difficulty)}},
+		FailureRollback: 1,
+	}
+	switch archetype {
+	case ArchetypeTravel:
+		tmpl.ObjectiveParams["beaconCount"] = beacons
+		tmpl.ObjectiveParams["holdTime"] = hold
+	case ArchetypeKill:
+		tmpl.ObjectiveParams["requiredKills"] = 3 + 2*difficulty + rng.Intn(3)
+		tmpl.ObjectiveParams["targetTag"] = "hostile"
+	case ArchetypeEscort:
+		tmpl.ObjectiveParams["convoySpeed"] = 80 - 5*difficulty
+		tmpl.ObjectiveParams["convoyHull"] = "destroyer"
+	}
+	if difficulty > MinMissionDifficulty {
+		// Room for every hold plus flight time, tightening with difficulty.
+		limit := float64(beacons) * (2*hold + 90) * (1.5 - 0.1*float64(difficulty))
+		tmpl.FailureTimeout = math.Round(limit/10) * 10
+	}
+	if difficulty >= 4 {
+		tmpl.FailureRollback = -1
+	}
+	reward := generatedRewards[difficulty-1]
+	tmpl.Reward = []dag.StoryEffect{{
+		Type:         dag.StoryEffectGrantItem,
+		Item:         "missile",
+		VariantID:    reward.variant,
+		HeatCapacity: reward.heat,
+		Count:        1 + difficulty/2 + rng.Intn(2),
+	}}
+	if err := tmpl.Validate(); err != nil {
+		return GeneratedMission{}, err
+	}
+
+	return GeneratedMission{
+		Template: tmpl,
+		Layout: MissionSpec{
+			ID:                  id,
+			HoldSeconds:         hold,
+			RevisitCooldown:     float64(30 - 2*difficulty),
+			MaxActiveEncounters: 1 + (difficulty+1)/2,
+			EncounterTimeout:    float64(120 + 15*difficulty),
+			BeaconCount:         beacons,
+			MinDistance:         2500,
+			MaxAttempts:         30,
+			DensityFactor:       1.0,
+			SpawnTableID:        table.ID,
+		},
+		SpawnTable: table,
+		Seed:       seed,
+		Difficulty: difficulty,
+	}, nil
+}
+
+// generateArchetype picks travel, kill or, from difficulty 2, escort.
+// Hazard missions need a designed hazard area, so they are never generated.
+func generateArchetype(rng *rand.Rand, difficulty int) MissionArchetype {
+	choices := []MissionArchetype{ArchetypeTravel, ArchetypeKill}
+	if difficulty >= 2 {
+		choices = append(choices, ArchetypeEscort)
+	}
+	return choices[rng.Intn(len(choices))]
+}
+
+// generateSpawnTable builds one rule per beacon tier. Each rule draws two or
+// three encounters of up to that tier; higher difficulties reach further up
+// the tiers, weight the tougher picks and spawn more often. Boss encounters
+// only appear at the highest difficulty.
+func generateSpawnTable(rng *rand.Rand, id, name string, difficulty int) (SpawnTable, error) {
+	contentMu.RLock()
+	encounters := make([]EncounterTemplate, 0, len(EncounterRegistry))
+	for _, enc := range EncounterRegistry {
+		encounters = append(encounters, enc)
+	}
+	contentMu.RUnlock()
+	sort.Slice(encounters, func(i, j int) bool { return encounters[i].ID < encounters[j].ID })
+
+	table := SpawnTable{ID: id, DisplayName: name + " Encounters"}
+	for tier := 1; tier <= 3; tier++ {
+		reach := tier + (difficulty-1)/2
+		var pool []string
+		tiers := make(map[string]int)
+		for _, enc := range encounters {
+			t := encounterTier(&enc)
+			if t > reach || (enc.Tags["boss"] && difficulty < MaxMissionDifficulty) {
+				continue
+			}
+			pool = append(pool, enc.ID)
+			tiers[enc.ID] = t
+		}
+		if len(pool) == 0 {
+			continue
+		}
+		rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+		n := 2 + rng.Intn(2)
+		if n > len(pool) {
+			n = len(pool)
+		}
+		rule := SpawnRule{
+			RequiredTags:  []string{fmt.Sprintf("tier-%d", tier)},
+			MaxConcurrent: 1 + difficulty/2,
+			Cooldown:      float64(60 - 8*difficulty),
+		}
+		for _, encID := range pool[:n] {
+			weight := 20 + rng.Intn(61)
+			if tiers[encID] > tier {
+				weight += 10 * difficulty
+			}
+			rule.Encounters = append(rule.Encounters, WeightedEncounter{EncounterID: encID, Weight: weight})
+		}
+		table.Rules = append(table.Rules, rule)
+	}
+	if len(table.Rules) == 0 {
+		return table, fmt.Errorf("no encounters to generate a spawn table from")
+	}
+	return table, nil
+}
+
+// encounterTier reads an encounter's "tier-N" tag; untagged encounters are tier 1.
+func encounterTier(enc *EncounterTemplate) int {
+	for tag, ok := range enc.Tags {
+		if !ok || !strings.HasPrefix(tag, "tier-") {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimPrefix(tag, "tier-")); err == nil {
+			return n
+		}
+	}
+	return 1
+}
+
+// RegisterGeneratedMission makes a generated mission playable: it is listed
+// for every room and can be accepted like any other mission. Generated
+// missions survive content reloads; once more than maxGeneratedMissions are
+// registered the oldest one not named in running is dropped, and a reload
+// that removes an encounter it spawns drops it too.
+func RegisterGeneratedMission(m GeneratedMission, running map[string]bool) {
+	contentMu.Lock()
+	defer contentMu.Unlock()
+	reg := currentRegistries().clone()
+	if _, ok := generatedMissions[m.Template.ID]; !ok {
+		generatedMissionOrder = append(generatedMissionOrder, m.Template.ID)
+	}
+	generatedMissions[m.Template.ID] = m
+	for i := 0; len(generatedMissionOrder) > maxGeneratedMissions && i < len(generatedMissionOrder); {
+		id := generatedMissionOrder[i]
+		if id == m.Template.ID || running[id] {
+			i++
+			continue
+		}
+		old := generatedMissions[id]
+		delete(reg.templates, old.Template.ID)
+		delete(reg.specs, old.Layout.ID)
+		delete(reg.spawnTables, old.SpawnTable.ID)
+		delete(generatedMissions, id)
+		generatedMissionOrder = slices.Delete(generatedMissionOrder, i, i+1)
+	}
+	m.applyTo(reg)
+	setRegistries(reg)
+}
+
+// RegisterGeneratedMission registers a generated mission without dropping
+// one a room of the hub is playing.
+func (h *Hub) RegisterGeneratedMission(m GeneratedMission) {
+	RegisterGeneratedMission(m, h.runningMissionIDs())
+}
+
+// runningMissionIDs returns the missions the hub's rooms are playing.
+func (h *Hub) runningMissionIDs() map[string]bool {
+	h.Mu.Lock()
+	defer h.Mu.Unlock()
+	running := make(map[string]bool)
+	for _, r := range h.Rooms {
+		r.Mu.Lock()
+		if r.missionDirector != nil {
+			running[r.missionDirector.MissionID()] = true
+		}
+		r.Mu.Unlock()
+	}
+	return running
+}
+
+// ClaimMissionGenerateLocked reports whether p may generate a mission now
+// and, if so, starts their MissionGenerateCooldown. Callers must hold r.Mu.
+func (r *Room) ClaimMissionGenerateLocked(p *Player) bool {
+	if p == nil || r.Now < p.GenerateReadyAt {
+		return false
+	}
+	p.GenerateReadyAt = r.Now + MissionGenerateCooldown
+	return true
+}
+
+func (m GeneratedMission) applyTo(reg contentRegistries) {
+	reg.templates[m.Template.ID] = m.Template
+	reg.specs[m.Layout.ID] = m.Layout
+	reg.spawnTables[m.SpawnTable.ID] = m.SpawnTable
+}
+
+// applyGeneratedMissionsLocked adds the registered generated missions to reg
+// and returns the IDs of those left out because reg lacks an encounter they
+// spawn. Callers must hold contentMu.
+func applyGeneratedMissionsLocked(reg contentRegistries) []string {
+	var stale []string
+	for _, id := range generatedMissionOrder {
+		m := generatedMissions[id]
+		if !m.encountersIn(reg) {
+			stale = append(stale, id)
+			continue
+		}
+		m.applyTo(reg)
+	}
+	return stale
+}
+
+// encountersIn reports whether reg has every encounter the mission's spawn
+// table names.
+func (m GeneratedMission) encountersIn(reg contentRegistries) bool {
+	for _, rule := range m.SpawnTable.Rules {
+		for _, enc := range rule.Encounters {
+			if _, ok := reg.encounters[enc.EncounterID]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// dropGeneratedMissionsLocked forgets the given generated missions. Callers
+// must hold contentMu for writing.
+func dropGeneratedMissionsLocked(ids []string) {
+	for _, id := range ids {
+		log.Printf("dropped generated mission %s: an encounter it spawns no longer exists", id)
+		delete(generatedMissions, id)
+		generatedMissionOrder = slices.DeleteFunc(generatedMissionOrder, func(o string) bool { return o == id })
+	}
+}
@@ -0,0 +1,210 @@
+package game
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+func saveGeneratedMissions(t *testing.T) {
+	t.Helper()
+	saved := currentRegistries()
+	contentMu.RLock()
+	savedGenerated, savedOrder := maps.Clone(generatedMissions), append([]string(nil), generatedMissionOrder...)
+	contentMu.RUnlock()
+	t.Cleanup(func() {
+		installRegistries(saved)
+		contentMu.Lock()
+		generatedMissions, generatedMissionOrder = savedGenerated, savedOrder
+		contentMu.Unlock()
+	})
+}
+
+func TestGenerateMissionIsDeterministicAndValid(t *testing.T) {
+	initTestDAG(t)
+	archetypes := map[MissionArchetype]bool{}
+	for seed := int64(1); seed <= 20; seed++ {
+		for difficulty := MinMissionDifficulty; difficulty <= MaxMissionDifficulty; difficulty++ {
+			m, err := GenerateMission(seed, difficulty)
+			if err != nil {
+				t.Fatalf("seed %d difficulty %d: %v", seed, difficulty, err)
+			}
+			again, _ := GenerateMission(seed, difficulty)
+			if !reflect.DeepEqual(m, again) {
+				t.Fatalf("seed %d difficulty %d generated two different missions", seed, difficulty)
+			}
+			archetypes[m.Template.Archetype] = true
+
+			reg := currentRegistries().clone()
+			m.applyTo(reg)
+			if issues := reg.check(dag.GetGraph(), nil); len(issues) > 0 {
+				t.Fatalf("seed %d difficulty %d: generated content has issues: %v", seed, difficulty, issues)
+			}
+			if m.Layout.SpawnTableID != m.SpawnTable.ID || len(m.Template.Reward) == 0 {
+				t.Fatalf("expected the layout to use the mission's spawn table and a reward, got %+v", m)
+			}
+		}
+	}
+	if len(archetypes) < 3 {
+		t.Fatalf("expected seeds to vary the archetype, got %v", archetypes)
+	}
+
+	easy, _ := GenerateMission(7, MinMissionDifficulty)
+	hard, _ := GenerateMission(7, MaxMissionDifficulty)
+	if hard.Layout.HoldSeconds <= easy.Layout.HoldSeconds || hard.Layout.BeaconCount < easy.Layout.BeaconCount {
+		t.Fatalf("expected harder missions to hold longer over more beacons, got %+v and %+v", easy.Layout, hard.Layout)
+	}
+	if _, err := GenerateMission(7, MaxMissionDifficulty+1); err == nil {
+		t.Fatal("expected an out-of-range difficulty to be rejected")
+	}
+}
+
+func TestGenerateDailyMissionFollowsTheDate(t *testing.T) {
+	morning := time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC)
+	evening := time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC)
+	a, err := GenerateDailyMission(morning, 3)
+	if err != nil {
+		t.Fatalf("daily mission: %v", err)
+	}
+	b, _ := GenerateDailyMission(evening, 3)
+	if !reflect.DeepEqual(a, b) {
+		t.Fatal("expected the same daily mission all day")
+	}
+	if a.Template.ID != "daily-20261019-d3" || !strings.HasPrefix(a.Template.DisplayName, "Daily: ") {
+		t.Fatalf("unexpected daily mission %s %q", a.Template.ID, a.Template.DisplayName)
+	}
+	next, _ := GenerateDailyMission(morning.AddDate(0, 0, 1), 3)
+	if next.Template.ID == a.Template.ID {
+		t.Fatal("expected a new daily mission the next day")
+	}
+}
+
+func TestRegisteredGeneratedMissionIsPlayable(t *testing.T) {
+	initTestDAG(t)
+	saveGeneratedMissions(t)
+	m, err := GenerateMission(99, 2)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	RegisterGeneratedMission(m, nil)
+
+	room := newCombatTestRoom()
+	room.WorldWidth, room.WorldHeight = 32000, 18000 // Campaign map size
+	room.EnsureBeaconDirectorLocked("campaign-1")
+	player := &Player{ID: "p1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+	player.EnsureInventory()
+
+	if entry := missionEntry(t, room.MissionListLocked(player), m.Template.ID); entry.Status != MissionStatusAvailable {
+		t.Fatalf("expected the generated mission to be available, got %+v", entry)
+	}
+	if err := room.SelectMissionLocked(player, m.Template.ID); err != nil {
+		t.Fatalf("failed to select the generated mission: %v", err)
+	}
+	d := room.BeaconDirectorLocked()
+	if d.MissionID() != m.Template.ID || len(d.beacons) != m.Layout.BeaconCount || d.spawnTableID != m.SpawnTable.ID {
+		t.Fatalf("expected the generated layout, got mission %s with %d beacons", d.MissionID(), len(d.beacons))
+	}
+
+	reward := m.Template.Reward[0]
+	room.recordMissionCompletedLocked(player, m.Template.ID)
+	if got := player.Inventory.GetItemCount(reward.Item, reward.VariantID); got != reward.Count {
+		t.Fatalf("expected %d reward missiles, got %d", reward.Count, got)
+	}
+	room.recordMissionCompletedLocked(player, m.Template.ID)
+	if got := player.Inventory.GetItemCount(reward.Item, reward.VariantID); got != reward.Count {
+		t.Fatalf("expected the reward only once, got %d", got)
+	}
+}
+
+func TestRegisterGeneratedMissionDropsTheOldest(t *testing.T) {
+	saveGeneratedMissions(t)
+	var ids []string
+	for seed := int64(1); seed <= maxGeneratedMissions+2; seed++ {
+		m, err := GenerateMission(seed, 1)
+		if err != nil {
+			t.Fatalf("generate: %v", err)
+		}
+		ids = append(ids, m.Template.ID)
+		// The first mission is being played, so it is never dropped.
+		RegisterGeneratedMission(m, map[string]bool{ids[0]: true})
+	}
+	if _, err := GetTemplate(ids[0]); err != nil {
+		t.Fatal("expected a running generated mission to be kept")
+	}
+	for _, id := range ids[1:3] {
+		if _, err := GetTemplate(id); err == nil {
+			t.Fatalf("expected the oldest idle generated mission %s to be dropped", id)
+		}
+		if _, err := GetSpawnTable(id + "-spawns"); err == nil {
+			t.Fatalf("expected the spawn table of %s to be dropped", id)
+		}
+	}
+	if len(generatedMissions) != maxGeneratedMissions {
+		t.Fatalf("expected %d generated missions, got %d", maxGeneratedMissions, len(generatedMissions))
+	}
+}
+
+func TestMissionGenerateIsRateLimited(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "p1"}
+	if !room.ClaimMissionGenerateLocked(player) {
+		t.Fatal("expected the first request to be allowed")
+	}
+	room.Now += MissionGenerateCooldown / 2
+	if room.ClaimMissionGenerateLocked(player) {
+		t.Fatal("expected a request during the cooldown to be refused")
+	}
+	room.Now += MissionGenerateCooldown / 2
+	if !room.ClaimMissionGenerateLocked(player) {
+		t.Fatal("expected a request after the cooldown to be allowed")
+	}
+}
+
+func TestReloadDropsGeneratedMissionsWithRemovedEncounters(t *testing.T) {
+	saveGeneratedMissions(t)
+	savedGraph := dag.GetGraph()
+	t.Cleanup(func() { dag.SetGraph(savedGraph) })
+
+	dir := t.TempDir()
+	writeContentFile(t, dir, "test.json", testContent)
+	if _, err := ReloadContent(dir); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	m, err := GenerateMission(5, 1)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	m.SpawnTable.Rules[0].Encounters = append(m.SpawnTable.Rules[0].Encounters, WeightedEncounter{EncounterID: "test-content-encounter", Weight: 10})
+	RegisterGeneratedMission(m, nil)
+	if _, err := ReloadContent(dir); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if _, err := GetTemplate(m.Template.ID); err != nil {
+		t.Fatal("expected the generated mission to survive a reload")
+	}
+
+	if err := os.Remove(filepath.Join(dir, "test.json")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReloadContent(dir); err != nil {
+		t.Fatalf("expected the reload to drop the stale mission instead of failing: %v", err)
+	}
+	if _, err := GetTemplate(m.Template.ID); err == nil {
+		t.Fatal("expected the stale generated mission to be dropped")
+	}
+	if _, ok := generatedMissions[m.Template.ID]; ok || slices.Contains(generatedMissionOrder, m.Template.ID) {
+		t.Fatal("expected the stale generated mission to be forgotten")
+	}
+	if _, err := ReloadContent(dir); err != nil {
+		t.Fatalf("expected later reloads to keep working: %v", err)
+	}
+}
@@ -2,6 +2,8 @@ package game
 
 import (
 	"fmt"
+
+	"LightSpeedDuel/internal/dag"
 )
 
 // MissionArchetype enumerates supported mission templates.
@@ -14,6 +16,22 @@ const (
 	ArchetypeHazard                         // Clear mines/obstacles in area
 )
 
+// MarshalText encodes the archetype by name.
+func (a MissionArchetype) MarshalText() ([]byte, error) {
+	return []byte(archetypeToString(a)), nil
+}
+
+// UnmarshalText parses an archetype name such as "travel" or "kill".
+func (a *MissionArchetype) UnmarshalText(text []byte) error {
+	for _, arch := range []MissionArchetype{ArchetypeTravel, ArchetypeEscort, ArchetypeKill, ArchetypeHazard} {
+		if archetypeToString(arch) == string(text) {
+			*a = arch
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown mission archetype: %s", text)
+}
+
 // MissionTemplate represents a reusable mission definition shared across rooms.
 type MissionTemplate struct {
 	ID              string
@@ -23,7 +41,15 @@ type MissionTemplate struct {
 	StoryNodeID     string
 	EncounterRefs   []string
 	FailureTimeout  float64
-	Cooldown        float64
+	Cooldown        float64 // Seconds before a failed mission can be accepted again
+
+	FailureRules       []FailureRule
+	FailureStoryNodeID string // Story node shown to each player when the mission fails
+	FailureRollback    int    // Beacon locks undone on failure; negative undoes all
+
+	StoryTriggers []StoryTrigger // Story nodes started by mission events
+
+	Reward []dag.StoryEffect // Granted to each player the first time they complete the mission
 }
 
 // TemplateRegistry holds all defined mission templates.
@@ -36,16 +62,27 @@ var TemplateRegistry = map[string]MissionTemplate{
 			"beaconCount": 4,
 			"holdTime":    10.0,
 		},
-		StoryNodeID:    "campaign-1-intro",
+		StoryNodeID:    "story.signal-static-1.start",
 		EncounterRefs:  []string{"wave-1", "wave-2", "wave-3"},
 		FailureTimeout: 0,
 		Cooldown:       0,
+		StoryTriggers: []StoryTrigger{
+			{Event: MissionEventStart, Node: "story.signal-static-1.start"},
+			{Event: MissionEventBeaconLocked, Beacon: 1, Node: "story.signal-static-1.beacon-1-lock"},
+			{Event: MissionEventBeaconLocked, Beacon: 2, Node: "story.signal-static-1.beacon-2-lock"},
+			{Event: MissionEventBeaconLocked, Beacon: 3, Node: "story.signal-static-1.beacon-3-lock"},
+			// An optional fourth beacon doubles as the completion beat.
+			{Event: MissionEventBeaconLocked, Beacon: 4, Node: "story.signal-static-1.complete"},
+			{Event: MissionEventCompleted, Node: "story.signal-static-1.complete"},
+		},
 	},
 }
 
 // GetTemplate retrieves a mission template by ID.
 func GetTemplate(id string) (*MissionTemplate, error) {
+	contentMu.RLock()
 	template, ok := TemplateRegistry[id]
+	contentMu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("mission template not found: %s", id)
 	}
@@ -63,8 +100,25 @@ func (t *MissionTemplate) Validate() error {
 	if t.DisplayName == "" {
 		return fmt.Errorf("template %s missing display name", t.ID)
 	}
-	// TODO: Validate StoryNodeID exists in story DAG (Phase 2.3)
-	// TODO: Validate EncounterRefs exist in encounter registry (Phase 3)
+	for i, rule := range t.FailureRules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("template %s failureRules[%d]: %w", t.ID, i, err)
+		}
+	}
+	for i, trigger := range t.StoryTriggers {
+		if err := trigger.Validate(); err != nil {
+			return fmt.Errorf("template %s storyTriggers[%d]: %w", t.ID, i, err)
+		}
+	}
+	for i, effect := range t.Reward {
+		switch effect.Type {
+		case dag.StoryEffectSetFlag, dag.StoryEffectClearFlag, dag.StoryEffectGrantItem, dag.StoryEffectGrantUpgrade:
+		default:
+			return fmt.Errorf("template %s reward[%d]: %q cannot be a reward", t.ID, i, effect.Type)
+		}
+	}
+	// StoryNodeID, EncounterRefs, trigger nodes and rewards are checked by CheckContentReferences
+	// once all content and the DAG are loaded.
 	return nil
 }
 
@@ -85,6 +139,8 @@ type MissionUpdate struct {
 	Status     string           `json:"status"`
 	Objectives []ObjectiveState `json:"objectives"`
 	ServerTime float64          `json:"serverTime"`
+	Convoy     *ConvoyStatus    `json:"convoy,omitempty"` // Escort missions only
+	Reason     string           `json:"reason,omitempty"` // Why a failed mission was lost
 }
 
 // ObjectiveState captures current progress for an objective.
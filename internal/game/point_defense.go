@@ -0,0 +1,99 @@
+package game
+
+// newPointDefense returns the default point-defense mount fitted to ships.
+func newPointDefense() *PointDefenseComponent {
+	return &PointDefenseComponent{
+		Range:     PointDefenseRange,
+		Cooldown:  PointDefenseCooldown,
+		HeatCost:  PointDefenseHeatCost,
+		HitChance: PointDefenseHitChance,
+	}
+}
+
+// updatePointDefense lets every ship with a point-defense mount engage the
+// nearest incoming hostile missile it can perceive. Each shot costs heat and
+// is skipped when it would push the ship into overheat.
+func updatePointDefense(r *Room, rng func() float64) {
+	if rng == nil {
+		rng = r.simRand().Float64
+	}
+	world := r.World
+	world.ForEach([]ComponentKey{CompTransform, CompShip, CompOwner, CompPointDefense}, func(shipID EntityID) {
+		if world.DestroyedData(shipID) != nil {
+			return
+		}
+
+		tr := world.Transform(shipID)
+		owner := world.Owner(shipID)
+		pd := world.PointDefense(shipID)
+		if tr == nil || owner == nil || pd == nil || pd.Range <= 0 {
+			return
+		}
+		if r.Now < pd.ReadyAt {
+			return
+		}
+
+		heat := world.HeatData(shipID)
+		if heat != nil {
+			if heat.IsStalled(r.Now) {
+				return
+			}
+			if heat.S.Value+pd.HeatCost >= heat.P.OverheatAt {
+				return
+			}
+		}
+
+		target := EntityID(0)
+		bestDist := pd.Range
+		world.ForEach([]ComponentKey{CompTransform, CompMissile, CompOwner}, func(missileID EntityID) {
+			if world.DestroyedData(missileID) != nil {
+				return
+			}
+			missileOwner := world.Owner(missileID)
+			if missileOwner == nil || ownersAllied(owner, missileOwner) {
+				return
+			}
+			snap, ok := PerceiveEntity(tr.Pos, missileID, world, r.Now)
+			if !ok {
+				return
+			}
+			dist := snap.Pos.Sub(tr.Pos).Len()
+			if dist > bestDist {
+				return
+			}
+			if !missileIncoming(world.MissileData(missileID), shipID, snap, tr.Pos) {
+				return
+			}
+			bestDist = dist
+			target = missileID
+		})
+
+		if target == 0 {
+			return
+		}
+
+		pd.ReadyAt = r.Now + pd.Cooldown
+		if heat != nil {
+			heat.S.Value += pd.HeatCost
+			if heat.S.Value > heat.P.Max {
+				heat.S.Value = heat.P.Max
+			}
+		}
+		if rng() < pd.HitChance {
+			r.detonateMissile(target, detonateShotDown)
+		}
+	})
+}
+
+// missileIncoming reports whether a perceived missile threatens the ship: it is
+// either locked onto the ship or its perceived velocity is closing the gap.
+func missileIncoming(missile *MissileComponent, shipID EntityID, snap Snapshot, shipPos Vec2) bool {
+	if missile == nil {
+		return false
+	}
+	if missile.Target == shipID {
+		return true
+	}
+	toShip := shipPos.Sub(snap.Pos)
+	return toShip.Dot(snap.Vel) > 0
+}
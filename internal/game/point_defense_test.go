@@ -0,0 +1,119 @@
+package game
+
+import "testing"
+
+func newCombatTestRoom() *Room {
+	return &Room{
+		ID:           "room-combat",
+		World:        newWorld(),
+		Players:      map[string]*Player{},
+		Bots:         map[string]*AIAgent{},
+		WorldWidth:   WorldW,
+		WorldHeight:  WorldH,
+		heatDefaults: DefaultHeatParams(),
+	}
+}
+
+func alwaysHit() float64 { return 0 }
+
+func TestPointDefenseDestroysIncomingMissile(t *testing.T) {
+	room := newCombatTestRoom()
+	ship := room.SpawnShip("defender", Vec2{X: 1000, Y: 1000})
+
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500})
+	missile := room.LaunchMissile("attacker", 0, cfg, []RouteWaypoint{{Pos: Vec2{X: 1000, Y: 1000}}}, Vec2{X: 1200, Y: 1000}, Vec2{})
+	room.World.MissileData(missile).Target = ship
+
+	room.Now = 1
+	updatePointDefense(room, alwaysHit)
+
+	if room.World.DestroyedData(missile) == nil {
+		t.Fatal("expected point defense to destroy the incoming missile")
+	}
+	pd := room.World.PointDefense(ship)
+	if pd.ReadyAt != room.Now+PointDefenseCooldown {
+		t.Fatalf("expected cooldown to start, got ready at %.2f", pd.ReadyAt)
+	}
+	if heat := room.World.HeatData(ship); heat.S.Value != PointDefenseHeatCost {
+		t.Fatalf("expected heat cost %.1f, got %.1f", PointDefenseHeatCost, heat.S.Value)
+	}
+}
+
+func TestPointDefenseIgnoresRecedingAndAlliedMissiles(t *testing.T) {
+	room := newCombatTestRoom()
+	ship := room.SpawnShip("defender", Vec2{X: 1000, Y: 1000})
+
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500})
+	wps := []RouteWaypoint{{Pos: Vec2{X: 2000, Y: 1000}}}
+	receding := room.LaunchMissile("attacker", 0, cfg, wps, Vec2{X: 1200, Y: 1000}, Vec2{})
+	room.World.HistoryComponent(receding).History.push(Snapshot{T: room.Now, Pos: Vec2{X: 1200, Y: 1000}, Vel: Vec2{X: 150}})
+	allied := room.LaunchMissile("defender", ship, cfg, wps, Vec2{X: 1100, Y: 1000}, Vec2{})
+
+	room.Now = 1
+	updatePointDefense(room, alwaysHit)
+
+	if room.World.DestroyedData(receding) != nil {
+		t.Fatal("receding missile should not be engaged")
+	}
+	if room.World.DestroyedData(allied) != nil {
+		t.Fatal("allied missile should not be engaged")
+	}
+}
+
+func TestPointDefenseHoldsFireNearOverheat(t *testing.T) {
+	room := newCombatTestRoom()
+	ship := room.SpawnShip("defender", Vec2{X: 1000, Y: 1000})
+	heat := room.World.HeatData(ship)
+	heat.S.Value = heat.P.OverheatAt - PointDefenseHeatCost/2
+
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500})
+	missile := room.LaunchMissile("attacker", 0, cfg, []RouteWaypoint{{Pos: Vec2{X: 1000, Y: 1000}}}, Vec2{X: 1200, Y: 1000}, Vec2{})
+	room.World.MissileData(missile).Target = ship
+
+	room.Now = 1
+	updatePointDefense(room, alwaysHit)
+
+	if room.World.DestroyedData(missile) != nil {
+		t.Fatal("point defense should not fire when the shot would overheat the ship")
+	}
+}
+
+func TestOpposingMissilesDestroyEachOther(t *testing.T) {
+	room := newCombatTestRoom()
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500})
+	wps := []RouteWaypoint{{Pos: Vec2{X: 3000, Y: 3000}}}
+
+	a := room.LaunchMissile("alpha", 0, cfg, wps, Vec2{X: 1000, Y: 1000}, Vec2{})
+	b := room.LaunchMissile("bravo", 0, cfg, wps, Vec2{X: 1020, Y: 1000}, Vec2{})
+	mineA := room.LaunchMissile(missionOwnerID, 0, cfg, wps, Vec2{X: 2000, Y: 2000}, Vec2{})
+	mineB := room.LaunchMissile(missionOwnerID, 0, cfg, wps, Vec2{X: 2010, Y: 2000}, Vec2{})
+
+	resolveMissileCollisions(room)
+
+	if room.World.DestroyedData(a) == nil || room.World.DestroyedData(b) == nil {
+		t.Fatal("expected opposing missiles to destroy each other")
+	}
+	if room.World.DestroyedData(mineA) != nil || room.World.DestroyedData(mineB) != nil {
+		t.Fatal("mission mines should not destroy each other")
+	}
+}
+
+func TestInterceptorGuidanceTargetsMissiles(t *testing.T) {
+	room := newCombatTestRoom()
+	room.SpawnShip("bravo", Vec2{X: 1100, Y: 1000})
+
+	cfg := GetMissilePreset(MissilePresetInterceptor)
+	if cfg.Guidance != MissileGuidanceInterceptor {
+		t.Fatalf("expected interceptor preset guidance, got %v", cfg.Guidance)
+	}
+	wps := []RouteWaypoint{{Pos: Vec2{X: 3000, Y: 3000}}}
+	interceptor := room.LaunchMissile("alpha", 0, cfg, wps, Vec2{X: 1000, Y: 1000}, Vec2{})
+	hostile := room.LaunchMissile("bravo", 0, SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 100}), wps, Vec2{X: 1000, Y: 1300}, Vec2{})
+
+	room.Now = 1
+	updateMissileGuidance(room, Dt)
+
+	if target := room.World.MissileData(interceptor).Target; target != hostile {
+		t.Fatalf("expected interceptor to lock onto hostile missile %d, got %d", hostile, target)
+	}
+}
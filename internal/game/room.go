@@ -27,8 +27,11 @@ type Player struct {
 	MissileRoutes        []*MissileRouteDef
 	ActiveMissileRouteID string
 	MissileReadyAt       float64
+	GenerateReadyAt      float64 // Room time the player may next generate a mission
 	IsBot                bool
 	Kills                int
+	Deaths               int
+	KilledBy             string     // Player credited with the most recent death, if any
 	DagState             *dag.State // Progression state for crafting/upgrades
 	Inventory            *Inventory // Player's crafted items
 	StoryFlags           map[string]bool
@@ -36,6 +39,8 @@ type Player struct {
 	PendingStoryEvents   []StoryEvent
 	Capabilities         dag.PlayerCapabilities
 	PendingMessages      []OutboundMessage
+	Hull                 string // Selected hull class ID (empty = DefaultHullID)
+	CompletedMissions    map[string]bool
 }
 
 // SendMessage queues an outbound event for the connected player.
@@ -72,6 +77,8 @@ type Room struct {
 	WorldWidth             float64
 	WorldHeight            float64
 	heatDefaults           HeatParams
+	heatScale              map[string]float64 // Story heat_params multipliers over heatDefaults
+	followHeatReloads      bool               // Content reloads push new heat defaults to this room
 	missionWaves           map[int]bool
 	missionDirector        *BeaconDirector
 	missionSnapshotVersion uint64
@@ -79,6 +86,9 @@ type Room struct {
 	missionFrameVersion    uint64
 	missionFrameDeltas     []BeaconDelta
 	missionFrameEncounters []EncounterDelta
+	storyTimers            []storyTimer
+	coop                   *CoopParty
+	rng                    *rand.Rand // Simulation randomness; see SeedLocked
 }
 
 func newRoom(id string, defaults HeatParams) *Room {
@@ -111,6 +121,28 @@ func NewHub(defaultHeat HeatParams) *Hub {
 	}
 }
 
+// HeatDefaults returns the heat params new rooms start with.
+func (h *Hub) HeatDefaults() HeatParams {
+	h.Mu.Lock()
+	defer h.Mu.Unlock()
+	return h.heatDefaults
+}
+
+// SetHeatDefaults changes the heat params for rooms created from now on.
+// Running rooms that follow heat reloads switch to them as well.
+func (h *Hub) SetHeatDefaults(params HeatParams) {
+	h.Mu.Lock()
+	defer h.Mu.Unlock()
+	h.heatDefaults = SanitizeHeatParams(params)
+	for _, r := range h.Rooms {
+		r.Mu.Lock()
+		if r.followHeatReloads {
+			r.rederiveHeatLocked(h.heatDefaults)
+		}
+		r.Mu.Unlock()
+	}
+}
+
 func (h *Hub) GetRoom(id string) *Room {
 	h.Mu.Lock()
 	defer h.Mu.Unlock()
@@ -149,11 +181,12 @@ func (r *Room) SetWorldSize(w, h float64) {
 func (r *Room) HeatParams() HeatParams {
 	r.Mu.Lock()
 	defer r.Mu.Unlock()
-	return r.heatDefaults
+	return r.HeatParamsLocked()
 }
 
+// HeatParamsLocked returns the room's heat defaults with any story scaling.
 func (r *Room) HeatParamsLocked() HeatParams {
-	return r.heatDefaults
+	return scaleHeatParams(r.heatDefaults, r.heatScale)
 }
 
 func (r *Room) applyHeatParams(params HeatParams) {
@@ -172,12 +205,56 @@ func (r *Room) applyHeatParams(params HeatParams) {
 	})
 }
 
+// SetFollowHeatReloadsLocked opts the room in or out of heat default
+// reloads. Callers must hold r.Mu.
+func (r *Room) SetFollowHeatReloadsLocked(follow bool) {
+	r.followHeatReloads = follow
+}
+
+// rederiveHeatLocked switches the room to new heat defaults. Player ships and
+// wingmen take their hull's view of them; missiles and mission entities keep
+// the heat they were spawned with.
+func (r *Room) rederiveHeatLocked(params HeatParams) {
+	r.heatDefaults = SanitizeHeatParams(params)
+	refit := func(id EntityID) {
+		heat := r.World.HeatData(id)
+		ship := r.World.ShipData(id)
+		if heat == nil || ship == nil {
+			return
+		}
+		heat.P = ResolveHull(ship.Hull).HeatParams(r.HeatParamsLocked())
+		heat.S.Value = Clamp(heat.S.Value, 0, heat.P.Max)
+	}
+	for _, p := range r.Players {
+		if p != nil && p.Ship != 0 {
+			refit(p.Ship)
+		}
+	}
+	r.World.ForEach([]ComponentKey{CompWingman}, refit)
+}
+
 func (r *Room) SetHeatParams(params HeatParams) {
 	r.Mu.Lock()
 	defer r.Mu.Unlock()
 	r.applyHeatParams(params)
 }
 
+// SeedLocked reseeds the room's simulation randomness (point defense, hit
+// effects and bot decisions) so a match can be replayed exactly. Callers must
+// hold r.Mu.
+func (r *Room) SeedLocked(seed int64) {
+	r.rng = rand.New(rand.NewSource(seed))
+}
+
+// simRand returns the room's simulation random source, seeding it from the
+// global source if the room was never seeded.
+func (r *Room) simRand() *rand.Rand {
+	if r.rng == nil {
+		r.rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+	return r.rng
+}
+
 func (r *Room) MissionWaveSpawnedLocked(index int) bool {
 	if index <= 0 {
 		return false
@@ -217,6 +294,9 @@ func (r *Room) EnsureBeaconDirectorLocked(missionID string) *BeaconDirector {
 	if !ok {
 		return nil
 	}
+	if r.coop != nil {
+		director.shareProgress()
+	}
 	r.missionDirector = director
 	return director
 }
@@ -295,11 +375,18 @@ func (r *Room) Tick() {
 	}
 
 	r.updateAI()
+	updateGunships(r)
+	updateWingmen(r)
+	updateSpawners(r)
+	updateBosses(r)
 	updateMissileGuidance(r, Dt)
 	updateRouteFollowers(r, Dt)
+	updatePointDefense(r, r.simRand().Float64)
 	resolveMissileCollisions(r)
 	updateMissileHeat(r, Dt)
+	updateSubsystemRepair(r, Dt)
 	r.updateDagStates()
+	r.updateStoryTimers()
 
 	// Run garbage collection every second to clean up old destroyed entities
 	tickCount := int(r.Now * SimHz)
@@ -344,18 +431,19 @@ func (r *Room) EvaluatePlayerDagLocked(graph *dag.Graph, player *Player, effects
 	caps := dag.CalculateCapabilities(player.DagState)
 	player.Capabilities = caps
 
-	// Apply ship movement cap
+	// Apply ship movement cap on top of the hull's base stats
 	if player.Ship != 0 {
+		hull := ResolveHull(player.Hull)
 		if mov := r.World.Movement(player.Ship); mov != nil {
-			base := ShipMaxSpeed
+			base := hull.MaxSpeed
 			if caps.ShipSpeedMultiplier <= 0 {
 				caps.ShipSpeedMultiplier = 1.0
 			}
 			mov.MaxSpeed = base * caps.ShipSpeedMultiplier
 		}
-		// Apply ship heat capacity scaling relative to room defaults
+		// Apply ship heat capacity scaling relative to the hull's heat profile
 		if heat := r.World.HeatData(player.Ship); heat != nil {
-			base := r.heatDefaults
+			base := hull.HeatParams(r.HeatParamsLocked())
 			scale := caps.ShipHeatCapacity
 			if scale <= 0 {
 				scale = 1.0
@@ -365,7 +453,11 @@ func (r *Room) EvaluatePlayerDagLocked(graph *dag.Graph, player *Player, effects
 			heat.P.OverheatAt = base.OverheatAt * scale
 			// also scale marker speed so the neutral marker reflects increased capacity
 			heat.P.MarkerSpeed = base.MarkerSpeed * scale
-			// keep dynamics unchanged
+			// heat efficiency slows heating; cooling is unchanged
+			heat.P.KUp = base.KUp
+			if caps.ShipHeatEfficiency > 0 {
+				heat.P.KUp = base.KUp / caps.ShipHeatEfficiency
+			}
 		}
 	}
 }
@@ -392,112 +484,59 @@ func (r *Room) tryStartStoryNodeLocked(player *Player, nodeID dag.NodeID) {
 	}
 
 	log.Printf("[story] Successfully started node %s for player %s", nodeID, player.ID)
+	// Effects only apply to nodes the server starts, never to client dag_start requests.
+	r.applyStoryEffectsLocked(player, string(nodeID), graph.GetNode(nodeID).StoryEffects)
 	// Re-evaluate to unlock downstream nodes immediately.
 	r.EvaluatePlayerDagLocked(graph, player, effects)
 }
 
+// HandleMissionStoryEventLocked handles a mission event reported by name and
+// beacon ordinal; see HandleMissionEventLocked.
 func (r *Room) HandleMissionStoryEventLocked(player *Player, event string, beaconIndex int) {
-	nodeID := storyNodeForMissionEvent(event, beaconIndex)
-	if nodeID == "" {
-		return
-	}
-
-	r.tryStartStoryNodeLocked(player, nodeID)
-}
-
-func storyNodeForMissionEvent(event string, beaconIndex int) dag.NodeID {
-	switch event {
-	case "mission:start":
-		return dag.NodeID("story.signal-static-1.start")
-	case "mission:beacon-locked":
-		switch beaconIndex {
-		case 1:
-			return dag.NodeID("story.signal-static-1.beacon-1-lock")
-		case 2:
-			return dag.NodeID("story.signal-static-1.beacon-2-lock")
-		case 3:
-			return dag.NodeID("story.signal-static-1.beacon-3-lock")
-		case 4:
-			// Allow optional beacon 4 message to map to completion as fallback.
-			return dag.NodeID("story.signal-static-1.complete")
-		}
-	case "mission:completed":
-		return dag.NodeID("story.signal-static-1.complete")
-	}
-	return ""
+	r.HandleMissionEventLocked(player, MissionEvent{Type: event, Beacon: beaconIndex})
 }
 
-// HandleStoryChoiceBranching activates follow-up story nodes and effects based on player choice.
+// HandleStoryChoiceBranching applies the effects of the player's choice on a
+// story node and starts the node it leads to. In co-op rooms the choice is
+// a vote or leader decision for the whole party instead.
 func (r *Room) HandleStoryChoiceBranching(p *Player, parentNodeID dag.NodeID, choiceID string, graph *dag.Graph) {
 	if r == nil || p == nil || graph == nil || choiceID == "" {
 		return
 	}
-	baseID := string(parentNodeID)
-	childID := baseID + "-" + choiceID
-	if strings.HasSuffix(baseID, "-lock") {
-		childID = strings.TrimSuffix(baseID, "-lock") + "-" + choiceID
-	}
-
-	childNodeID := dag.NodeID(childID)
-	childNode := graph.GetNode(childNodeID)
-	if childNode == nil {
-		log.Printf("[story] No child node found for choice %s on %s", choiceID, parentNodeID)
-		return
-	}
-
-	log.Printf("[story] Player %s chose %s on %s -> activating %s", p.ID, choiceID, parentNodeID, childNodeID)
-	r.tryStartStoryNodeLocked(p, childNodeID)
-	status := p.DagState.GetStatus(childNodeID)
-	if status == dag.StatusLocked {
-		log.Printf("[story] Child node %s remained locked after choice %s", childNodeID, choiceID)
+	if r.coop != nil {
+		r.handlePartyChoiceLocked(p, parentNodeID, choiceID, graph)
 		return
 	}
-	r.handleStoryNodeEffects(p, childNode)
+	r.applyStoryChoiceLocked(p, parentNodeID, choiceID, graph)
 }
 
-// handleStoryNodeEffects processes special payload directives for story outcomes.
-func (r *Room) handleStoryNodeEffects(p *Player, node *dag.Node) {
-	if r == nil || p == nil || node == nil {
+// applyStoryChoiceLocked applies a choice's effects to the player and starts
+// the node it leads to.
+func (r *Room) applyStoryChoiceLocked(p *Player, parentNodeID dag.NodeID, choiceID string, graph *dag.Graph) {
+	choice := findStoryChoice(graph.GetNode(parentNodeID), choiceID)
+	if choice == nil {
+		log.Printf("[story] Unknown choice %s on %s", choiceID, parentNodeID)
 		return
 	}
 
-	if upgradeID := node.Payload["grant_upgrade"]; upgradeID != "" {
-		r.grantUpgradeToPlayer(p, dag.NodeID(upgradeID))
+	log.Printf("[story] Player %s chose %s on %s", p.ID, choiceID, parentNodeID)
+	r.applyStoryEffectsLocked(p, string(parentNodeID)+"/"+choiceID, choice.Effects)
+	if choice.Next != "" {
+		r.tryStartStoryNodeLocked(p, choice.Next)
 	}
+}
 
-	if spawn := strings.ToLower(strings.TrimSpace(node.Payload["spawn_encounter"])); spawn == "true" {
-		waveIndex := 0
-		if waveStr := node.Payload["encounter_wave"]; waveStr != "" {
-			if parsed, err := strconv.Atoi(waveStr); err == nil {
-				waveIndex = parsed
-			} else {
-				log.Printf("[story] Invalid encounter_wave %q on node %s", waveStr, node.ID)
-			}
-		}
-
-		if waveIndex > 0 {
-			beaconOrdinal := 0
-			if ordStr := node.Payload["encounter_beacon"]; ordStr != "" {
-				if parsed, err := strconv.Atoi(ordStr); err == nil {
-					beaconOrdinal = parsed
-				} else {
-					log.Printf("[story] Invalid encounter_beacon %q on node %s", ordStr, node.ID)
-				}
-			}
-
-			director := r.EnsureBeaconDirectorLocked("campaign-1")
-			if director == nil {
-				log.Printf("[story] Cannot spawn encounter for node %s: no beacon director", node.ID)
-				return
-			}
-
-			beaconID := ""
-			if beaconOrdinal > 0 && beaconOrdinal <= len(director.beacons) {
-				beaconID = director.beacons[beaconOrdinal-1].ID
-			}
-			director.launchEncounter(r, beaconID, waveIndex)
+// findStoryChoice returns the node's dialogue choice with the given ID.
+func findStoryChoice(parent *dag.Node, choiceID string) *dag.DialogueChoice {
+	if parent == nil || parent.Dialogue == nil {
+		return nil
+	}
+	for i := range parent.Dialogue.Choices {
+		if parent.Dialogue.Choices[i].ID == choiceID {
+			return &parent.Dialogue.Choices[i]
 		}
 	}
+	return nil
 }
 
 // grantUpgradeToPlayer force-completes an upgrade node as an immediate reward.
@@ -576,6 +615,7 @@ func (r *Room) BroadcastObjectiveProgress(p *Player, objectiveID string, progres
 		Status:     "active",
 		Objectives: r.buildObjectiveStates(p),
 		ServerTime: r.Now,
+		Convoy:     r.convoyStatus(r.missionDirector.convoy),
 	}
 	p.SendMessage("mission:update", update)
 }
@@ -589,7 +629,8 @@ func (r *Room) BroadcastObjectiveComplete(p *Player, objectiveID string) {
 	status := "active"
 	if len(r.missionDirector.ActiveObjectives) == 0 {
 		status = "completed"
-		r.HandleMissionStoryEventLocked(p, "mission:completed", 0)
+		r.HandleMissionEventLocked(p, MissionEvent{Type: MissionEventCompleted})
+		r.recordMissionCompletedLocked(p, r.missionDirector.CurrentMissionID)
 	}
 
 	update := MissionUpdate{
@@ -597,6 +638,23 @@ func (r *Room) BroadcastObjectiveComplete(p *Player, objectiveID string) {
 		Status:     status,
 		Objectives: r.buildObjectiveStates(p),
 		ServerTime: r.Now,
+		Convoy:     r.convoyStatus(r.missionDirector.convoy),
+	}
+	p.SendMessage("mission:update", update)
+}
+
+// BroadcastMissionFailed queues a failed mission update for the player.
+func (r *Room) BroadcastMissionFailed(p *Player, reason string) {
+	if r == nil || p == nil || r.missionDirector == nil {
+		return
+	}
+	update := MissionUpdate{
+		MissionID:  r.missionDirector.CurrentMissionID,
+		Status:     "failed",
+		Objectives: r.buildObjectiveStates(p),
+		ServerTime: r.Now,
+		Convoy:     r.convoyStatus(r.missionDirector.convoy),
+		Reason:     reason,
 	}
 	p.SendMessage("mission:update", update)
 }
@@ -659,7 +717,7 @@ func generateObjectiveDescriptions(template *MissionTemplate) []string {
 		}
 		return []string{"Secure mission beacons"}
 	case ArchetypeEscort:
-		return []string{"Escort the target safely to the destination"}
+		return []string{"Escort the convoy through every beacon to the destination"}
 	case ArchetypeKill:
 		count, _ := floatFromParam(template.ObjectiveParams["requiredKills"])
 		tag := ""
@@ -692,6 +750,8 @@ func getEvaluatorType(eval ObjectiveEvaluator) string {
 		return "timer"
 	case *HazardClearEvaluator:
 		return "hazard"
+	case *EscortEvaluator:
+		return "escort"
 	default:
 		return "unknown"
 	}
@@ -752,6 +812,8 @@ func generateObjectiveDescription(eval ObjectiveEvaluator) string {
 			return fmt.Sprintf("Clear hazards within %.0f units", e.Radius)
 		}
 		return "Clear hazardous area"
+	case *EscortEvaluator:
+		return "Escort the convoy to the final beacon"
 	default:
 		return "Complete mission objective"
 	}
@@ -819,7 +881,7 @@ func (r *Room) removePlayerEntitiesUnlocked(playerID string) {
 	var toRemove []EntityID
 	r.World.ForEach([]ComponentKey{CompOwner}, func(e EntityID) {
 		owner := r.World.Owner(e)
-		if owner != nil && owner.PlayerID == playerID {
+		if owner != nil && (owner.PlayerID == playerID || owner.LeaderID == playerID) {
 			toRemove = append(toRemove, e)
 		}
 	})
@@ -829,12 +891,12 @@ func (r *Room) removePlayerEntitiesUnlocked(playerID string) {
 }
 
 func (r *Room) addBotUnlocked(name string, behavior AIBehavior, startPos Vec2) *Player {
-	id := RandId("bot")
+	id := r.randID("bot")
 	for {
 		if _, exists := r.Players[id]; !exists {
 			break
 		}
-		id = RandId("bot")
+		id = r.randID("bot")
 	}
 	player := &Player{
 		ID:            id,
@@ -884,19 +946,41 @@ func (r *Room) RemovePlayerEntitiesLocked(playerID string) {
 	r.removePlayerEntitiesUnlocked(playerID)
 }
 
+// RemovePlayerLocked removes a departing player and their entities. In co-op
+// rooms the party's open votes are re-tallied without them.
+func (r *Room) RemovePlayerLocked(playerID string) {
+	if _, ok := r.Players[playerID]; !ok {
+		return
+	}
+	r.removePlayerEntitiesUnlocked(playerID)
+	delete(r.Players, playerID)
+	if r.coop != nil {
+		r.partyMemberLeftLocked()
+	}
+}
+
 func (r *Room) SpawnShip(owner string, startPos Vec2) EntityID {
+	hull := r.hullForOwner(owner)
 	id := r.World.NewEntity()
 	r.World.SetComponent(id, CompTransform, &Transform{Pos: startPos})
-	r.World.SetComponent(id, compMovement, &Movement{MaxSpeed: ShipMaxSpeed})
-	r.World.SetComponent(id, CompShip, &ShipComponent{HP: ShipMaxHP})
+	r.World.SetComponent(id, compMovement, &Movement{MaxSpeed: hull.MaxSpeed})
+	r.World.SetComponent(id, CompShip, &ShipComponent{
+		HP:        hull.MaxHP,
+		MaxHP:     hull.MaxHP,
+		HitRadius: hull.HitRadius,
+		Hull:      hull.ID,
+	})
 	r.World.SetComponent(id, CompRoute, &RouteComponent{})
 	r.World.SetComponent(id, CompRouteFollower, &RouteFollower{})
 	r.World.SetComponent(id, CompOwner, &OwnerComponent{PlayerID: owner, Neutral: false})
+	r.World.SetComponent(id, CompPointDefense, hull.PointDefense())
+	r.World.SetComponent(id, CompSubsystems, newSubsystems())
+	r.World.SetComponent(id, CompHeatAbilities, newHeatAbilities())
 	history := newHistory(HistoryKeepS, SimHz)
 	history.push(Snapshot{T: r.Now, Pos: startPos})
 	r.World.SetComponent(id, CompHistory, &HistoryComponent{History: history})
-	params := r.heatDefaults
-	// Initialize heat component with room default parameters
+	params := hull.HeatParams(r.HeatParamsLocked())
+	// Initialize heat component with the hull's view of the room defaults
 	r.World.SetComponent(id, CompHeat, &HeatComponent{
 		P: params,
 		S: HeatState{
@@ -919,6 +1003,8 @@ func (r *Room) LaunchMissile(owner string, shipID EntityID, cfg MissileConfig, w
 		AgroRadius: cfg.AgroRadius,
 		LaunchTime: r.Now,
 		Lifetime:   cfg.Lifetime,
+		Guidance:   cfg.Guidance,
+		Warhead:    cfg.Warhead,
 	}
 	r.World.SetComponent(id, CompMissile, missile)
 	copied := make([]RouteWaypoint, len(waypoints))
@@ -935,7 +1021,11 @@ func (r *Room) LaunchMissile(owner string, shipID EntityID, cfg MissileConfig, w
 	if neutralOwner && strings.EqualFold(normalizedOwner, "mission") {
 		normalizedOwner = "mission"
 	}
-	r.World.SetComponent(id, CompOwner, &OwnerComponent{PlayerID: normalizedOwner, Neutral: neutralOwner})
+	missileOwner := &OwnerComponent{PlayerID: normalizedOwner, Neutral: neutralOwner}
+	if shipOwner := r.World.Owner(shipID); shipOwner != nil {
+		missileOwner.LeaderID = shipOwner.LeaderID
+	}
+	r.World.SetComponent(id, CompOwner, missileOwner)
 
 	// Add heat component with missile-specific parameters
 	r.World.SetComponent(id, CompHeat, &HeatComponent{
@@ -967,6 +1057,15 @@ func (r *Room) handleShipDestruction(shipID EntityID, attackerID string) {
 		return
 	}
 
+	// Mission ships, wingmen and convoys have no player to respawn
+	if owner.PlayerID == missionOwnerID || r.World.Wingman(shipID) != nil || r.World.Convoy(shipID) != nil {
+		if r.World.DestroyedData(shipID) == nil {
+			r.destroyUnpilotedShip(shipID, attackerID)
+			r.missionShipDestroyedLocked(shipID, owner)
+		}
+		return
+	}
+
 	player := r.Players[owner.PlayerID]
 	if player == nil {
 		return
@@ -987,20 +1086,26 @@ func (r *Room) handleShipDestruction(shipID EntityID, attackerID string) {
 
 		// Mark the old ship as destroyed so its history persists for observers.
 		r.World.SetComponent(shipID, CompDestroyed, &DestroyedComponent{DestroyedAt: r.Now})
+		player.Deaths++
+		player.KilledBy = attackerID
 
 		// Ensure we have an AI agent registered for this bot and reset its planning timer.
+		// Rebuild the bot's behavior from the registry so its state starts fresh.
 		agent := r.Bots[owner.PlayerID]
 		if agent == nil {
 			agent = NewAIAgent(owner.PlayerID, NewDefensiveBehavior())
 			r.Bots[owner.PlayerID] = agent
+		} else if behavior, err := NewAIBehavior(agent.BehaviorName); err == nil {
+			agent.Behavior = behavior
 		} else {
 			agent.Behavior = NewDefensiveBehavior()
+			agent.BehaviorName = AIBehaviorDefensive
 		}
 		agent.nextPlanAt = 0
 
 		// Spawn a replacement ship for the same bot player at a new random location.
-		randX := r.WorldWidth * (0.2 + 0.6*rand.Float64())
-		randY := r.WorldHeight * (0.2 + 0.6*rand.Float64())
+		randX := r.WorldWidth * (0.2 + 0.6*r.simRand().Float64())
+		randY := r.WorldHeight * (0.2 + 0.6*r.simRand().Float64())
 		newShip := r.SpawnShip(owner.PlayerID, Vec2{X: randX, Y: randY})
 
 		player.Ship = newShip
@@ -1009,7 +1114,10 @@ func (r *Room) handleShipDestruction(shipID EntityID, attackerID string) {
 		return
 	} else {
 		// Player: respawn at center
+		player.Deaths++
+		player.KilledBy = attackerID
 		r.reSpawnShip(shipID)
+		r.HandleMissionEventLocked(player, MissionEvent{Type: MissionEventShipDestroyed, Target: ShipDestroyedPlayer})
 	}
 }
 
@@ -1028,12 +1136,33 @@ func (r *Room) reSpawnShip(id EntityID) {
 		follower.Hold = false
 		follower.hasOverride = false
 	}
+	hull := ResolveHull(DefaultHullID)
 	if ship := r.World.ShipData(id); ship != nil {
-		ship.HP = ShipMaxHP
+		hull = ResolveHull(ship.Hull)
+	}
+	// Players come back in the hull they last selected
+	if owner := r.World.Owner(id); owner != nil && r.Players[owner.PlayerID] != nil {
+		hull = r.hullForOwner(owner.PlayerID)
+	}
+	r.applyHullLocked(id, hull)
+	if ship := r.World.ShipData(id); ship != nil {
+		ship.HP = ship.MaxHP
+		if ship.HP <= 0 {
+			ship.HP = ShipMaxHP
+		}
+	}
+	if pd := r.World.PointDefense(id); pd != nil {
+		pd.ReadyAt = 0
+	}
+	if subs := r.World.Subsystems(id); subs != nil {
+		subs.Restore()
+	}
+	if abilities := r.World.HeatAbilities(id); abilities != nil {
+		abilities.Reset()
 	}
 	// Reset heat on respawn
 	if heat := r.World.HeatData(id); heat != nil {
-		heat.P = r.heatDefaults
+		heat.P = hull.HeatParams(r.HeatParamsLocked())
 		heat.S.Value = 0
 		heat.S.StallUntil = 0
 	}
@@ -1046,10 +1175,20 @@ func (r *Room) reSpawnShip(id EntityID) {
 }
 
 func RandId(prefix string) string {
+	return randIDFrom(prefix, rand.Intn)
+}
+
+// randID returns an ID drawn from the room's random source, so seeded rooms
+// name their bots the same way every time.
+func (r *Room) randID(prefix string) string {
+	return randIDFrom(prefix, r.simRand().Intn)
+}
+
+func randIDFrom(prefix string, intn func(int) int) string {
 	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
 	b := make([]byte, 6)
 	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+		b[i] = letters[intn(len(letters))]
 	}
 	return prefix + "-" + string(b)
 }
@@ -81,7 +81,9 @@ func GetSpawnTable(id string) (*SpawnTable, error) {
 	if id == "" {
 		return nil, fmt.Errorf("spawn table not found: empty id")
 	}
+	contentMu.RLock()
 	table, ok := SpawnTableRegistry[id]
+	contentMu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("spawn table not found: %s", id)
 	}
@@ -0,0 +1,113 @@
+package game
+
+// Defaults for spawner groups that leave the cadence unset
+const (
+	spawnerDefaultInterval    = 15.0
+	spawnerDefaultMaxChildren = 6
+)
+
+// spawnSpawnerEntity creates a mission-owned ship that emits children from
+// the group's child template until it is destroyed.
+func spawnSpawnerEntity(r *Room, pos Vec2, group SpawnGroup) EntityID {
+	if _, err := GetEncounter(group.ChildTemplate); err != nil {
+		return 0
+	}
+	interval := group.SpawnInterval
+	if interval <= 0 {
+		interval = spawnerDefaultInterval
+	}
+	maxChildren := group.MaxChildren
+	if maxChildren <= 0 {
+		maxChildren = spawnerDefaultMaxChildren
+	}
+
+	id := r.SpawnShip(missionOwnerID, pos)
+	if group.Hull != "" {
+		if hull, err := GetHull(group.Hull); err == nil {
+			r.applyHullLocked(id, *hull)
+		}
+	}
+	r.World.SetComponent(id, CompSpawner, &SpawnerComponent{
+		Template:    group.ChildTemplate,
+		Interval:    interval,
+		MaxChildren: maxChildren,
+		NextSpawnAt: r.Now + interval,
+	})
+	applyEntityTags(r, id, group.Tags)
+	return id
+}
+
+// liveChildren drops children that have been destroyed or removed.
+func (s *SpawnerComponent) liveChildren(w *World) []EntityID {
+	live := s.Children[:0]
+	for _, child := range s.Children {
+		if w.Exists(child) && w.DestroyedData(child) == nil {
+			live = append(live, child)
+		}
+	}
+	s.Children = live
+	return live
+}
+
+// updateSpawners emits a wave from every spawner whose interval has elapsed
+// and that is below its child cap. Children beyond the cap are discarded.
+func updateSpawners(r *Room) {
+	world := r.World
+	var ready []EntityID
+	world.ForEach([]ComponentKey{CompSpawner, CompTransform}, func(id EntityID) {
+		if world.DestroyedData(id) != nil {
+			return
+		}
+		if sp := world.Spawner(id); sp != nil && r.Now >= sp.NextSpawnAt {
+			ready = append(ready, id)
+		}
+	})
+
+	for _, id := range ready {
+		sp := world.Spawner(id)
+		sp.NextSpawnAt = r.Now + sp.Interval
+		room := sp.MaxChildren - len(sp.liveChildren(world))
+		if room <= 0 {
+			continue
+		}
+		template, err := GetEncounter(sp.Template)
+		if err != nil {
+			continue
+		}
+		sp.Waves++
+		seed := int64(id)*7919 + int64(sp.Waves)
+		for _, child := range SpawnFromTemplate(r, template, world.Transform(id).Pos, seed) {
+			if len(sp.Children) >= sp.MaxChildren {
+				world.RemoveEntity(child)
+				continue
+			}
+			sp.Children = append(sp.Children, child)
+		}
+	}
+}
+
+// encounterSourceDestroyed reports whether any spawner or boss in the
+// encounter has been destroyed.
+func encounterSourceDestroyed(w *World, ids []EntityID) bool {
+	for _, id := range ids {
+		if (w.Spawner(id) != nil || w.Boss(id) != nil) && w.DestroyedData(id) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// encounterChildren returns the entities spawned by an encounter's spawners
+// and bosses after the encounter started.
+func encounterChildren(w *World, ids []EntityID) []EntityID {
+	var children []EntityID
+	for _, id := range ids {
+		if sp := w.Spawner(id); sp != nil {
+			children = append(children, sp.Children...)
+		}
+		if boss := w.Boss(id); boss != nil {
+			children = append(children, boss.Children...)
+		}
+	}
+	return children
+}
@@ -0,0 +1,106 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSpawnerEmitsChildrenUpToCap(t *testing.T) {
+	room := newCombatTestRoom()
+	template, err := GetEncounter("carrier-group")
+	if err != nil {
+		t.Fatalf("failed to load encounter template: %v", err)
+	}
+	spawned := SpawnFromTemplate(room, template, Vec2{X: 4000, Y: 4000}, 1)
+	if len(spawned) != 1 {
+		t.Fatalf("expected one carrier, got %d", len(spawned))
+	}
+	carrier := spawned[0]
+	if ship := room.World.ShipData(carrier); ship == nil || ship.HP <= 0 {
+		t.Fatal("expected carrier to have ship HP")
+	}
+	sp := room.World.Spawner(carrier)
+	if sp == nil {
+		t.Fatal("expected spawner component")
+	}
+
+	updateSpawners(room)
+	if len(sp.Children) != 0 {
+		t.Fatalf("expected no children before the first interval, got %d", len(sp.Children))
+	}
+
+	for wave := 0; wave < 5; wave++ {
+		room.Now = sp.NextSpawnAt
+		updateSpawners(room)
+	}
+	if len(sp.Children) != sp.MaxChildren {
+		t.Fatalf("expected children to reach the cap of %d, got %d", sp.MaxChildren, len(sp.Children))
+	}
+	missiles := 0
+	room.World.ForEach([]ComponentKey{CompMissile}, func(EntityID) { missiles++ })
+	if missiles != sp.MaxChildren {
+		t.Fatalf("children beyond the cap should be discarded, found %d missiles", missiles)
+	}
+
+	room.World.SetComponent(sp.Children[0], CompDestroyed, &DestroyedComponent{DestroyedAt: room.Now})
+	room.Now = sp.NextSpawnAt
+	updateSpawners(room)
+	if len(sp.Children) != sp.MaxChildren {
+		t.Fatalf("expected a lost child to be replaced, got %d children", len(sp.Children))
+	}
+}
+
+func TestDestroyingSpawnerEndsEncounter(t *testing.T) {
+	room := newCombatTestRoom()
+	director := &BeaconDirector{
+		beacons: []BeaconLayout{{
+			ID:         "beacon-1",
+			Normalized: Vec2{X: 0.5, Y: 0.5},
+			Radius:     650,
+			Seed:       7,
+		}},
+		encounters:         make(map[string]*EncounterState),
+		encounterCooldowns: make(map[string]float64),
+		rng:                rand.New(rand.NewSource(1)),
+		spec:               MissionSpec{EncounterTimeout: 120},
+	}
+	template, err := GetEncounter("carrier-group")
+	if err != nil {
+		t.Fatalf("failed to load encounter template: %v", err)
+	}
+	director.spawnEncounterFromTemplate(room, "carrier-group", 0, &director.beacons[0], template, SpawnRule{})
+	if len(director.encounters) != 1 {
+		t.Fatalf("expected one encounter, got %d", len(director.encounters))
+	}
+	var carrier EntityID
+	for _, enc := range director.encounters {
+		carrier = enc.EntityIDs[0]
+	}
+	sp := room.World.Spawner(carrier)
+	room.Now = sp.NextSpawnAt
+	updateSpawners(room)
+	children := append([]EntityID(nil), sp.Children...)
+	if len(children) == 0 {
+		t.Fatal("expected the carrier to launch children")
+	}
+
+	room.Players["pilot"] = &Player{ID: "pilot"}
+	room.damageShip(carrier, room.World.ShipData(carrier).HP, "pilot", nil)
+	if room.Players["pilot"].Kills != 1 {
+		t.Fatal("expected the attacker to be credited with the carrier")
+	}
+	director.pendingEncounters = nil
+	director.pruneExpiredEncounters(room)
+
+	if len(director.encounters) != 0 {
+		t.Fatal("expected the encounter to end with its spawner")
+	}
+	if len(director.pendingEncounters) != 1 || director.pendingEncounters[0].Type != EncounterDeltaSourceDestroyed {
+		t.Fatalf("expected a source destroyed delta, got %+v", director.pendingEncounters)
+	}
+	for _, child := range children {
+		if room.World.Exists(child) {
+			t.Fatalf("expected child %d to be removed with the encounter", child)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package game
+
+import (
+	"log"
+	"maps"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// storyTimer starts a story node for a player once the room clock reaches At.
+type storyTimer struct {
+	PlayerID string
+	Node     dag.NodeID
+	At       float64
+}
+
+// applyStoryEffectsLocked applies the effects of a story node or dialogue
+// choice to the player and room. source names the node or choice in logs.
+func (r *Room) applyStoryEffectsLocked(p *Player, source string, effects []dag.StoryEffect) {
+	if r == nil || p == nil {
+		return
+	}
+	// A co-op party applies room-wide effects once, not once per member.
+	shared := r.coop == nil || r.coop.claim(source)
+	for _, effect := range effects {
+		if !shared && partyWideEffect(effect.Type) {
+			continue
+		}
+		switch effect.Type {
+		case dag.StoryEffectSetFlag:
+			p.EnsureStoryState()
+			p.StoryFlags[effect.Flag] = true
+		case dag.StoryEffectClearFlag:
+			p.EnsureStoryState()
+			delete(p.StoryFlags, effect.Flag)
+		case dag.StoryEffectGrantItem:
+			p.EnsureInventory()
+			p.Inventory.AddItem(effect.Item, effect.VariantID, effect.HeatCapacity, effect.Count)
+		case dag.StoryEffectRemoveItem:
+			p.EnsureInventory()
+			p.Inventory.RemoveItems(effect.Item, effect.VariantID, effect.Count)
+		case dag.StoryEffectGrantUpgrade:
+			r.grantUpgradeToPlayer(p, effect.Node)
+		case dag.StoryEffectSpawnEncounter:
+			director := r.missionDirector
+			if director == nil {
+				director = r.EnsureBeaconDirectorLocked("")
+			}
+			if director == nil {
+				log.Printf("[story] Cannot spawn encounter for %s: no beacon director", source)
+				continue
+			}
+			beaconID := ""
+			if effect.Beacon > 0 && effect.Beacon <= len(director.beacons) {
+				beaconID = director.beacons[effect.Beacon-1].ID
+			}
+			director.launchEncounter(r, beaconID, effect.Wave)
+		case dag.StoryEffectHeatParams:
+			r.setHeatScaleLocked(effect.Heat)
+		case dag.StoryEffectStartTimer:
+			r.storyTimers = append(r.storyTimers, storyTimer{PlayerID: p.ID, Node: effect.Node, At: r.Now + effect.Seconds})
+		case dag.StoryEffectAddObjective:
+			if r.missionDirector == nil {
+				log.Printf("[story] Cannot add objective %s for %s: no beacon director", effect.Objective, source)
+				continue
+			}
+			r.missionDirector.addStoryObjective(r, effect)
+		case dag.StoryEffectRemoveObjective:
+			if r.missionDirector != nil {
+				r.missionDirector.removeObjective(r, effect.Objective)
+			}
+		default:
+			log.Printf("[story] Unknown effect %q on %s", effect.Type, source)
+		}
+	}
+}
+
+// scaleHeatParams multiplies the named heat params.
+func scaleHeatParams(params HeatParams, scales map[string]float64) HeatParams {
+	for name, scale := range scales {
+		switch name {
+		case "max":
+			params.Max *= scale
+		case "warnAt":
+			params.WarnAt *= scale
+		case "overheatAt":
+			params.OverheatAt *= scale
+		case "stallSeconds":
+			params.StallSeconds *= scale
+		case "markerSpeed":
+			params.MarkerSpeed *= scale
+		case "kUp":
+			params.KUp *= scale
+		case "kDown":
+			params.KDown *= scale
+		}
+	}
+	return params
+}
+
+// setHeatScaleLocked replaces the room's story heat multipliers. Every heat
+// entity keeps its own params, rescaled from the old multipliers to the new
+// ones, so repeated effects scale the unmodified params instead of compounding.
+func (r *Room) setHeatScaleLocked(scales map[string]float64) {
+	ratio := make(map[string]float64, len(scales)+len(r.heatScale))
+	for name, old := range r.heatScale {
+		if old != 0 {
+			ratio[name] = 1 / old
+		}
+	}
+	for name, scale := range scales {
+		if old, ok := r.heatScale[name]; ok && old != 0 {
+			ratio[name] = scale / old
+		} else {
+			ratio[name] = scale
+		}
+	}
+	r.heatScale = maps.Clone(scales)
+	r.World.ForEach([]ComponentKey{CompHeat}, func(id EntityID) {
+		if heat := r.World.HeatData(id); heat != nil {
+			heat.P = scaleHeatParams(heat.P, ratio)
+			heat.S.Value = Clamp(heat.S.Value, 0, heat.P.Max)
+		}
+	})
+}
+
+// updateStoryTimers starts the story nodes of timers that have run out.
+func (r *Room) updateStoryTimers() {
+	if len(r.storyTimers) == 0 {
+		return
+	}
+	pending := r.storyTimers[:0]
+	var due []storyTimer
+	for _, timer := range r.storyTimers {
+		if r.Now >= timer.At {
+			due = append(due, timer)
+		} else {
+			pending = append(pending, timer)
+		}
+	}
+	r.storyTimers = pending
+	for _, timer := range due {
+		r.tryStartStoryNodeLocked(r.Players[timer.PlayerID], timer.Node)
+	}
+}
+
+// addStoryObjective adds the objective an add_objective effect describes and
+// tells every player about it.
+func (d *BeaconDirector) addStoryObjective(r *Room, effect dag.StoryEffect) {
+	var evaluator ObjectiveEvaluator
+	switch effect.ObjectiveType {
+	case dag.StoryObjectiveKill:
+		evaluator = &KillCountEvaluator{TargetTag: effect.Tag, RequiredKills: effect.Count}
+	case dag.StoryObjectiveSurvive:
+		evaluator = &TimerEvaluator{StartTime: r.Now, RequiredTime: effect.Seconds, Identifier: effect.Objective}
+	case dag.StoryObjectiveReach:
+		if effect.Beacon > len(d.beacons) {
+			log.Printf("[story] Objective %s names beacon %d of %d", effect.Objective, effect.Beacon, len(d.beacons))
+			return
+		}
+		evaluator = d.reachEvaluator(r, &d.beacons[effect.Beacon-1], effect.Objective)
+	default:
+		return
+	}
+	if d.ActiveObjectives == nil {
+		d.ActiveObjectives = make(map[string]ObjectiveEvaluator)
+	}
+	if d.ObjectiveProgress == nil {
+		d.ObjectiveProgress = make(map[string]float64)
+	}
+	d.ActiveObjectives[effect.Objective] = evaluator
+	d.ObjectiveProgress[effect.Objective] = 0
+	for _, p := range r.Players {
+		if p != nil && !p.IsBot {
+			r.BroadcastObjectiveProgress(p, effect.Objective, 0)
+		}
+	}
+}
+
+// removeObjective drops an objective. Dropping the last one completes the
+// mission, as if it had been achieved.
+func (d *BeaconDirector) removeObjective(r *Room, objID string) {
+	if _, ok := d.ActiveObjectives[objID]; !ok {
+		return
+	}
+	delete(d.ActiveObjectives, objID)
+	delete(d.ObjectiveProgress, objID)
+	if len(d.ActiveObjectives) == 0 {
+		d.failureChecks = nil
+	}
+	for _, p := range r.Players {
+		if p == nil || p.IsBot {
+			continue
+		}
+		if len(d.ActiveObjectives) == 0 {
+			r.BroadcastObjectiveComplete(p, objID)
+		} else {
+			r.BroadcastObjectiveProgress(p, objID, 0)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package game
+
+import (
+	"testing"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+func TestStoryEffectsApplyToPlayerAndRoom(t *testing.T) {
+	initTestDAG(t)
+	room, director, player := failureRoom(t, MissionTemplate{
+		ID:              "test-effects",
+		DisplayName:     "Effects",
+		Archetype:       ArchetypeKill,
+		ObjectiveParams: map[string]interface{}{"requiredKills": 5, "targetTag": "drone"},
+	})
+	player.EnsureDagState()
+	player.EnsureStoryState()
+	player.EnsureInventory()
+	player.StoryFlags["test.old"] = true
+	player.Inventory.AddItem("missile", "basic", 60, 3)
+	baseKUp := room.HeatParamsLocked().KUp
+
+	room.applyStoryEffectsLocked(player, "test", []dag.StoryEffect{
+		{Type: dag.StoryEffectSetFlag, Flag: "test.new"},
+		{Type: dag.StoryEffectClearFlag, Flag: "test.old"},
+		{Type: dag.StoryEffectGrantItem, Item: "missile", VariantID: "long_range", HeatCapacity: 80, Count: 2},
+		{Type: dag.StoryEffectRemoveItem, Item: "missile", VariantID: "basic", Count: 2},
+		{Type: dag.StoryEffectHeatParams, Heat: map[string]float64{"kUp": 2}},
+		{Type: dag.StoryEffectAddObjective, Objective: "hold-out", ObjectiveType: dag.StoryObjectiveSurvive, Seconds: 30},
+		{Type: dag.StoryEffectStartTimer, Seconds: 10, Node: "story.signal-static-1.start"},
+	})
+
+	if !player.StoryFlags["test.new"] || player.StoryFlags["test.old"] {
+		t.Fatalf("expected flags to be set and cleared, got %v", player.StoryFlags)
+	}
+	if got := player.Inventory.GetItemCount("missile", "long_range"); got != 2 {
+		t.Fatalf("expected 2 granted missiles, got %d", got)
+	}
+	if got := player.Inventory.GetItemCount("missile", "basic"); got != 1 {
+		t.Fatalf("expected 1 basic missile left, got %d", got)
+	}
+	if got := room.HeatParamsLocked().KUp; got != baseKUp*2 {
+		t.Fatalf("expected kUp to double from %.1f, got %.1f", baseKUp, got)
+	}
+	if _, ok := director.ActiveObjectives["hold-out"]; !ok {
+		t.Fatalf("expected the survive objective to be added, got %v", director.ActiveObjectives)
+	}
+
+	room.Now += 5
+	room.updateStoryTimers()
+	if player.ActiveStoryNodeID != "" {
+		t.Fatalf("expected the timer to still be running, got %s", player.ActiveStoryNodeID)
+	}
+	room.Now += 5
+	room.updateStoryTimers()
+	if player.ActiveStoryNodeID != "story.signal-static-1.start" {
+		t.Fatalf("expected the timer to start its node, got %s", player.ActiveStoryNodeID)
+	}
+
+	room.applyStoryEffectsLocked(player, "test", []dag.StoryEffect{
+		{Type: dag.StoryEffectRemoveObjective, Objective: "hold-out"},
+		{Type: dag.StoryEffectRemoveObjective, Objective: "kill-drone"},
+	})
+	if len(director.ActiveObjectives) != 0 {
+		t.Fatalf("expected the objectives to be removed, got %v", director.ActiveObjectives)
+	}
+	if update := lastMissionUpdate(player); update == nil || update.Status != "completed" {
+		t.Fatalf("expected removing the last objective to complete the mission, got %+v", update)
+	}
+}
+
+func TestStoryChoiceAppliesChoiceEffects(t *testing.T) {
+	nodes := []*dag.Node{
+		{
+			ID:        "story.test.ask",
+			Kind:      dag.NodeKindStory,
+			Label:     "Ask",
+			DurationS: 999999,
+			Dialogue: &dag.DialogueContent{
+				Choices: []dag.DialogueChoice{
+					{ID: "help", Effects: []dag.StoryEffect{{Type: dag.StoryEffectSetFlag, Flag: "test.helped"}}, Next: "story.test.thanks"},
+					{ID: "leave", Effects: []dag.StoryEffect{{Type: dag.StoryEffectSetFlag, Flag: "test.left"}}},
+				},
+			},
+		},
+		{
+			ID:           "story.test.thanks",
+			Kind:         dag.NodeKindStory,
+			Label:        "Thanks",
+			DurationS:    999999,
+			Requires:     []dag.NodeID{"story.test.ask"},
+			StoryEffects: []dag.StoryEffect{{Type: dag.StoryEffectGrantItem, Item: "missile", VariantID: "basic", Count: 1}},
+		},
+	}
+	if err := dag.Init(nodes); err != nil {
+		t.Fatalf("failed to init DAG: %v", err)
+	}
+	room := newCombatTestRoom()
+	player := &Player{ID: "p1"}
+	room.Players[player.ID] = player
+	player.EnsureDagState()
+	player.EnsureStoryState()
+	player.EnsureInventory()
+
+	graph := dag.GetGraph()
+	room.tryStartStoryNodeLocked(player, "story.test.ask")
+	if err := dag.Complete(graph, player.DagState, "story.test.ask", NewRoomDagEffects(room, player)); err != nil {
+		t.Fatalf("completing the question failed: %v", err)
+	}
+	room.HandleStoryChoiceBranching(player, "story.test.ask", "help", graph)
+
+	if !player.StoryFlags["test.helped"] || player.StoryFlags["test.left"] {
+		t.Fatalf("expected only the picked choice's effects, got %v", player.StoryFlags)
+	}
+	if player.ActiveStoryNodeID != "story.test.thanks" {
+		t.Fatalf("expected the choice to lead to its node, got %s", player.ActiveStoryNodeID)
+	}
+	if got := player.Inventory.GetItemCount("missile", "basic"); got != 1 {
+		t.Fatalf("expected the follow-up node's effects to apply, got %d missiles", got)
+	}
+}
+
+func TestStoryHeatEffectScalesEachEntityOnce(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "p1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+	if err := room.SetPlayerHullLocked(player, "interceptor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	shipKUp := room.World.HeatData(player.Ship).P.KUp
+	missile := room.World.NewEntity()
+	missileHeat := &HeatComponent{P: HeatParams{Max: 50, MarkerSpeed: 300, KUp: 9}}
+	room.World.SetComponent(missile, CompHeat, missileHeat)
+	baseKUp := room.HeatParamsLocked().KUp
+
+	double := []dag.StoryEffect{{Type: dag.StoryEffectHeatParams, Heat: map[string]float64{"kUp": 2}}}
+	room.applyStoryEffectsLocked(player, "test", double)
+	room.applyStoryEffectsLocked(player, "test", double)
+
+	if got := room.HeatParamsLocked().KUp; got != baseKUp*2 {
+		t.Fatalf("expected repeated effects to double kUp once, %.2f -> %.2f", baseKUp, got)
+	}
+	if got := room.World.HeatData(player.Ship).P.KUp; got != shipKUp*2 {
+		t.Fatalf("expected the hull's own kUp to double, %.2f -> %.2f", shipKUp, got)
+	}
+	if missileHeat.P.KUp != 18 || missileHeat.P.Max != 50 {
+		t.Fatalf("expected the missile's own params to be scaled, got %+v", missileHeat.P)
+	}
+
+	room.applyStoryEffectsLocked(player, "test", []dag.StoryEffect{{Type: dag.StoryEffectHeatParams, Heat: map[string]float64{"max": 0.5}}})
+	if missileHeat.P.KUp != 9 || missileHeat.P.Max != 25 {
+		t.Fatalf("expected a new effect to replace the old multipliers, got %+v", missileHeat.P)
+	}
+}
@@ -0,0 +1,174 @@
+package game
+
+import (
+	"fmt"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+// Mission events that story triggers react to.
+const (
+	MissionEventStart             = "mission:start"
+	MissionEventBeaconLocked      = "mission:beacon-locked"
+	MissionEventEncounterCleared  = "mission:encounter-cleared"
+	MissionEventShipDestroyed     = "mission:ship-destroyed"
+	MissionEventObjectiveComplete = "mission:objective-complete"
+	MissionEventHeatStall         = "mission:heat-stall"
+	MissionEventCompleted         = "mission:completed"
+	MissionEventFailed            = "mission:failed"
+)
+
+// Targets of mission:ship-destroyed events
+const (
+	ShipDestroyedPlayer  = "player"  // The player's own ship
+	ShipDestroyedWingman = "wingman" // One of the player's wingmen
+	ShipDestroyedConvoy  = "convoy"  // The escort convoy
+	ShipDestroyedMission = "mission" // A ship spawned by the mission
+)
+
+var missionEventTypes = map[string]bool{
+	MissionEventStart:             true,
+	MissionEventBeaconLocked:      true,
+	MissionEventEncounterCleared:  true,
+	MissionEventShipDestroyed:     true,
+	MissionEventObjectiveComplete: true,
+	MissionEventHeatStall:         true,
+	MissionEventCompleted:         true,
+	MissionEventFailed:            true,
+}
+
+// MissionEvent is something that happened during a mission that story
+// triggers can react to.
+type MissionEvent struct {
+	Type   string
+	Beacon int    // 1-based ordinal of the beacon involved, or 0
+	Target string // Encounter ID, objective ID or ship-destroyed target
+}
+
+// StoryTrigger starts Node for a player when a mission event matches it and
+// the player's story flags meet its conditions.
+type StoryTrigger struct {
+	Event         string
+	Beacon        int    // Beacon ordinal to match; 0 matches any
+	Target        string // Target to match; empty matches any
+	RequiresFlags []string
+	ExcludesFlags []string
+	Node          dag.NodeID
+}
+
+// Validate checks the trigger's event and node.
+func (t StoryTrigger) Validate() error {
+	if !missionEventTypes[t.Event] {
+		return fmt.Errorf("unknown mission event: %s", t.Event)
+	}
+	if t.Beacon < 0 {
+		return fmt.Errorf("trigger for %s has negative beacon %d", t.Event, t.Beacon)
+	}
+	if t.Node == "" {
+		return fmt.Errorf("trigger for %s has no node", t.Event)
+	}
+	return nil
+}
+
+func (t *StoryTrigger) matches(p *Player, ev MissionEvent) bool {
+	if t.Event != ev.Type {
+		return false
+	}
+	if t.Beacon != 0 && t.Beacon != ev.Beacon {
+		return false
+	}
+	if t.Target != "" && t.Target != ev.Target {
+		return false
+	}
+	for _, flag := range t.RequiresFlags {
+		if !p.StoryFlags[flag] {
+			return false
+		}
+	}
+	for _, flag := range t.ExcludesFlags {
+		if p.StoryFlags[flag] {
+			return false
+		}
+	}
+	return true
+}
+
+// storyTriggersLocked returns the triggers of the room's current mission. Rooms
+// without a mission director use the first mission of the default campaign.
+func (r *Room) storyTriggersLocked() []StoryTrigger {
+	if tmpl := r.missionDirector.MissionTemplate(); tmpl != nil {
+		return tmpl.StoryTriggers
+	}
+	id, ok := CampaignMissionID(DefaultCampaignID, 1)
+	if !ok {
+		return nil
+	}
+	tmpl, err := GetTemplate(id)
+	if err != nil {
+		return nil
+	}
+	return tmpl.StoryTriggers
+}
+
+// HandleMissionEventLocked starts every story node whose trigger matches the
+// event for the player. Nodes that are not available are skipped.
+func (r *Room) HandleMissionEventLocked(p *Player, ev MissionEvent) {
+	if r == nil || p == nil || p.IsBot {
+		return
+	}
+	triggers := r.storyTriggersLocked()
+	for i := range triggers {
+		if triggers[i].matches(p, ev) {
+			r.tryStartStoryNodeLocked(p, triggers[i].Node)
+		}
+	}
+}
+
+// broadcastMissionEventLocked handles the event for every human player.
+func (r *Room) broadcastMissionEventLocked(ev MissionEvent) {
+	for _, p := range r.Players {
+		if p != nil && !p.IsBot {
+			r.HandleMissionEventLocked(p, ev)
+		}
+	}
+}
+
+// missionShipDestroyedLocked reports a destroyed non-player ship: wingmen to
+// their leader, convoys and mission ships to everyone.
+func (r *Room) missionShipDestroyedLocked(id EntityID, owner *OwnerComponent) {
+	switch {
+	case r.World.Wingman(id) != nil:
+		r.HandleMissionEventLocked(r.Players[owner.side()], MissionEvent{Type: MissionEventShipDestroyed, Target: ShipDestroyedWingman})
+	case r.World.Convoy(id) != nil:
+		r.broadcastMissionEventLocked(MissionEvent{Type: MissionEventShipDestroyed, Target: ShipDestroyedConvoy})
+	case owner.PlayerID == missionOwnerID:
+		r.broadcastMissionEventLocked(MissionEvent{Type: MissionEventShipDestroyed, Target: ShipDestroyedMission})
+	}
+}
+
+// beaconOrdinal returns the 1-based ordinal of the beacon, or 0 if unknown.
+func (d *BeaconDirector) beaconOrdinal(beaconID string) int {
+	for _, beacon := range d.beacons {
+		if beacon.ID == beaconID {
+			return beacon.Ordinal + 1
+		}
+	}
+	return 0
+}
+
+// observeHeatStalls reports a heat stall event if the player's ship started
+// stalling since the last tick.
+func (d *BeaconDirector) observeHeatStalls(r *Room, p *Player) {
+	heat := r.World.HeatData(p.Ship)
+	if heat == nil {
+		return
+	}
+	if d.stallSeen == nil {
+		d.stallSeen = make(map[string]float64)
+	}
+	last, seen := d.stallSeen[p.ID]
+	d.stallSeen[p.ID] = heat.S.StallUntil
+	if seen && heat.S.StallUntil > last && heat.S.StallUntil > r.Now {
+		r.HandleMissionEventLocked(p, MissionEvent{Type: MissionEventHeatStall})
+	}
+}
@@ -0,0 +1,127 @@
+package game
+
+import (
+	"testing"
+
+	"LightSpeedDuel/internal/dag"
+)
+
+func TestStoryTriggersMatchEventsAndFlags(t *testing.T) {
+	initTestDAG(t)
+	room, director, player := failureRoom(t, MissionTemplate{
+		ID:              "test-triggers",
+		DisplayName:     "Triggers",
+		Archetype:       ArchetypeKill,
+		ObjectiveParams: map[string]interface{}{"requiredKills": 5},
+		StoryTriggers: []StoryTrigger{
+			{Event: MissionEventEncounterCleared, Target: "drone-patrol", ExcludesFlags: []string{"test.hostile"}, Node: "story.signal-static-1.beacon-1-lock"},
+			{Event: MissionEventHeatStall, RequiresFlags: []string{"test.hostile"}, Node: "story.signal-static-1.beacon-2-lock"},
+		},
+	})
+	player.EnsureDagState()
+	player.EnsureStoryState()
+	status := func(id dag.NodeID) dag.Status { return player.DagState.GetStatus(id) }
+
+	room.HandleMissionEventLocked(player, MissionEvent{Type: MissionEventEncounterCleared, Target: "other"})
+	if status("story.signal-static-1.beacon-1-lock") == dag.StatusInProgress {
+		t.Fatal("expected a trigger for another encounter not to fire")
+	}
+	room.HandleMissionEventLocked(player, MissionEvent{Type: MissionEventBeaconLocked, Beacon: 1})
+	if status("story.signal-static-1.beacon-1-lock") == dag.StatusInProgress {
+		t.Fatal("expected campaign-1's triggers not to apply to another mission")
+	}
+	room.HandleMissionEventLocked(player, MissionEvent{Type: MissionEventEncounterCleared, Target: "drone-patrol"})
+	if status("story.signal-static-1.beacon-1-lock") != dag.StatusInProgress {
+		t.Fatal("expected clearing the encounter to start its story node")
+	}
+
+	heat := room.World.HeatData(player.Ship)
+	director.Tick(room)
+	room.Now += 1
+	heat.S.StallUntil = room.Now + heat.P.StallSeconds
+	director.Tick(room)
+	if status("story.signal-static-1.beacon-2-lock") == dag.StatusInProgress {
+		t.Fatal("expected the stall trigger to need its flag")
+	}
+	player.StoryFlags["test.hostile"] = true
+	room.Now += 5
+	heat.S.StallUntil = room.Now + heat.P.StallSeconds
+	director.Tick(room)
+	if status("story.signal-static-1.beacon-2-lock") != dag.StatusInProgress {
+		t.Fatal("expected a heat stall to start its story node once flagged")
+	}
+}
+
+func TestAcceptMissionStartsStoryNode(t *testing.T) {
+	initTestDAG(t)
+	room := newCombatTestRoom()
+	director := room.EnsureBeaconDirectorLocked("campaign-1")
+	player := &Player{ID: "player-1"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 100, Y: 100})
+	player.EnsureDagState()
+	player.EnsureStoryState()
+
+	if player.ActiveStoryNodeID != "" {
+		t.Fatalf("expected no story before the mission is accepted, got %s", player.ActiveStoryNodeID)
+	}
+	if err := director.AcceptMission(room, player, "campaign-1"); err != nil {
+		t.Fatalf("failed to accept mission: %v", err)
+	}
+	if player.ActiveStoryNodeID != "story.signal-static-1.start" {
+		t.Fatalf("expected accepting the mission to start its story, got %q", player.ActiveStoryNodeID)
+	}
+}
+
+func TestStoryChoiceWithoutOutcomeStartsNothing(t *testing.T) {
+	initTestDAG(t)
+	room := newCombatTestRoom()
+	player := &Player{ID: "player-1"}
+	room.Players[player.ID] = player
+	player.EnsureDagState()
+	player.EnsureStoryState()
+
+	room.HandleMissionEventLocked(player, MissionEvent{Type: MissionEventStart})
+	parentID := dag.NodeID("story.signal-static-1.start")
+	if player.ActiveStoryNodeID != string(parentID) {
+		t.Fatalf("expected the start node active, got %s", player.ActiveStoryNodeID)
+	}
+	graph := dag.GetGraph()
+	if err := dag.Complete(graph, player.DagState, parentID, NewRoomDagEffects(room, player)); err != nil {
+		t.Fatalf("completing start node failed: %v", err)
+	}
+
+	// "investigate" declares no outcome, so no node named after it may start.
+	room.HandleStoryChoiceBranching(player, parentID, "investigate", graph)
+	if player.ActiveStoryNodeID != "" && player.ActiveStoryNodeID != string(parentID) {
+		t.Fatalf("expected no follow-up node, got %s", player.ActiveStoryNodeID)
+	}
+}
+
+func TestCheckContentReferencesStoryTriggers(t *testing.T) {
+	initTestDAG(t)
+	reg := currentRegistries().clone()
+	reg.templates["test-triggers"] = MissionTemplate{
+		ID:          "test-triggers",
+		DisplayName: "Triggers",
+		StoryTriggers: []StoryTrigger{
+			{Event: MissionEventStart, Node: "no.such.node"},
+			{Event: MissionEventCompleted, Node: "upgrade.missile.speed_1"},
+		},
+	}
+	issues := reg.check(dag.GetGraph(), map[string]string{"mission:test-triggers": "triggers.json"})
+	var found int
+	for _, issue := range issues {
+		if issue.Source == "triggers.json" {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Fatalf("expected two trigger issues, got %v", issues)
+	}
+
+	bad := StoryTrigger{Event: "mission:exploded", Node: "story.signal-static-1.start"}
+	if err := bad.Validate(); err == nil {
+		t.Fatal("expected an unknown event to be rejected")
+	}
+}
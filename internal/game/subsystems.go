@@ -0,0 +1,215 @@
+package game
+
+import (
+	"fmt"
+	"math"
+)
+
+// Subsystem identifies a damageable ship system.
+type Subsystem int
+
+const (
+	// SubsystemEngines limits the ship's top speed.
+	SubsystemEngines Subsystem = iota
+	// SubsystemSensors limits how far the ship can perceive other entities.
+	SubsystemSensors
+	// SubsystemLaunchers stretches the missile launch cooldown.
+	SubsystemLaunchers
+	// SubsystemRadiators slows heat dissipation below the marker speed.
+	SubsystemRadiators
+	// SubsystemHull is the ship's structure. It drives no mechanic; the ship
+	// is destroyed when its integrity runs out. Repairs don't patch it.
+	SubsystemHull
+
+	subsystemCount
+)
+
+// hullEpsilon absorbs float error when hits of 1/MaxHP wear the hull down.
+const hullEpsilon = 1e-9
+
+func (s Subsystem) String() string {
+	switch s {
+	case SubsystemEngines:
+		return "engines"
+	case SubsystemSensors:
+		return "sensors"
+	case SubsystemLaunchers:
+		return "launchers"
+	case SubsystemRadiators:
+		return "radiators"
+	case SubsystemHull:
+		return "hull"
+	default:
+		return "unknown"
+	}
+}
+
+// Subsystems returns every damageable subsystem in display order.
+func Subsystems() []Subsystem {
+	out := make([]Subsystem, 0, subsystemCount)
+	for s := Subsystem(0); s < subsystemCount; s++ {
+		out = append(out, s)
+	}
+	return out
+}
+
+// Repair kit inventory item produced by the repair craft node.
+const (
+	RepairKitItemType  = "repair_kit"
+	RepairKitVariantID = "standard"
+)
+
+// newSubsystems returns a component with every subsystem fully operational.
+func newSubsystems() *SubsystemsComponent {
+	s := &SubsystemsComponent{}
+	s.Restore()
+	return s
+}
+
+// Restore returns every subsystem to full health.
+func (s *SubsystemsComponent) Restore() {
+	for i := range s.Health {
+		s.Health[i] = 1
+	}
+	s.RepairAfter = 0
+}
+
+// Efficiency returns the output multiplier of a subsystem. A destroyed
+// subsystem still runs at SubsystemMinEfficiency so a crippled ship can limp.
+func (s *SubsystemsComponent) Efficiency(sys Subsystem) float64 {
+	if s == nil || sys < 0 || sys >= subsystemCount {
+		return 1
+	}
+	health := Clamp(s.Health[sys], 0, 1)
+	return SubsystemMinEfficiency + (1-SubsystemMinEfficiency)*health
+}
+
+// Integrity returns the hull's remaining structure, from 0 to 1.
+func (s *SubsystemsComponent) Integrity() float64 {
+	if s == nil {
+		return 1
+	}
+	return Clamp(s.Health[SubsystemHull], 0, 1)
+}
+
+// Damaged reports whether any repairable subsystem is below full health.
+func (s *SubsystemsComponent) Damaged() bool {
+	if s == nil {
+		return false
+	}
+	for i, h := range s.Health {
+		if Subsystem(i) != SubsystemHull && h < 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// damage removes health from a subsystem and holds off passive repair.
+func (s *SubsystemsComponent) damage(sys Subsystem, amount float64, now float64) {
+	if s == nil || sys < 0 || sys >= subsystemCount {
+		return
+	}
+	s.Health[sys] = Clamp(s.Health[sys]-amount, 0, 1)
+	s.RepairAfter = now + SubsystemRepairDelay
+}
+
+// repair adds health to every subsystem but the hull, capped at full health.
+func (s *SubsystemsComponent) repair(amount float64) {
+	for i := range s.Health {
+		if Subsystem(i) != SubsystemHull {
+			s.Health[i] = Clamp(s.Health[i]+amount, 0, 1)
+		}
+	}
+}
+
+// hullHP converts hull integrity into whole hit points.
+func hullHP(integrity float64, maxHP int) int {
+	return max(int(math.Ceil(integrity*float64(maxHP)-hullEpsilon)), 0)
+}
+
+// SubsystemEfficiency returns the output multiplier of a subsystem on an
+// entity. Entities without subsystems always run at full efficiency.
+func SubsystemEfficiency(world *World, id EntityID, sys Subsystem) float64 {
+	return world.Subsystems(id).Efficiency(sys)
+}
+
+// SensorRange returns how far the entity can perceive other ships and
+// missiles given the state of its sensors.
+func SensorRange(world *World, id EntityID) float64 {
+	if world.Subsystems(id) == nil {
+		return math.Inf(1)
+	}
+	return SensorMaxRange * SubsystemEfficiency(world, id, SubsystemSensors)
+}
+
+// damageShip wears down a ship's hull by damage/MaxHP, knocks out a random
+// other subsystem and rolls a heat spike. The ship is destroyed when its hull
+// integrity runs out; ships without subsystems fall back to counting HP.
+func (r *Room) damageShip(shipID EntityID, damage int, attackerID string, rng func() float64) {
+	if damage <= 0 {
+		return
+	}
+	world := r.World
+	shipData := world.ShipData(shipID)
+	subs := world.Subsystems(shipID)
+	if subs != nil && rng != nil {
+		sys := Subsystem(int(rng()*float64(SubsystemHull)) % int(SubsystemHull))
+		subs.damage(sys, SubsystemHitDamage*float64(damage), r.Now)
+	}
+	destroyed := false
+	switch {
+	case shipData == nil:
+	case subs != nil && shipData.MaxHP > 0:
+		subs.damage(SubsystemHull, float64(damage)/float64(shipData.MaxHP), r.Now)
+		shipData.HP = hullHP(subs.Integrity(), shipData.MaxHP)
+		destroyed = subs.Integrity() <= hullEpsilon
+	default:
+		shipData.HP -= damage
+		destroyed = shipData.HP <= 0
+	}
+	if destroyed {
+		r.handleShipDestruction(shipID, attackerID)
+	}
+	if heat := world.HeatData(shipID); heat != nil {
+		ApplyMissileHeatSpike(heat, r.Now, rng)
+	}
+}
+
+// updateSubsystemRepair slowly restores damaged subsystems once a ship has
+// gone SubsystemRepairDelay seconds without taking a hit.
+func updateSubsystemRepair(r *Room, dt float64) {
+	world := r.World
+	world.ForEach([]ComponentKey{CompShip, CompSubsystems}, func(id EntityID) {
+		if world.DestroyedData(id) != nil {
+			return
+		}
+		subs := world.Subsystems(id)
+		if subs == nil || r.Now < subs.RepairAfter {
+			return
+		}
+		subs.repair(SubsystemRepairRate * dt)
+	})
+}
+
+// UseRepairKitLocked consumes a repair kit from the player's inventory and
+// restores RepairKitAmount health to every subsystem but the hull. Callers
+// must hold r.Mu.
+func (r *Room) UseRepairKitLocked(p *Player) error {
+	if p == nil {
+		return fmt.Errorf("nil player")
+	}
+	subs := r.World.Subsystems(p.Ship)
+	if subs == nil {
+		return fmt.Errorf("player %s has no ship", p.ID)
+	}
+	if !subs.Damaged() {
+		return fmt.Errorf("ship subsystems are not damaged")
+	}
+	p.EnsureInventory()
+	if !p.Inventory.RemoveItem(RepairKitItemType, RepairKitVariantID, 0, 1) {
+		return fmt.Errorf("no repair kits in inventory")
+	}
+	subs.repair(RepairKitAmount)
+	return nil
+}
@@ -0,0 +1,138 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+// pickSubsystem returns an rng that selects sys for subsystem damage and
+// skips the heat spike roll.
+func pickSubsystem(sys Subsystem) func() float64 {
+	calls := 0
+	return func() float64 {
+		calls++
+		if calls == 1 {
+			return (float64(sys) + 0.5) / float64(SubsystemHull)
+		}
+		return 1
+	}
+}
+
+func TestDamageShipDegradesSubsystem(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+
+	room.Now = 5
+	room.damageShip(player.Ship, 1, "attacker", pickSubsystem(SubsystemLaunchers))
+
+	if hp := room.World.ShipData(player.Ship).HP; hp != ShipMaxHP-1 {
+		t.Fatalf("expected hull damage, got HP %d", hp)
+	}
+	subs := room.World.Subsystems(player.Ship)
+	if subs.Health[SubsystemLaunchers] != 1-SubsystemHitDamage {
+		t.Fatalf("expected launchers to be damaged, got %.2f", subs.Health[SubsystemLaunchers])
+	}
+	if subs.Health[SubsystemEngines] != 1 {
+		t.Fatalf("expected engines untouched, got %.2f", subs.Health[SubsystemEngines])
+	}
+	base := MissileCooldownForSpeed(0)
+	if got := room.MissileCooldownFor(player, 0); got <= base {
+		t.Fatalf("expected damaged launchers to stretch cooldown beyond %.2f, got %.2f", base, got)
+	}
+}
+
+func TestDamagedEnginesLimitSpeed(t *testing.T) {
+	room := newCombatTestRoom()
+	ship := room.SpawnShip("pilot", Vec2{X: 1000, Y: 1000})
+	room.World.Route(ship).Waypoints = []RouteWaypoint{{Pos: Vec2{X: 5000, Y: 1000}, Speed: ShipMaxSpeed}}
+	room.World.Subsystems(ship).Health[SubsystemEngines] = 0
+
+	updateRouteFollowers(room, Dt)
+
+	want := ShipMaxSpeed * SubsystemMinEfficiency
+	if got := room.World.Transform(ship).Vel.Len(); math.Abs(got-want) > 1e-6 {
+		t.Fatalf("expected crippled engines to cap speed at %.1f, got %.1f", want, got)
+	}
+}
+
+func TestDamagedSensorsShortenRange(t *testing.T) {
+	room := newCombatTestRoom()
+	ship := room.SpawnShip("pilot", Vec2{X: 1000, Y: 1000})
+
+	if got := SensorRange(room.World, ship); got != SensorMaxRange {
+		t.Fatalf("expected full sensor range, got %.1f", got)
+	}
+	room.World.Subsystems(ship).Health[SubsystemSensors] = 0
+	if got := SensorRange(room.World, ship); got != SensorMaxRange*SubsystemMinEfficiency {
+		t.Fatalf("expected reduced sensor range, got %.1f", got)
+	}
+}
+
+func TestSubsystemRepair(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+	subs := room.World.Subsystems(player.Ship)
+
+	room.Now = 10
+	subs.damage(SubsystemRadiators, 0.8, room.Now)
+	damaged := subs.Health[SubsystemRadiators]
+	updateSubsystemRepair(room, 1)
+	if subs.Health[SubsystemRadiators] != damaged {
+		t.Fatalf("passive repair should wait out the delay, got %.2f", subs.Health[SubsystemRadiators])
+	}
+
+	room.Now += SubsystemRepairDelay
+	updateSubsystemRepair(room, 1)
+	if got := subs.Health[SubsystemRadiators]; math.Abs(got-(damaged+SubsystemRepairRate)) > 1e-9 {
+		t.Fatalf("expected passive repair, got %.3f", got)
+	}
+
+	if err := room.UseRepairKitLocked(player); err == nil {
+		t.Fatal("expected error without a repair kit")
+	}
+	player.EnsureInventory()
+	player.Inventory.AddItem(RepairKitItemType, RepairKitVariantID, 0, 1)
+	if err := room.UseRepairKitLocked(player); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := subs.Health[SubsystemRadiators]; math.Abs(got-(damaged+SubsystemRepairRate+RepairKitAmount)) > 1e-9 {
+		t.Fatalf("expected repair kit to restore radiators, got %.3f", got)
+	}
+	if n := player.Inventory.GetItemCount(RepairKitItemType, RepairKitVariantID); n != 0 {
+		t.Fatalf("expected repair kit to be consumed, %d left", n)
+	}
+}
+
+func TestHullIntegrityDecidesDestruction(t *testing.T) {
+	room := newCombatTestRoom()
+	player := &Player{ID: "pilot"}
+	room.Players[player.ID] = player
+	player.Ship = room.SpawnShip(player.ID, Vec2{X: 1000, Y: 1000})
+	ship := room.World.ShipData(player.Ship)
+	subs := room.World.Subsystems(player.Ship)
+
+	room.Now = 5
+	room.damageShip(player.Ship, 1, "attacker", nil)
+	want := 1 - 1/float64(ship.MaxHP)
+	if got := subs.Integrity(); math.Abs(got-want) > 1e-9 || ship.HP != ship.MaxHP-1 {
+		t.Fatalf("expected a hit to wear the hull to %.2f, got %.2f (HP %d)", want, got, ship.HP)
+	}
+
+	// HP only mirrors the hull; the subsystems decide destruction.
+	ship.HP = 99
+	room.damageShip(player.Ship, ship.MaxHP-1, "attacker", nil)
+	if player.Deaths != 1 {
+		t.Fatalf("expected the worn-out hull to destroy the ship, got %d deaths", player.Deaths)
+	}
+
+	room.Now += SubsystemRepairDelay
+	subs.Health[SubsystemHull] = 0.5
+	updateSubsystemRepair(room, 10)
+	if subs.Health[SubsystemHull] != 0.5 {
+		t.Fatalf("expected passive repair to leave the hull alone, got %.3f", subs.Health[SubsystemHull])
+	}
+}
@@ -1,7 +1,5 @@
 package game
 
-import "math/rand"
-
 func updateRouteFollowers(r *Room, dt float64) {
 	world := r.World
 	world.ForEach([]ComponentKey{CompTransform, compMovement, CompRouteFollower, CompRoute}, func(id EntityID) {
@@ -20,7 +18,9 @@ func updateRouteFollowers(r *Room, dt float64) {
 
 		heat := world.HeatData(id)
 		if heat != nil {
-			UpdateHeat(heat, tr.Vel.Len(), dt, r.Now)
+			speed := tr.Vel.Len()
+			cooling := SubsystemEfficiency(world, id, SubsystemRadiators) * radiatorCooling(world.HeatAbilities(id), speed)
+			UpdateHeatWithCooling(heat, speed, dt, r.Now, cooling)
 			if heat.IsStalled(r.Now) {
 				tr.Vel = Vec2{}
 				follower.hasOverride = false
@@ -66,9 +66,10 @@ func updateRouteFollowers(r *Room, dt float64) {
 			target = route.Waypoints[follower.Index]
 		}
 
-		speedLimit := mov.MaxSpeed
+		maxSpeed := mov.MaxSpeed * SubsystemEfficiency(world, id, SubsystemEngines)
+		speedLimit := maxSpeed
 		if target.Speed > 0 {
-			speedLimit = Clamp(target.Speed, 0, mov.MaxSpeed)
+			speedLimit = Clamp(target.Speed, 0, maxSpeed)
 		}
 
 		dir := target.Pos.Sub(tr.Pos)
@@ -111,10 +112,27 @@ func ownersAllied(a, b *OwnerComponent) bool {
 	if a == nil || b == nil {
 		return false
 	}
+	// Mission hazards and gunships fight on the same side
+	if a.PlayerID == missionOwnerID && b.PlayerID == missionOwnerID {
+		return true
+	}
 	if a.Neutral || b.Neutral {
 		return false
 	}
-	return a.PlayerID == b.PlayerID
+	return a.side() == b.side()
+}
+
+// missilesOpposed reports whether two missile owners are on opposing sides.
+// Unlike ownersAllied, two neutral missiles from the same owner (e.g. mission
+// mines) are not considered hostile to each other.
+func missilesOpposed(a, b *OwnerComponent) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	if ownersAllied(a, b) {
+		return false
+	}
+	return a.PlayerID != b.PlayerID
 }
 
 func updateMissileGuidance(r *Room, dt float64) {
@@ -136,7 +154,7 @@ func updateMissileGuidance(r *Room, dt float64) {
 
 		age := r.Now - missile.LaunchTime
 		if age >= missile.Lifetime {
-			world.SetComponent(id, CompDestroyed, &DestroyedComponent{DestroyedAt: r.Now})
+			r.detonateMissile(id, detonateExpiry)
 			follower.hasOverride = false
 			return
 		}
@@ -159,7 +177,7 @@ func updateMissileGuidance(r *Room, dt float64) {
 		}
 
 		if missile.Target != 0 {
-			if world.Exists(missile.Target) {
+			if world.Exists(missile.Target) && world.DestroyedData(missile.Target) == nil {
 				if targetOwner := world.Owner(missile.Target); targetOwner != nil && !ownersAllied(owner, targetOwner) {
 					perceivedDist := PerceivedDistance(tr.Pos, missile.Target, world, r.Now)
 					if perceivedDist <= missile.AgroRadius {
@@ -185,27 +203,40 @@ func updateMissileGuidance(r *Room, dt float64) {
 		}
 
 		if !chasing {
-			world.ForEach([]ComponentKey{CompTransform, CompShip, CompOwner}, func(shipID EntityID) {
-				if chasing {
+			// Interceptors hunt hostile missiles; everything else hunts ships.
+			targetKey := CompShip
+			if missile.Guidance == MissileGuidanceInterceptor {
+				targetKey = CompMissile
+			}
+			acquire := func(targetID EntityID) {
+				if chasing || targetID == id {
+					return
+				}
+				if world.DestroyedData(targetID) != nil {
 					return
 				}
-				if world.DestroyedData(shipID) != nil {
+				targetOwner := world.Owner(targetID)
+				if targetOwner == nil || ownersAllied(owner, targetOwner) {
 					return
 				}
-				shipOwner := world.Owner(shipID)
-				if shipOwner == nil || ownersAllied(owner, shipOwner) {
+				if targetKey == CompMissile && !missilesOpposed(owner, targetOwner) {
 					return
 				}
-				perceivedDist := PerceivedDistance(tr.Pos, shipID, world, r.Now)
+				perceivedDist := PerceivedDistance(tr.Pos, targetID, world, r.Now)
 				if perceivedDist <= missile.AgroRadius {
-					if snap, ok := PerceiveEntity(tr.Pos, shipID, world, r.Now); ok {
+					if snap, ok := PerceiveEntity(tr.Pos, targetID, world, r.Now); ok {
 						chasing = true
 						perceivedTargetPos = snap.Pos
-						missile.Target = shipID
+						missile.Target = targetID
 						missile.ReturnIndex = follower.Index
 					}
 				}
-			})
+			}
+			// Mission seekers go for an escort convoy in range before anything else.
+			if targetKey == CompShip && owner != nil && owner.PlayerID == missionOwnerID {
+				world.ForEach([]ComponentKey{CompTransform, CompShip, CompOwner, CompConvoy}, acquire)
+			}
+			world.ForEach([]ComponentKey{CompTransform, targetKey, CompOwner}, acquire)
 		}
 
 		if chasing {
@@ -233,9 +264,13 @@ func resolveMissileCollisions(r *Room) {
 			return
 		}
 
+		spec := GetWarheadSpec(missile.Warhead)
+
 		hitShip := EntityID(0)
+		hitDist := 0.0
+		hitRadius := MissileHitRadius
 		world.ForEach([]ComponentKey{CompTransform, CompShip, CompOwner}, func(shipID EntityID) {
-			if hitShip != 0 {
+			if hitShip != 0 || missile.Guidance == MissileGuidanceInterceptor {
 				return
 			}
 			if world.DestroyedData(shipID) != nil {
@@ -249,28 +284,64 @@ func resolveMissileCollisions(r *Room) {
 			if !ok {
 				return
 			}
-			if snap.Pos.Sub(tr.Pos).Len() <= MissileHitRadius {
+			contactRadius := shipHitRadius(world.ShipData(shipID))
+			fuseRadius := contactRadius
+			if spec.ProximityRadius > fuseRadius {
+				fuseRadius = spec.ProximityRadius
+			}
+			if dist := snap.Pos.Sub(tr.Pos).Len(); dist <= fuseRadius {
 				hitShip = shipID
+				hitDist = dist
+				hitRadius = contactRadius
 			}
 		})
 
+		if hitShip != 0 && spec.BlastRadius > 0 {
+			trigger := detonateContact
+			if hitDist > hitRadius {
+				trigger = detonateProximity
+			}
+			r.detonateMissile(id, trigger)
+			return
+		}
+
 		if hitShip != 0 {
-			if shipData := world.ShipData(hitShip); shipData != nil {
-				shipData.HP--
-				if shipData.HP <= 0 {
-					r.handleShipDestruction(hitShip, owner.PlayerID)
-				}
+			r.damageShip(hitShip, 1, owner.PlayerID, r.simRand().Float64)
+			world.SetComponent(id, CompDestroyed, &DestroyedComponent{DestroyedAt: r.Now})
+			return
+		}
+
+		// Opposing missiles that meet destroy each other.
+		hitMissile := EntityID(0)
+		world.ForEach([]ComponentKey{CompTransform, CompMissile, CompOwner}, func(otherID EntityID) {
+			if hitMissile != 0 || otherID == id {
+				return
 			}
-			if heat := world.HeatData(hitShip); heat != nil {
-				ApplyMissileHeatSpike(heat, r.Now, rand.Float64)
+			if world.DestroyedData(otherID) != nil {
+				return
 			}
-			world.SetComponent(id, CompDestroyed, &DestroyedComponent{DestroyedAt: r.Now})
+			otherOwner := world.Owner(otherID)
+			if otherOwner == nil || !missilesOpposed(owner, otherOwner) {
+				return
+			}
+			snap, ok := PerceiveEntity(tr.Pos, otherID, world, r.Now)
+			if !ok {
+				return
+			}
+			if snap.Pos.Sub(tr.Pos).Len() <= MissileInterceptRadius {
+				hitMissile = otherID
+			}
+		})
+
+		if hitMissile != 0 {
+			r.detonateMissile(hitMissile, detonateContact)
+			r.detonateMissile(id, detonateContact)
 		}
 	})
 }
 
 // updateMissileHeat applies heat physics to all missiles.
-// Missiles that overheat explode (get destroyed) instead of stalling.
+// Missiles that overheat explode (detonating their warhead) instead of stalling.
 func updateMissileHeat(r *Room, dt float64) {
 	world := r.World
 	world.ForEach([]ComponentKey{CompMissile, CompHeat, CompTransform}, func(id EntityID) {
@@ -288,7 +359,7 @@ func updateMissileHeat(r *Room, dt float64) {
 		UpdateHeat(heat, speed, dt, r.Now)
 
 		if heat.S.Value >= heat.P.OverheatAt {
-			world.SetComponent(id, CompDestroyed, &DestroyedComponent{DestroyedAt: r.Now})
+			r.detonateMissile(id, detonateOverheat)
 		}
 	})
 }
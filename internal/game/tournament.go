@@ -0,0 +1,285 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Defaults for tournaments
+const (
+	tournamentDefaultRounds  = 10
+	tournamentDefaultSeconds = 180.0
+	tournamentInitialElo     = 1500.0
+	tournamentEloK           = 32.0
+)
+
+// TournamentEntry is one competitor: a bot behavior at a difficulty.
+type TournamentEntry struct {
+	Name       string `json:"name"`
+	Behavior   string `json:"behavior"`
+	Difficulty string `json:"difficulty"`
+	Hull       string `json:"hull,omitempty"`
+}
+
+// ParseTournamentEntry parses "behavior[@difficulty]".
+func ParseTournamentEntry(spec string) (TournamentEntry, error) {
+	spec = strings.TrimSpace(spec)
+	behavior, difficulty, _ := strings.Cut(spec, "@")
+	if difficulty == "" {
+		difficulty = DefaultAIDifficultyID
+	}
+	if _, err := NewAIBehavior(behavior); err != nil {
+		return TournamentEntry{}, err
+	}
+	if _, err := GetAIDifficulty(difficulty); err != nil {
+		return TournamentEntry{}, err
+	}
+	return TournamentEntry{Name: behavior + "@" + difficulty, Behavior: behavior, Difficulty: difficulty}, nil
+}
+
+// TournamentConfig describes a round-robin tournament. Every pair of entries
+// plays Rounds one-on-one matches, swapping sides each round.
+type TournamentConfig struct {
+	Entries      []TournamentEntry
+	Rounds       int
+	Seed         int64
+	MatchSeconds float64
+	Parallel     int        // Matches simulated at once; 0 runs them one at a time
+	Heat         HeatParams // Zero uses DefaultHeatParams
+}
+
+// MatchStats is one competitor's record in a match.
+type MatchStats struct {
+	Kills         int `json:"kills"`
+	Deaths        int `json:"deaths"`
+	MissilesFired int `json:"missiles_fired"`
+	Stalls        int `json:"stalls"`
+}
+
+// MatchResult records a single match. Winner is empty for a draw.
+type MatchResult struct {
+	Seed   int64      `json:"seed"`
+	A      string     `json:"a"`
+	B      string     `json:"b"`
+	Winner string     `json:"winner,omitempty"`
+	StatsA MatchStats `json:"stats_a"`
+	StatsB MatchStats `json:"stats_b"`
+}
+
+// TournamentStanding aggregates an entry's results.
+type TournamentStanding struct {
+	Name          string  `json:"name"`
+	Elo           float64 `json:"elo"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Draws         int     `json:"draws"`
+	Kills         int     `json:"kills"`
+	Deaths        int     `json:"deaths"`
+	MissilesFired int     `json:"missiles_fired"`
+	Stalls        int     `json:"stalls"`
+}
+
+// TournamentReport is the outcome of a tournament, standings sorted by Elo.
+type TournamentReport struct {
+	Seed      int64                `json:"seed"`
+	Rounds    int                  `json:"rounds"`
+	Standings []TournamentStanding `json:"standings"`
+	Matches   []MatchResult        `json:"matches"`
+}
+
+// RunTournament plays every match headlessly and rates the entries. Seeds fix
+// each match's spawn layout and every random draw in it, so a match replays
+// exactly; ratings are updated in match order so the report does not depend
+// on Parallel.
+func RunTournament(cfg TournamentConfig) (*TournamentReport, error) {
+	if len(cfg.Entries) < 2 {
+		return nil, fmt.Errorf("tournament needs at least two entries, got %d", len(cfg.Entries))
+	}
+	names := make(map[string]bool, len(cfg.Entries))
+	for _, e := range cfg.Entries {
+		if names[e.Name] {
+			return nil, fmt.Errorf("duplicate tournament entry: %s", e.Name)
+		}
+		names[e.Name] = true
+	}
+	if cfg.Rounds <= 0 {
+		cfg.Rounds = tournamentDefaultRounds
+	}
+	if cfg.MatchSeconds <= 0 {
+		cfg.MatchSeconds = tournamentDefaultSeconds
+	}
+	if cfg.Heat.Max <= 0 {
+		cfg.Heat = DefaultHeatParams()
+	}
+	if cfg.Parallel <= 0 {
+		cfg.Parallel = 1
+	}
+
+	type pairing struct{ a, b TournamentEntry }
+	var schedule []pairing
+	for round := 0; round < cfg.Rounds; round++ {
+		for i := range cfg.Entries {
+			for j := i + 1; j < len(cfg.Entries); j++ {
+				a, b := cfg.Entries[i], cfg.Entries[j]
+				if round%2 == 1 {
+					a, b = b, a
+				}
+				schedule = append(schedule, pairing{a, b})
+			}
+		}
+	}
+
+	results := make([]MatchResult, len(schedule))
+	errs := make([]error, len(schedule))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < cfg.Parallel; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], errs[i] = RunMatch(schedule[i].a, schedule[i].b, cfg.Seed+int64(i), cfg.MatchSeconds, cfg.Heat)
+			}
+		}()
+	}
+	for i := range schedule {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	standings := make(map[string]*TournamentStanding, len(cfg.Entries))
+	for _, e := range cfg.Entries {
+		standings[e.Name] = &TournamentStanding{Name: e.Name, Elo: tournamentInitialElo}
+	}
+	for _, m := range results {
+		a, b := standings[m.A], standings[m.B]
+		a.addStats(m.StatsA)
+		b.addStats(m.StatsB)
+		score := 0.5
+		switch m.Winner {
+		case m.A:
+			score = 1
+			a.Wins++
+			b.Losses++
+		case m.B:
+			score = 0
+			b.Wins++
+			a.Losses++
+		default:
+			a.Draws++
+			b.Draws++
+		}
+		a.Elo, b.Elo = updateElo(a.Elo, b.Elo, score)
+	}
+
+	report := &TournamentReport{Seed: cfg.Seed, Rounds: cfg.Rounds, Matches: results}
+	for _, s := range standings {
+		report.Standings = append(report.Standings, *s)
+	}
+	sort.Slice(report.Standings, func(i, j int) bool {
+		if report.Standings[i].Elo != report.Standings[j].Elo {
+			return report.Standings[i].Elo > report.Standings[j].Elo
+		}
+		return report.Standings[i].Name < report.Standings[j].Name
+	})
+	return report, nil
+}
+
+func (s *TournamentStanding) addStats(m MatchStats) {
+	s.Kills += m.Kills
+	s.Deaths += m.Deaths
+	s.MissilesFired += m.MissilesFired
+	s.Stalls += m.Stalls
+}
+
+// updateElo returns both ratings after a game where a scored scoreA (1 win,
+// 0.5 draw, 0 loss).
+func updateElo(a, b, scoreA float64) (float64, float64) {
+	expectedA := 1 / (1 + math.Pow(10, (b-a)/400))
+	delta := tournamentEloK * (scoreA - expectedA)
+	return a + delta, b - delta
+}
+
+// RunMatch plays one headless match between two bots for the given number of
+// simulated seconds. The bot with more kills wins.
+func RunMatch(a, b TournamentEntry, seed int64, seconds float64, heat HeatParams) (MatchResult, error) {
+	rng := rand.New(rand.NewSource(seed))
+	room := newRoom(fmt.Sprintf("match-%d", seed), heat)
+	room.SeedLocked(seed)
+	result := MatchResult{Seed: seed, A: a.Name, B: b.Name}
+
+	var bots [2]*Player
+	for i, entry := range []TournamentEntry{a, b} {
+		minX := 0.15 + 0.55*float64(i)
+		bot, err := room.AddConfiguredBotLocked(BotSpawnOptions{
+			Name:       entry.Name,
+			Behavior:   entry.Behavior,
+			Difficulty: entry.Difficulty,
+			Hull:       entry.Hull,
+			Pos: Vec2{
+				X: room.WorldWidth * (minX + 0.15*rng.Float64()),
+				Y: room.WorldHeight * (0.2 + 0.6*rng.Float64()),
+			},
+		})
+		if err != nil {
+			return result, err
+		}
+		bots[i] = bot
+	}
+
+	var stats [2]MatchStats
+	var stallUntil [2]float64
+	var ships [2]EntityID
+	seen := make(map[EntityID]bool)
+	ticks := int(math.Round(seconds * SimHz))
+	for t := 0; t < ticks; t++ {
+		room.Tick()
+		room.World.ForEach([]ComponentKey{CompMissile, CompOwner}, func(id EntityID) {
+			if seen[id] {
+				return
+			}
+			seen[id] = true
+			owner := room.World.Owner(id).PlayerID
+			for i, bot := range bots {
+				if owner == bot.ID {
+					stats[i].MissilesFired++
+				}
+			}
+		})
+		for i, bot := range bots {
+			if bot.Ship != ships[i] {
+				ships[i] = bot.Ship
+				stallUntil[i] = 0
+			}
+			if heat := room.World.HeatData(bot.Ship); heat != nil && heat.S.StallUntil > stallUntil[i] {
+				stats[i].Stalls++
+				stallUntil[i] = heat.S.StallUntil
+			}
+		}
+	}
+
+	// Bot-on-bot kills are not credited to Player.Kills, so a one-on-one
+	// match counts kills from the opponent's deaths.
+	for i, bot := range bots {
+		stats[i].Deaths = bot.Deaths
+		stats[1-i].Kills = bot.Deaths
+	}
+	result.StatsA, result.StatsB = stats[0], stats[1]
+	switch {
+	case stats[0].Kills > stats[1].Kills:
+		result.Winner = a.Name
+	case stats[1].Kills > stats[0].Kills:
+		result.Winner = b.Name
+	}
+	return result, nil
+}
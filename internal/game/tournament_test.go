@@ -0,0 +1,94 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUpdateElo(t *testing.T) {
+	a, b := updateElo(1500, 1500, 1)
+	if a != 1500+tournamentEloK/2 || b != 1500-tournamentEloK/2 {
+		t.Fatalf("expected an even game to move ratings by K/2, got %.1f %.1f", a, b)
+	}
+	a, b = updateElo(1800, 1400, 0.5)
+	if a >= 1800 || b <= 1400 {
+		t.Fatalf("expected a draw to pull ratings together, got %.1f %.1f", a, b)
+	}
+	if math.Abs((a+b)-3200) > 1e-9 {
+		t.Fatalf("expected rating points to be conserved, got %.3f", a+b)
+	}
+}
+
+func TestParseTournamentEntry(t *testing.T) {
+	entry, err := ParseTournamentEntry(" offensive ")
+	if err != nil {
+		t.Fatalf("failed to parse entry: %v", err)
+	}
+	if entry.Behavior != AIBehaviorOffensive || entry.Difficulty != DefaultAIDifficultyID {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	if _, err := ParseTournamentEntry("offensive@impossible"); err == nil {
+		t.Fatal("expected an unknown difficulty to be rejected")
+	}
+	if _, err := ParseTournamentEntry("pacifist"); err == nil {
+		t.Fatal("expected an unknown behavior to be rejected")
+	}
+}
+
+func TestRunTournament(t *testing.T) {
+	offensive, _ := ParseTournamentEntry(AIBehaviorOffensive)
+	defensive, _ := ParseTournamentEntry(AIBehaviorDefensive)
+	if _, err := RunTournament(TournamentConfig{Entries: []TournamentEntry{offensive, offensive}}); err == nil {
+		t.Fatal("expected duplicate entries to be rejected")
+	}
+
+	report, err := RunTournament(TournamentConfig{
+		Entries:      []TournamentEntry{offensive, defensive},
+		Rounds:       2,
+		Seed:         5,
+		MatchSeconds: 20,
+		Parallel:     2,
+	})
+	if err != nil {
+		t.Fatalf("tournament failed: %v", err)
+	}
+	if len(report.Matches) != 2 || len(report.Standings) != 2 {
+		t.Fatalf("expected 2 matches and 2 standings, got %d and %d", len(report.Matches), len(report.Standings))
+	}
+	if report.Matches[0].A != offensive.Name || report.Matches[1].A != defensive.Name {
+		t.Fatal("expected sides to swap between rounds")
+	}
+	if report.Matches[0].Seed != 5 || report.Matches[1].Seed != 6 {
+		t.Fatalf("expected consecutive match seeds, got %d and %d", report.Matches[0].Seed, report.Matches[1].Seed)
+	}
+	games := 0
+	fired := 0
+	for _, s := range report.Standings {
+		games += s.Wins + s.Losses + s.Draws
+		fired += s.MissilesFired
+	}
+	if games != 4 {
+		t.Fatalf("expected each match to count for both entries, got %d results", games)
+	}
+	if fired == 0 {
+		t.Fatal("expected missile launches to be counted")
+	}
+	if report.Standings[0].Elo < report.Standings[1].Elo {
+		t.Fatal("expected standings sorted by rating")
+	}
+}
+
+func TestRunMatchIsReproducible(t *testing.T) {
+	offensive, _ := ParseTournamentEntry(AIBehaviorOffensive)
+	defensive, _ := ParseTournamentEntry(AIBehaviorDefensive + "@hard")
+	first, err := RunMatch(offensive, defensive, 11, 30, DefaultHeatParams())
+	if err != nil {
+		t.Fatalf("match failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		again, _ := RunMatch(offensive, defensive, 11, 30, DefaultHeatParams())
+		if again != first {
+			t.Fatalf("expected the same seed to replay the match, got %+v and %+v", first, again)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package game
+
+import (
+	"fmt"
+	"math"
+)
+
+// WarheadType selects how a missile delivers damage when it detonates.
+type WarheadType int
+
+const (
+	// WarheadKinetic is a contact-only hit: 1 HP to the struck ship, no blast.
+	WarheadKinetic WarheadType = iota
+	// WarheadFragmentation has a proximity fuse and a wide, light blast.
+	WarheadFragmentation
+	// WarheadHighExplosive detonates on contact with a heavy, tighter blast.
+	WarheadHighExplosive
+	// WarheadProximityMine is fitted to static mines; it only fires on
+	// proximity so minefields do not go off when they time out.
+	WarheadProximityMine
+)
+
+func (w WarheadType) String() string {
+	switch w {
+	case WarheadFragmentation:
+		return "fragmentation"
+	case WarheadHighExplosive:
+		return "high_explosive"
+	case WarheadProximityMine:
+		return "mine"
+	default:
+		return "kinetic"
+	}
+}
+
+// MarshalText encodes the warhead by name.
+func (w WarheadType) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText parses a warhead name such as "fragmentation".
+func (w *WarheadType) UnmarshalText(text []byte) error {
+	for _, t := range []WarheadType{WarheadKinetic, WarheadFragmentation, WarheadHighExplosive, WarheadProximityMine} {
+		if t.String() == string(text) {
+			*w = t
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown warhead: %s", text)
+}
+
+// WarheadSpec describes the blast produced by a warhead type.
+type WarheadSpec struct {
+	BlastRadius        float64 // Radius of the area-of-effect blast (0 = contact only)
+	Damage             float64 // HP damage at the centre of the blast
+	ProximityRadius    float64 // Fuse distance to a hostile ship (0 = contact fuse)
+	DetonateOnExpiry   bool    // Detonate when the missile's lifetime runs out
+	DetonateOnOverheat bool    // Detonate when the missile overheats
+}
+
+// warheadSpecs holds the blast profile for each warhead type.
+var warheadSpecs = map[WarheadType]WarheadSpec{
+	WarheadKinetic: {},
+	WarheadFragmentation: {
+		BlastRadius:        180.0,
+		Damage:             1.0,
+		ProximityRadius:    120.0,
+		DetonateOnExpiry:   true,
+		DetonateOnOverheat: true,
+	},
+	WarheadHighExplosive: {
+		BlastRadius:        140.0,
+		Damage:             2.0,
+		DetonateOnExpiry:   true,
+		DetonateOnOverheat: true,
+	},
+	WarheadProximityMine: {
+		BlastRadius:     150.0,
+		Damage:          1.0,
+		ProximityRadius: 90.0,
+	},
+}
+
+// GetWarheadSpec returns the blast profile for a warhead type.
+func GetWarheadSpec(w WarheadType) WarheadSpec {
+	return warheadSpecs[w]
+}
+
+// Detonation triggers passed to detonateMissile.
+const (
+	detonateContact   = "contact"
+	detonateProximity = "proximity"
+	detonateExpiry    = "expiry"
+	detonateOverheat  = "overheat"
+	detonateChain     = "chain"
+	detonateShotDown  = "shot_down"
+)
+
+// detonateMissile destroys a missile and, if its warhead has a blast for the
+// given trigger, damages every hostile ship in range. Missiles and mines
+// caught in a blast detonate in turn, whoever owns them, so explosions can
+// chain through a minefield.
+func (r *Room) detonateMissile(id EntityID, trigger string) {
+	world := r.World
+	queue := []EntityID{id}
+	triggers := []string{trigger}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		reason := triggers[0]
+		queue = queue[1:]
+		triggers = triggers[1:]
+
+		if world.DestroyedData(current) != nil {
+			continue
+		}
+		world.SetComponent(current, CompDestroyed, &DestroyedComponent{DestroyedAt: r.Now})
+
+		missile := world.MissileData(current)
+		tr := world.Transform(current)
+		if missile == nil || tr == nil {
+			continue
+		}
+		spec := GetWarheadSpec(missile.Warhead)
+		if spec.BlastRadius <= 0 {
+			continue
+		}
+		if reason == detonateExpiry && !spec.DetonateOnExpiry {
+			continue
+		}
+		if reason == detonateOverheat && !spec.DetonateOnOverheat {
+			continue
+		}
+
+		attackerID := ""
+		owner := world.Owner(current)
+		if owner != nil {
+			attackerID = owner.PlayerID
+		}
+
+		world.ForEach([]ComponentKey{CompTransform, CompShip}, func(shipID EntityID) {
+			if world.DestroyedData(shipID) != nil {
+				return
+			}
+			// Blasts spare the firer's own and allied ships.
+			if target := world.Owner(shipID); target != nil && owner != nil &&
+				(target.PlayerID == owner.PlayerID || ownersAllied(owner, target)) {
+				return
+			}
+			snap, ok := PerceiveEntity(tr.Pos, shipID, world, r.Now)
+			if !ok {
+				return
+			}
+			damage := blastDamage(spec, snap.Pos.Sub(tr.Pos).Len())
+			if damage <= 0 {
+				return
+			}
+			r.damageShip(shipID, damage, attackerID, r.simRand().Float64)
+		})
+
+		world.ForEach([]ComponentKey{CompTransform, CompMissile}, func(otherID EntityID) {
+			if otherID == current || world.DestroyedData(otherID) != nil {
+				return
+			}
+			snap, ok := PerceiveEntity(tr.Pos, otherID, world, r.Now)
+			if !ok {
+				return
+			}
+			if snap.Pos.Sub(tr.Pos).Len() <= spec.BlastRadius {
+				queue = append(queue, otherID)
+				triggers = append(triggers, detonateChain)
+			}
+		})
+	}
+}
+
+// blastDamage returns the whole-HP damage dealt at distance dist from the
+// blast centre. Damage falls off linearly and is rounded up, so anything
+// strictly inside the blast radius takes at least 1 HP and the edge takes none.
+func blastDamage(spec WarheadSpec, dist float64) int {
+	if spec.BlastRadius <= 0 || dist > spec.BlastRadius {
+		return 0
+	}
+	falloff := 1.0 - dist/spec.BlastRadius
+	return int(math.Ceil(spec.Damage * falloff))
+}
@@ -0,0 +1,108 @@
+package game
+
+import "testing"
+
+func TestBlastDamageFalloff(t *testing.T) {
+	spec := GetWarheadSpec(WarheadHighExplosive)
+
+	if got := blastDamage(spec, 0); got != 2 {
+		t.Fatalf("expected full damage at centre, got %d", got)
+	}
+	if got := blastDamage(spec, spec.BlastRadius*0.75); got != 1 {
+		t.Fatalf("expected reduced damage near the edge, got %d", got)
+	}
+	if got := blastDamage(spec, spec.BlastRadius); got != 0 {
+		t.Fatalf("expected no damage at the very edge, got %d", got)
+	}
+	if got := blastDamage(spec, spec.BlastRadius+1); got != 0 {
+		t.Fatalf("expected no damage outside the blast, got %d", got)
+	}
+	if got := blastDamage(GetWarheadSpec(WarheadKinetic), 0); got != 0 {
+		t.Fatalf("kinetic warheads should not produce a blast, got %d", got)
+	}
+}
+
+func TestProximityFuseDamagesShipsInBlast(t *testing.T) {
+	room := newCombatTestRoom()
+	room.Players["target"] = &Player{ID: "target"}
+	ship := room.SpawnShip("target", Vec2{X: 1000, Y: 1000})
+
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500, Warhead: WarheadFragmentation})
+	missile := room.LaunchMissile("attacker", 0, cfg, []RouteWaypoint{{Pos: Vec2{X: 1000, Y: 1000}}}, Vec2{X: 1100, Y: 1000}, Vec2{})
+
+	room.Now = 1
+	resolveMissileCollisions(room)
+
+	if room.World.DestroyedData(missile) == nil {
+		t.Fatal("expected proximity fuse to detonate the missile")
+	}
+	if hp := room.World.ShipData(ship).HP; hp != ShipMaxHP-1 {
+		t.Fatalf("expected ship to take blast damage, got HP %d", hp)
+	}
+}
+
+func TestBlastSparesFirerAndAllies(t *testing.T) {
+	room := newCombatTestRoom()
+	for _, id := range []string{"attacker", "target"} {
+		room.Players[id] = &Player{ID: id}
+	}
+	own := room.SpawnShip("attacker", Vec2{X: 1000, Y: 1020})
+	ship := room.SpawnShip("target", Vec2{X: 1000, Y: 1000})
+	wingman := room.SpawnShip("wing-1", Vec2{X: 1020, Y: 1000})
+	room.World.Owner(wingman).LeaderID = "attacker"
+
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500, Warhead: WarheadFragmentation})
+	missile := room.LaunchMissile("attacker", 0, cfg, []RouteWaypoint{{Pos: Vec2{X: 1000, Y: 1000}}}, Vec2{X: 1000, Y: 1000}, Vec2{})
+
+	room.Now = 1
+	room.detonateMissile(missile, detonateProximity)
+	if hp := room.World.ShipData(own).HP; hp != ShipMaxHP {
+		t.Fatalf("expected the firer's own ship to be spared, got HP %d", hp)
+	}
+	if hp := room.World.ShipData(wingman).HP; hp != ShipMaxHP {
+		t.Fatalf("expected the firer's wingman to be spared, got HP %d", hp)
+	}
+	if hp := room.World.ShipData(ship).HP; hp >= ShipMaxHP {
+		t.Fatal("expected the target to take blast damage")
+	}
+}
+
+func TestDetonationChainsThroughMinefield(t *testing.T) {
+	room := newCombatTestRoom()
+	heat := DefaultMissileHeatParams()
+
+	first := spawnMineEntity(room, Vec2{X: 1000, Y: 1000}, heat, 120, nil)
+	second := spawnMineEntity(room, Vec2{X: 1120, Y: 1000}, heat, 120, nil)
+	third := spawnMineEntity(room, Vec2{X: 1240, Y: 1000}, heat, 120, nil)
+	distant := spawnMineEntity(room, Vec2{X: 2000, Y: 1000}, heat, 120, nil)
+
+	room.Now = 1
+	room.detonateMissile(first, detonateProximity)
+
+	for _, id := range []EntityID{first, second, third} {
+		if room.World.DestroyedData(id) == nil {
+			t.Fatalf("expected mine %d to be destroyed by the chain", id)
+		}
+	}
+	if room.World.DestroyedData(distant) != nil {
+		t.Fatal("mine outside the blast should survive")
+	}
+}
+
+func TestMineDoesNotDetonateOnExpiry(t *testing.T) {
+	room := newCombatTestRoom()
+	heat := DefaultMissileHeatParams()
+
+	first := spawnMineEntity(room, Vec2{X: 1000, Y: 1000}, heat, 120, nil)
+	neighbour := spawnMineEntity(room, Vec2{X: 1100, Y: 1000}, heat, 120, nil)
+
+	room.Now = 1
+	room.detonateMissile(first, detonateExpiry)
+
+	if room.World.DestroyedData(first) == nil {
+		t.Fatal("expired mine should be removed")
+	}
+	if room.World.DestroyedData(neighbour) != nil {
+		t.Fatal("an expiring mine should not set off its neighbours")
+	}
+}
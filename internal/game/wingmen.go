@@ -0,0 +1,201 @@
+package game
+
+import (
+	"fmt"
+	"math"
+)
+
+// WingmanOrder is a standing order given to a player's wingmen.
+type WingmanOrder string
+
+const (
+	WingmanOrderEscort WingmanOrder = "escort" // Fly formation on the leader
+	WingmanOrderHold   WingmanOrder = "hold"   // Hold the position where the order was given
+	WingmanOrderAttack WingmanOrder = "attack" // Hunt the nearest perceived enemy
+)
+
+// Tunables for wingmen
+const (
+	wingmanPlanInterval  = 0.3    // Seconds between wingman plans
+	wingmanMissileStock  = 12     // Missiles a wingman launches with
+	wingmanFormationDist = 260.0  // Distance from the leader to each slot
+	wingmanStationTol    = 60.0   // Re-route once this far off station
+	wingmanEngageRange   = 2800.0 // Escorting/holding wingmen only fire within this range
+)
+
+// ParseWingmanOrder validates an order name.
+func ParseWingmanOrder(s string) (WingmanOrder, error) {
+	switch order := WingmanOrder(s); order {
+	case WingmanOrderEscort, WingmanOrderHold, WingmanOrderAttack:
+		return order, nil
+	default:
+		return "", fmt.Errorf("unknown wingman order: %s", s)
+	}
+}
+
+// wingmanSlotOffset returns the formation offset of a slot behind the
+// leader, alternating port and starboard.
+func wingmanSlotOffset(slot int, heading Vec2) Vec2 {
+	if heading.Len() <= 1e-3 {
+		heading = Vec2{X: 1}
+	}
+	heading = unitOrZero(heading)
+	rank := float64(slot/2 + 1)
+	side := 1.0
+	if slot%2 == 1 {
+		side = -1
+	}
+	back := heading.Scale(-wingmanFormationDist * 0.7 * rank)
+	lateral := orthogonal(heading).Scale(side * wingmanFormationDist * rank)
+	return back.Add(lateral)
+}
+
+// wingmenOf returns the live wingmen led by a player.
+func (r *Room) wingmenOf(leaderID string) []EntityID {
+	var ids []EntityID
+	r.World.ForEach([]ComponentKey{CompWingman}, func(id EntityID) {
+		if w := r.World.Wingman(id); w != nil && w.LeaderID == leaderID && r.World.DestroyedData(id) == nil {
+			ids = append(ids, id)
+		}
+	})
+	return ids
+}
+
+// AddWingmanLocked launches a wingman in the player's next free slot. The
+// number of slots comes from the player's upgrade capabilities. Callers must
+// hold r.Mu.
+func (r *Room) AddWingmanLocked(p *Player) (EntityID, error) {
+	if p == nil {
+		return 0, fmt.Errorf("nil player")
+	}
+	leaderTr := r.World.Transform(p.Ship)
+	if leaderTr == nil {
+		return 0, fmt.Errorf("player %s has no ship", p.ID)
+	}
+	used := make(map[int]bool)
+	for _, id := range r.wingmenOf(p.ID) {
+		used[r.World.Wingman(id).Slot] = true
+	}
+	if len(used) >= p.Capabilities.WingmanSlots {
+		return 0, fmt.Errorf("no free wingman slots (%d unlocked)", p.Capabilities.WingmanSlots)
+	}
+	slot := 0
+	for used[slot] {
+		slot++
+	}
+
+	ownerID := fmt.Sprintf("%s-wing-%d", p.ID, slot+1)
+	pos := clampPointToWorldBounds(leaderTr.Pos.Add(wingmanSlotOffset(slot, leaderTr.Vel)), r.WorldWidth, r.WorldHeight)
+	id := r.SpawnShip(ownerID, pos)
+	if owner := r.World.Owner(id); owner != nil {
+		owner.LeaderID = p.ID
+	}
+	pilot := &Player{
+		ID:    ownerID,
+		Name:  fmt.Sprintf("%s Wing %d", p.Name, slot+1),
+		Ship:  id,
+		IsBot: true,
+	}
+	pilot.EnsureInventory()
+	pilot.Inventory.AddItem("missile", "basic", 80, wingmanMissileStock)
+	r.World.SetComponent(id, CompWingman, &WingmanComponent{
+		LeaderID: p.ID,
+		Slot:     slot,
+		Order:    WingmanOrderEscort,
+		Pilot:    pilot,
+		Behavior: NewOffensiveBehavior(),
+	})
+	return id, nil
+}
+
+// SetWingmanOrderLocked gives every wingman of the player a new order.
+// Callers must hold r.Mu.
+func (r *Room) SetWingmanOrderLocked(p *Player, order WingmanOrder) error {
+	if p == nil {
+		return fmt.Errorf("nil player")
+	}
+	if _, err := ParseWingmanOrder(string(order)); err != nil {
+		return err
+	}
+	wingmen := r.wingmenOf(p.ID)
+	if len(wingmen) == 0 {
+		return fmt.Errorf("player %s has no wingmen", p.ID)
+	}
+	for _, id := range wingmen {
+		w := r.World.Wingman(id)
+		w.Order = order
+		w.NextPlanAt = 0
+		if tr := r.World.Transform(id); tr != nil {
+			w.HoldPos = tr.Pos
+		}
+	}
+	return nil
+}
+
+// updateWingmen plans for every wingman. Wingmen always use the offensive
+// behavior to pick and lead targets, but only follow its maneuvers under an
+// attack order; otherwise they keep station and fire at nearby enemies.
+func updateWingmen(r *Room) {
+	world := r.World
+	world.ForEach([]ComponentKey{CompWingman, CompTransform}, func(id EntityID) {
+		if world.DestroyedData(id) != nil {
+			return
+		}
+		w := world.Wingman(id)
+		if w == nil || w.Pilot == nil || r.Now < w.NextPlanAt {
+			return
+		}
+		w.NextPlanAt = r.Now + wingmanPlanInterval
+		leader := r.Players[w.LeaderID]
+		if leader == nil {
+			return
+		}
+
+		ctx := buildAIContext(r, w.Pilot, 0)
+		ctx.Difficulty = AIDifficultyRegistry[DefaultAIDifficultyID]
+		pos := ctx.SelfTransform.Pos
+		nearest := math.Inf(1)
+		for _, op := range ctx.Opponents {
+			if d := op.Transform.Pos.Sub(pos).Len(); d < nearest {
+				nearest = d
+			}
+		}
+		for _, cmd := range w.Behavior.Plan(ctx) {
+			switch c := cmd.(type) {
+			case aiCommandLaunchMissile:
+				if w.Order == WingmanOrderAttack || nearest <= wingmanEngageRange {
+					c.apply(r, w.Pilot)
+				}
+			case aiCommandSetShipRoute, aiCommandClearShipRoute:
+				if w.Order == WingmanOrderAttack {
+					c.apply(r, w.Pilot)
+				}
+			}
+		}
+		if w.Order == WingmanOrderAttack && len(ctx.Opponents) > 0 {
+			return
+		}
+
+		station := w.HoldPos
+		speed := 0.0
+		if w.Order != WingmanOrderHold {
+			leaderTr := world.Transform(leader.Ship)
+			if leaderTr == nil {
+				return
+			}
+			station = leaderTr.Pos.Add(wingmanSlotOffset(w.Slot, leaderTr.Vel))
+			speed = leaderTr.Vel.Len()
+		}
+		station = clampPointToWorldBounds(station, r.WorldWidth, r.WorldHeight)
+		if station.Sub(pos).Len() <= wingmanStationTol {
+			return
+		}
+		maxSpeed := ShipMaxSpeed
+		if ctx.SelfMovement != nil {
+			maxSpeed = ctx.SelfMovement.MaxSpeed
+		}
+		// Close the gap quickly, then match the leader's speed.
+		speed = Clamp(math.Max(speed*1.2, station.Sub(pos).Len()*0.5), 40, maxSpeed)
+		CommandSetShipRoute([]RouteWaypoint{{Pos: station, Speed: speed}}).apply(r, w.Pilot)
+	})
+}
@@ -0,0 +1,117 @@
+package game
+
+import "testing"
+
+// wingmanRoom returns a room with a leader ship that has the given number of
+// wingman slots unlocked.
+func wingmanRoom(slots int) (*Room, *Player) {
+	room := newCombatTestRoom()
+	leader := &Player{ID: "lead", Name: "Lead"}
+	leader.Capabilities.WingmanSlots = slots
+	room.Players[leader.ID] = leader
+	leader.Ship = room.SpawnShip(leader.ID, Vec2{X: 2000, Y: 2000})
+	return room, leader
+}
+
+func TestAddWingmanRespectsUnlockedSlots(t *testing.T) {
+	room, leader := wingmanRoom(0)
+	if _, err := room.AddWingmanLocked(leader); err == nil {
+		t.Fatal("expected an error without unlocked slots")
+	}
+
+	leader.Capabilities.WingmanSlots = 1
+	id, err := room.AddWingmanLocked(leader)
+	if err != nil {
+		t.Fatalf("expected wingman to launch: %v", err)
+	}
+	w := room.World.Wingman(id)
+	if w == nil || w.LeaderID != leader.ID || w.Order != WingmanOrderEscort {
+		t.Fatalf("unexpected wingman component %+v", w)
+	}
+	if _, err := room.AddWingmanLocked(leader); err == nil {
+		t.Fatal("expected an error once all slots are filled")
+	}
+
+	room.damageShip(id, room.World.ShipData(id).HP, "raider", nil)
+	if room.World.DestroyedData(id) == nil {
+		t.Fatal("expected the wingman to be destroyed")
+	}
+	if room.World.ShipData(id).HP != 0 {
+		t.Fatal("wingmen should not respawn")
+	}
+	if _, err := room.AddWingmanLocked(leader); err != nil {
+		t.Fatalf("expected the lost wingman's slot to free up: %v", err)
+	}
+}
+
+func TestWingmanIsAlliedWithLeader(t *testing.T) {
+	room, leader := wingmanRoom(1)
+	id, err := room.AddWingmanLocked(leader)
+	if err != nil {
+		t.Fatalf("failed to add wingman: %v", err)
+	}
+	leaderOwner := room.World.Owner(leader.Ship)
+	wingOwner := room.World.Owner(id)
+	if !ownersAllied(leaderOwner, wingOwner) {
+		t.Fatal("expected wingman to be allied with its leader")
+	}
+	rival := room.SpawnShip("rival", Vec2{X: 5000, Y: 2000})
+	if ownersAllied(room.World.Owner(rival), wingOwner) {
+		t.Fatal("expected wingman to be hostile to other players")
+	}
+
+	cfg := SanitizeMissileConfig(MissileConfig{Speed: 150, AgroRadius: 500})
+	missile := room.LaunchMissile(leader.ID, leader.Ship, cfg, []RouteWaypoint{{Pos: Vec2{X: 2500, Y: 2000}}}, Vec2{X: 2000, Y: 2000}, Vec2{})
+	if !ownersAllied(room.World.Owner(missile), wingOwner) {
+		t.Fatal("expected the leader's missiles to spare the wingman")
+	}
+	wingMissile := room.LaunchMissile(wingOwner.PlayerID, id, cfg, []RouteWaypoint{{Pos: Vec2{X: 2500, Y: 2000}}}, Vec2{X: 2000, Y: 2000}, Vec2{})
+	if !ownersAllied(room.World.Owner(wingMissile), leaderOwner) {
+		t.Fatal("expected the wingman's missiles to spare the leader")
+	}
+}
+
+func TestWingmanOrders(t *testing.T) {
+	room, leader := wingmanRoom(1)
+	if err := room.SetWingmanOrderLocked(leader, WingmanOrderHold); err == nil {
+		t.Fatal("expected an error without wingmen")
+	}
+	id, err := room.AddWingmanLocked(leader)
+	if err != nil {
+		t.Fatalf("failed to add wingman: %v", err)
+	}
+	if _, err := ParseWingmanOrder("scatter"); err == nil {
+		t.Fatal("expected unknown orders to be rejected")
+	}
+
+	// Escorting wingmen follow the leader when it moves off.
+	room.World.Transform(leader.Ship).Pos = Vec2{X: 3500, Y: 2000}
+	room.World.Transform(leader.Ship).Vel = Vec2{X: 100}
+	updateWingmen(room)
+	route := room.World.Route(id)
+	if len(route.Waypoints) != 1 {
+		t.Fatalf("expected a route to the formation station, got %+v", route.Waypoints)
+	}
+	station := Vec2{X: 3500, Y: 2000}.Add(wingmanSlotOffset(0, Vec2{X: 100}))
+	if route.Waypoints[0].Pos.Sub(station).Len() > 1 {
+		t.Fatalf("expected route to station %+v, got %+v", station, route.Waypoints[0].Pos)
+	}
+
+	// Holding wingmen return to where the order was given.
+	room.World.Transform(id).Pos = Vec2{X: 1000, Y: 1000}
+	if err := room.SetWingmanOrderLocked(leader, WingmanOrderHold); err != nil {
+		t.Fatalf("failed to give hold order: %v", err)
+	}
+	room.World.Transform(id).Pos = Vec2{X: 1200, Y: 1000}
+	updateWingmen(room)
+	if got := route.Waypoints[0].Pos; got.Sub(Vec2{X: 1000, Y: 1000}).Len() > 1 {
+		t.Fatalf("expected route back to the hold position, got %+v", got)
+	}
+
+	if err := room.SetWingmanOrderLocked(leader, WingmanOrderAttack); err != nil {
+		t.Fatalf("failed to give attack order: %v", err)
+	}
+	if room.World.Wingman(id).Order != WingmanOrderAttack {
+		t.Fatal("expected the attack order to be stored")
+	}
+}
@@ -142,6 +142,7 @@ const (
 	UpgradeEffectType_UPGRADE_EFFECT_TYPE_MISSILE_UNLOCK   UpgradeEffectType = 2
 	UpgradeEffectType_UPGRADE_EFFECT_TYPE_HEAT_CAPACITY    UpgradeEffectType = 3
 	UpgradeEffectType_UPGRADE_EFFECT_TYPE_HEAT_EFFICIENCY  UpgradeEffectType = 4
+	UpgradeEffectType_UPGRADE_EFFECT_TYPE_WINGMAN_SLOT     UpgradeEffectType = 5
 )
 
 // Enum value maps for UpgradeEffectType.
@@ -152,6 +153,7 @@ var (
 		2: "UPGRADE_EFFECT_TYPE_MISSILE_UNLOCK",
 		3: "UPGRADE_EFFECT_TYPE_HEAT_CAPACITY",
 		4: "UPGRADE_EFFECT_TYPE_HEAT_EFFICIENCY",
+		5: "UPGRADE_EFFECT_TYPE_WINGMAN_SLOT",
 	}
 	UpgradeEffectType_value = map[string]int32{
 		"UPGRADE_EFFECT_TYPE_UNSPECIFIED":      0,
@@ -159,6 +161,7 @@ var (
 		"UPGRADE_EFFECT_TYPE_MISSILE_UNLOCK":   2,
 		"UPGRADE_EFFECT_TYPE_HEAT_CAPACITY":    3,
 		"UPGRADE_EFFECT_TYPE_HEAT_EFFICIENCY":  4,
+		"UPGRADE_EFFECT_TYPE_WINGMAN_SLOT":     5,
 	}
 )
 
@@ -303,11 +306,12 @@ func (MissionBeaconDeltaType) EnumDescriptor() ([]byte, []int) {
 type MissionEncounterEventType int32
 
 const (
-	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_UNSPECIFIED MissionEncounterEventType = 0
-	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_SPAWNED     MissionEncounterEventType = 1
-	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_CLEARED     MissionEncounterEventType = 2
-	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_TIMEOUT     MissionEncounterEventType = 3
-	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_PURGED      MissionEncounterEventType = 4
+	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_UNSPECIFIED      MissionEncounterEventType = 0
+	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_SPAWNED          MissionEncounterEventType = 1
+	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_CLEARED          MissionEncounterEventType = 2
+	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_TIMEOUT          MissionEncounterEventType = 3
+	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_PURGED           MissionEncounterEventType = 4
+	MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED MissionEncounterEventType = 5
 )
 
 // Enum value maps for MissionEncounterEventType.
@@ -318,13 +322,15 @@ var (
 		2: "MISSION_ENCOUNTER_EVENT_CLEARED",
 		3: "MISSION_ENCOUNTER_EVENT_TIMEOUT",
 		4: "MISSION_ENCOUNTER_EVENT_PURGED",
+		5: "MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED",
 	}
 	MissionEncounterEventType_value = map[string]int32{
-		"MISSION_ENCOUNTER_EVENT_UNSPECIFIED": 0,
-		"MISSION_ENCOUNTER_EVENT_SPAWNED":     1,
-		"MISSION_ENCOUNTER_EVENT_CLEARED":     2,
-		"MISSION_ENCOUNTER_EVENT_TIMEOUT":     3,
-		"MISSION_ENCOUNTER_EVENT_PURGED":      4,
+		"MISSION_ENCOUNTER_EVENT_UNSPECIFIED":      0,
+		"MISSION_ENCOUNTER_EVENT_SPAWNED":          1,
+		"MISSION_ENCOUNTER_EVENT_CLEARED":          2,
+		"MISSION_ENCOUNTER_EVENT_TIMEOUT":          3,
+		"MISSION_ENCOUNTER_EVENT_PURGED":           4,
+		"MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED": 5,
 	}
 )
 
@@ -355,6 +361,112 @@ func (MissionEncounterEventType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_ws_messages_proto_rawDescGZIP(), []int{5}
 }
 
+// Missile guidance mode (what the missile hunts)
+type MissileGuidance int32
+
+const (
+	MissileGuidance_MISSILE_GUIDANCE_UNSPECIFIED MissileGuidance = 0
+	MissileGuidance_MISSILE_GUIDANCE_SHIP        MissileGuidance = 1
+	MissileGuidance_MISSILE_GUIDANCE_INTERCEPTOR MissileGuidance = 2
+)
+
+// Enum value maps for MissileGuidance.
+var (
+	MissileGuidance_name = map[int32]string{
+		0: "MISSILE_GUIDANCE_UNSPECIFIED",
+		1: "MISSILE_GUIDANCE_SHIP",
+		2: "MISSILE_GUIDANCE_INTERCEPTOR",
+	}
+	MissileGuidance_value = map[string]int32{
+		"MISSILE_GUIDANCE_UNSPECIFIED": 0,
+		"MISSILE_GUIDANCE_SHIP":        1,
+		"MISSILE_GUIDANCE_INTERCEPTOR": 2,
+	}
+)
+
+func (x MissileGuidance) Enum() *MissileGuidance {
+	p := new(MissileGuidance)
+	*p = x
+	return p
+}
+
+func (x MissileGuidance) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissileGuidance) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ws_messages_proto_enumTypes[6].Descriptor()
+}
+
+func (MissileGuidance) Type() protoreflect.EnumType {
+	return &file_proto_ws_messages_proto_enumTypes[6]
+}
+
+func (x MissileGuidance) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissileGuidance.Descriptor instead.
+func (MissileGuidance) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{6}
+}
+
+// Missile warhead type (blast profile on detonation)
+type Warhead int32
+
+const (
+	Warhead_WARHEAD_UNSPECIFIED    Warhead = 0
+	Warhead_WARHEAD_KINETIC        Warhead = 1
+	Warhead_WARHEAD_FRAGMENTATION  Warhead = 2
+	Warhead_WARHEAD_HIGH_EXPLOSIVE Warhead = 3
+	Warhead_WARHEAD_PROXIMITY_MINE Warhead = 4
+)
+
+// Enum value maps for Warhead.
+var (
+	Warhead_name = map[int32]string{
+		0: "WARHEAD_UNSPECIFIED",
+		1: "WARHEAD_KINETIC",
+		2: "WARHEAD_FRAGMENTATION",
+		3: "WARHEAD_HIGH_EXPLOSIVE",
+		4: "WARHEAD_PROXIMITY_MINE",
+	}
+	Warhead_value = map[string]int32{
+		"WARHEAD_UNSPECIFIED":    0,
+		"WARHEAD_KINETIC":        1,
+		"WARHEAD_FRAGMENTATION":  2,
+		"WARHEAD_HIGH_EXPLOSIVE": 3,
+		"WARHEAD_PROXIMITY_MINE": 4,
+	}
+)
+
+func (x Warhead) Enum() *Warhead {
+	p := new(Warhead)
+	*p = x
+	return p
+}
+
+func (x Warhead) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Warhead) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ws_messages_proto_enumTypes[7].Descriptor()
+}
+
+func (Warhead) Type() protoreflect.EnumType {
+	return &file_proto_ws_messages_proto_enumTypes[7]
+}
+
+func (x Warhead) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Warhead.Descriptor instead.
+func (Warhead) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{7}
+}
+
 // WsEnvelope wraps all WebSocket messages in a discriminated union
 type WsEnvelope struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -380,6 +492,7 @@ type WsEnvelope struct {
 	//	*WsEnvelope_DeleteMissileRoute
 	//	*WsEnvelope_SetActiveMissileRoute
 	//	*WsEnvelope_LaunchMissile
+	//	*WsEnvelope_UseRepairKit
 	//	*WsEnvelope_DagStart
 	//	*WsEnvelope_DagCancel
 	//	*WsEnvelope_DagStoryAck
@@ -389,6 +502,11 @@ type WsEnvelope struct {
 	//	*WsEnvelope_DagListResponse
 	//	*WsEnvelope_MissionBeaconSnapshot
 	//	*WsEnvelope_MissionBeaconDelta
+	//	*WsEnvelope_EmergencyVent
+	//	*WsEnvelope_DeployRadiators
+	//	*WsEnvelope_UseHeatSink
+	//	*WsEnvelope_AddWingman
+	//	*WsEnvelope_WingmanOrder
 	Payload       isWsEnvelope_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -611,6 +729,15 @@ func (x *WsEnvelope) GetLaunchMissile() *LaunchMissile {
 	return nil
 }
 
+func (x *WsEnvelope) GetUseRepairKit() *UseRepairKit {
+	if x != nil {
+		if x, ok := x.Payload.(*WsEnvelope_UseRepairKit); ok {
+			return x.UseRepairKit
+		}
+	}
+	return nil
+}
+
 func (x *WsEnvelope) GetDagStart() *DagStart {
 	if x != nil {
 		if x, ok := x.Payload.(*WsEnvelope_DagStart); ok {
@@ -692,6 +819,51 @@ func (x *WsEnvelope) GetMissionBeaconDelta() *MissionBeaconDelta {
 	return nil
 }
 
+func (x *WsEnvelope) GetEmergencyVent() *EmergencyVent {
+	if x != nil {
+		if x, ok := x.Payload.(*WsEnvelope_EmergencyVent); ok {
+			return x.EmergencyVent
+		}
+	}
+	return nil
+}
+
+func (x *WsEnvelope) GetDeployRadiators() *DeployRadiators {
+	if x != nil {
+		if x, ok := x.Payload.(*WsEnvelope_DeployRadiators); ok {
+			return x.DeployRadiators
+		}
+	}
+	return nil
+}
+
+func (x *WsEnvelope) GetUseHeatSink() *UseHeatSink {
+	if x != nil {
+		if x, ok := x.Payload.(*WsEnvelope_UseHeatSink); ok {
+			return x.UseHeatSink
+		}
+	}
+	return nil
+}
+
+func (x *WsEnvelope) GetAddWingman() *AddWingman {
+	if x != nil {
+		if x, ok := x.Payload.(*WsEnvelope_AddWingman); ok {
+			return x.AddWingman
+		}
+	}
+	return nil
+}
+
+func (x *WsEnvelope) GetWingmanOrder() *WingmanOrder {
+	if x != nil {
+		if x, ok := x.Payload.(*WsEnvelope_WingmanOrder); ok {
+			return x.WingmanOrder
+		}
+	}
+	return nil
+}
+
 type isWsEnvelope_Payload interface {
 	isWsEnvelope_Payload()
 }
@@ -778,6 +950,10 @@ type WsEnvelope_LaunchMissile struct {
 	LaunchMissile *LaunchMissile `protobuf:"bytes,27,opt,name=launch_missile,json=launchMissile,proto3,oneof"`
 }
 
+type WsEnvelope_UseRepairKit struct {
+	UseRepairKit *UseRepairKit `protobuf:"bytes,28,opt,name=use_repair_kit,json=useRepairKit,proto3,oneof"`
+}
+
 type WsEnvelope_DagStart struct {
 	// Phase 2: DAG commands
 	DagStart *DagStart `protobuf:"bytes,30,opt,name=dag_start,json=dagStart,proto3,oneof"`
@@ -817,6 +993,27 @@ type WsEnvelope_MissionBeaconDelta struct {
 	MissionBeaconDelta *MissionBeaconDelta `protobuf:"bytes,61,opt,name=mission_beacon_delta,json=missionBeaconDelta,proto3,oneof"`
 }
 
+type WsEnvelope_EmergencyVent struct {
+	// Heat management commands
+	EmergencyVent *EmergencyVent `protobuf:"bytes,70,opt,name=emergency_vent,json=emergencyVent,proto3,oneof"`
+}
+
+type WsEnvelope_DeployRadiators struct {
+	DeployRadiators *DeployRadiators `protobuf:"bytes,71,opt,name=deploy_radiators,json=deployRadiators,proto3,oneof"`
+}
+
+type WsEnvelope_UseHeatSink struct {
+	UseHeatSink *UseHeatSink `protobuf:"bytes,72,opt,name=use_heat_sink,json=useHeatSink,proto3,oneof"`
+}
+
+type WsEnvelope_AddWingman struct {
+	AddWingman *AddWingman `protobuf:"bytes,73,opt,name=add_wingman,json=addWingman,proto3,oneof"`
+}
+
+type WsEnvelope_WingmanOrder struct {
+	WingmanOrder *WingmanOrder `protobuf:"bytes,74,opt,name=wingman_order,json=wingmanOrder,proto3,oneof"`
+}
+
 func (*WsEnvelope_StateUpdate) isWsEnvelope_Payload() {}
 
 func (*WsEnvelope_RoomFull) isWsEnvelope_Payload() {}
@@ -857,6 +1054,8 @@ func (*WsEnvelope_SetActiveMissileRoute) isWsEnvelope_Payload() {}
 
 func (*WsEnvelope_LaunchMissile) isWsEnvelope_Payload() {}
 
+func (*WsEnvelope_UseRepairKit) isWsEnvelope_Payload() {}
+
 func (*WsEnvelope_DagStart) isWsEnvelope_Payload() {}
 
 func (*WsEnvelope_DagCancel) isWsEnvelope_Payload() {}
@@ -875,6 +1074,16 @@ func (*WsEnvelope_MissionBeaconSnapshot) isWsEnvelope_Payload() {}
 
 func (*WsEnvelope_MissionBeaconDelta) isWsEnvelope_Payload() {}
 
+func (*WsEnvelope_EmergencyVent) isWsEnvelope_Payload() {}
+
+func (*WsEnvelope_DeployRadiators) isWsEnvelope_Payload() {}
+
+func (*WsEnvelope_UseHeatSink) isWsEnvelope_Payload() {}
+
+func (*WsEnvelope_AddWingman) isWsEnvelope_Payload() {}
+
+func (*WsEnvelope_WingmanOrder) isWsEnvelope_Payload() {}
+
 // Server → Client: Full game state
 // Sent every tick (~20Hz) containing the player's view of the game world
 // with light-delayed positions of other ships and missiles
@@ -1079,6 +1288,7 @@ type ClientJoin struct {
 	Room          string                 `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
 	MapW          float64                `protobuf:"fixed64,3,opt,name=map_w,json=mapW,proto3" json:"map_w,omitempty"`
 	MapH          float64                `protobuf:"fixed64,4,opt,name=map_h,json=mapH,proto3" json:"map_h,omitempty"`
+	Hull          string                 `protobuf:"bytes,5,opt,name=hull,proto3" json:"hull,omitempty"` // Hull class ID (empty = default)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1141,9 +1351,21 @@ func (x *ClientJoin) GetMapH() float64 {
 	return 0
 }
 
+func (x *ClientJoin) GetHull() string {
+	if x != nil {
+		return x.Hull
+	}
+	return ""
+}
+
 // Client → Server: Spawn AI bot
 type SpawnBot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Behavior      string                 `protobuf:"bytes,1,opt,name=behavior,proto3" json:"behavior,omitempty"`     // AI behavior name (empty = defensive)
+	Difficulty    string                 `protobuf:"bytes,2,opt,name=difficulty,proto3" json:"difficulty,omitempty"` // Difficulty profile ID (empty = normal)
+	Hull          string                 `protobuf:"bytes,3,opt,name=hull,proto3" json:"hull,omitempty"`             // Hull class ID (empty = default)
+	X             *float64               `protobuf:"fixed64,4,opt,name=x,proto3,oneof" json:"x,omitempty"`           // Spawn position (default: mirrored from the requester)
+	Y             *float64               `protobuf:"fixed64,5,opt,name=y,proto3,oneof" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1178,6 +1400,41 @@ func (*SpawnBot) Descriptor() ([]byte, []int) {
 	return file_proto_ws_messages_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *SpawnBot) GetBehavior() string {
+	if x != nil {
+		return x.Behavior
+	}
+	return ""
+}
+
+func (x *SpawnBot) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *SpawnBot) GetHull() string {
+	if x != nil {
+		return x.Hull
+	}
+	return ""
+}
+
+func (x *SpawnBot) GetX() float64 {
+	if x != nil && x.X != nil {
+		return *x.X
+	}
+	return 0
+}
+
+func (x *SpawnBot) GetY() float64 {
+	if x != nil && x.Y != nil {
+		return *x.Y
+	}
+	return 0
+}
+
 // Client → Server: Add waypoint to ship route
 type AddWaypoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1440,6 +1697,8 @@ type ConfigureMissile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MissileSpeed  float64                `protobuf:"fixed64,1,opt,name=missile_speed,json=missileSpeed,proto3" json:"missile_speed,omitempty"`
 	MissileAgro   float64                `protobuf:"fixed64,2,opt,name=missile_agro,json=missileAgro,proto3" json:"missile_agro,omitempty"`
+	Guidance      MissileGuidance        `protobuf:"varint,3,opt,name=guidance,proto3,enum=lightspeedduel.ws.MissileGuidance" json:"guidance,omitempty"`
+	Warhead       Warhead                `protobuf:"varint,4,opt,name=warhead,proto3,enum=lightspeedduel.ws.Warhead" json:"warhead,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1488,6 +1747,20 @@ func (x *ConfigureMissile) GetMissileAgro() float64 {
 	return 0
 }
 
+func (x *ConfigureMissile) GetGuidance() MissileGuidance {
+	if x != nil {
+		return x.Guidance
+	}
+	return MissileGuidance_MISSILE_GUIDANCE_UNSPECIFIED
+}
+
+func (x *ConfigureMissile) GetWarhead() Warhead {
+	if x != nil {
+		return x.Warhead
+	}
+	return Warhead_WARHEAD_UNSPECIFIED
+}
+
 // Client → Server: Add waypoint to missile route
 type AddMissileWaypoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2033,6 +2306,13 @@ type Ghost struct {
 	Hp                   int32                  `protobuf:"varint,10,opt,name=hp,proto3" json:"hp,omitempty"`
 	Kills                int32                  `protobuf:"varint,11,opt,name=kills,proto3" json:"kills,omitempty"`
 	Heat                 *ShipHeatView          `protobuf:"bytes,12,opt,name=heat,proto3,oneof" json:"heat,omitempty"`
+	PointDefense         *PointDefenseView      `protobuf:"bytes,13,opt,name=point_defense,json=pointDefense,proto3,oneof" json:"point_defense,omitempty"`
+	Hull                 string                 `protobuf:"bytes,14,opt,name=hull,proto3" json:"hull,omitempty"`
+	MaxHp                int32                  `protobuf:"varint,15,opt,name=max_hp,json=maxHp,proto3" json:"max_hp,omitempty"`
+	Subsystems           *ShipSubsystemView     `protobuf:"bytes,16,opt,name=subsystems,proto3,oneof" json:"subsystems,omitempty"`
+	HeatAbilities        *HeatAbilitiesView     `protobuf:"bytes,17,opt,name=heat_abilities,json=heatAbilities,proto3,oneof" json:"heat_abilities,omitempty"`
+	Venting              bool                   `protobuf:"varint,18,opt,name=venting,proto3" json:"venting,omitempty"` // Vent plume visible in the perceived snapshot
+	Allied               bool                   `protobuf:"varint,19,opt,name=allied,proto3" json:"allied,omitempty"`   // Ship fights on the viewer's side (e.g. their wingmen)
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -2151,6 +2431,55 @@ func (x *Ghost) GetHeat() *ShipHeatView {
 	return nil
 }
 
+func (x *Ghost) GetPointDefense() *PointDefenseView {
+	if x != nil {
+		return x.PointDefense
+	}
+	return nil
+}
+
+func (x *Ghost) GetHull() string {
+	if x != nil {
+		return x.Hull
+	}
+	return ""
+}
+
+func (x *Ghost) GetMaxHp() int32 {
+	if x != nil {
+		return x.MaxHp
+	}
+	return 0
+}
+
+func (x *Ghost) GetSubsystems() *ShipSubsystemView {
+	if x != nil {
+		return x.Subsystems
+	}
+	return nil
+}
+
+func (x *Ghost) GetHeatAbilities() *HeatAbilitiesView {
+	if x != nil {
+		return x.HeatAbilities
+	}
+	return nil
+}
+
+func (x *Ghost) GetVenting() bool {
+	if x != nil {
+		return x.Venting
+	}
+	return false
+}
+
+func (x *Ghost) GetAllied() bool {
+	if x != nil {
+		return x.Allied
+	}
+	return false
+}
+
 // Waypoint with position and target speed
 type Waypoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2290,6 +2619,9 @@ type Missile struct {
 	ExpiresAt     float64                `protobuf:"fixed64,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	TargetId      string                 `protobuf:"bytes,13,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	Heat          *ShipHeatView          `protobuf:"bytes,14,opt,name=heat,proto3,oneof" json:"heat,omitempty"`
+	Guidance      MissileGuidance        `protobuf:"varint,15,opt,name=guidance,proto3,enum=lightspeedduel.ws.MissileGuidance" json:"guidance,omitempty"`
+	Warhead       Warhead                `protobuf:"varint,16,opt,name=warhead,proto3,enum=lightspeedduel.ws.Warhead" json:"warhead,omitempty"`
+	BlastRadius   float64                `protobuf:"fixed64,17,opt,name=blast_radius,json=blastRadius,proto3" json:"blast_radius,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2422,6 +2754,27 @@ func (x *Missile) GetHeat() *ShipHeatView {
 	return nil
 }
 
+func (x *Missile) GetGuidance() MissileGuidance {
+	if x != nil {
+		return x.Guidance
+	}
+	return MissileGuidance_MISSILE_GUIDANCE_UNSPECIFIED
+}
+
+func (x *Missile) GetWarhead() Warhead {
+	if x != nil {
+		return x.Warhead
+	}
+	return Warhead_WARHEAD_UNSPECIFIED
+}
+
+func (x *Missile) GetBlastRadius() float64 {
+	if x != nil {
+		return x.BlastRadius
+	}
+	return 0
+}
+
 // Missile configuration parameters
 type MissileConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2432,6 +2785,8 @@ type MissileConfig struct {
 	AgroRadius    float64                `protobuf:"fixed64,5,opt,name=agro_radius,json=agroRadius,proto3" json:"agro_radius,omitempty"`
 	Lifetime      float64                `protobuf:"fixed64,6,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
 	HeatConfig    *HeatParams            `protobuf:"bytes,7,opt,name=heat_config,json=heatConfig,proto3,oneof" json:"heat_config,omitempty"`
+	Guidance      MissileGuidance        `protobuf:"varint,8,opt,name=guidance,proto3,enum=lightspeedduel.ws.MissileGuidance" json:"guidance,omitempty"`
+	Warhead       Warhead                `protobuf:"varint,9,opt,name=warhead,proto3,enum=lightspeedduel.ws.Warhead" json:"warhead,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2515,6 +2870,20 @@ func (x *MissileConfig) GetHeatConfig() *HeatParams {
 	return nil
 }
 
+func (x *MissileConfig) GetGuidance() MissileGuidance {
+	if x != nil {
+		return x.Guidance
+	}
+	return MissileGuidance_MISSILE_GUIDANCE_UNSPECIFIED
+}
+
+func (x *MissileConfig) GetWarhead() Warhead {
+	if x != nil {
+		return x.Warhead
+	}
+	return Warhead_WARHEAD_UNSPECIFIED
+}
+
 // Missile route definition
 type MissileRoute struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2872,13 +3241,18 @@ func (*UpgradeEffect_UnlockId) isUpgradeEffect_Value() {}
 
 // Player capabilities (computed from completed upgrades)
 type PlayerCapabilities struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SpeedMultiplier  float64                `protobuf:"fixed64,1,opt,name=speed_multiplier,json=speedMultiplier,proto3" json:"speed_multiplier,omitempty"`
-	UnlockedMissiles []string               `protobuf:"bytes,2,rep,name=unlocked_missiles,json=unlockedMissiles,proto3" json:"unlocked_missiles,omitempty"`
-	HeatCapacity     float64                `protobuf:"fixed64,3,opt,name=heat_capacity,json=heatCapacity,proto3" json:"heat_capacity,omitempty"`
-	HeatEfficiency   float64                `protobuf:"fixed64,4,opt,name=heat_efficiency,json=heatEfficiency,proto3" json:"heat_efficiency,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	SpeedMultiplier           float64                `protobuf:"fixed64,1,opt,name=speed_multiplier,json=speedMultiplier,proto3" json:"speed_multiplier,omitempty"`
+	UnlockedMissiles          []string               `protobuf:"bytes,2,rep,name=unlocked_missiles,json=unlockedMissiles,proto3" json:"unlocked_missiles,omitempty"`
+	HeatCapacity              float64                `protobuf:"fixed64,3,opt,name=heat_capacity,json=heatCapacity,proto3" json:"heat_capacity,omitempty"`
+	HeatEfficiency            float64                `protobuf:"fixed64,4,opt,name=heat_efficiency,json=heatEfficiency,proto3" json:"heat_efficiency,omitempty"`
+	Hull                      string                 `protobuf:"bytes,5,opt,name=hull,proto3" json:"hull,omitempty"`                                                                                // Selected hull class ID
+	MissileCooldownMultiplier float64                `protobuf:"fixed64,6,opt,name=missile_cooldown_multiplier,json=missileCooldownMultiplier,proto3" json:"missile_cooldown_multiplier,omitempty"` // Hull launch cooldown scale
+	MaxSpeed                  float64                `protobuf:"fixed64,7,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`                                                      // Effective ship max speed
+	MaxHp                     int32                  `protobuf:"varint,8,opt,name=max_hp,json=maxHp,proto3" json:"max_hp,omitempty"`                                                                // Hull max HP
+	WingmanSlots              int32                  `protobuf:"varint,9,opt,name=wingman_slots,json=wingmanSlots,proto3" json:"wingman_slots,omitempty"`                                           // Wingmen the player may field
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *PlayerCapabilities) Reset() {
@@ -2939,6 +3313,41 @@ func (x *PlayerCapabilities) GetHeatEfficiency() float64 {
 	return 0
 }
 
+func (x *PlayerCapabilities) GetHull() string {
+	if x != nil {
+		return x.Hull
+	}
+	return ""
+}
+
+func (x *PlayerCapabilities) GetMissileCooldownMultiplier() float64 {
+	if x != nil {
+		return x.MissileCooldownMultiplier
+	}
+	return 0
+}
+
+func (x *PlayerCapabilities) GetMaxSpeed() float64 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *PlayerCapabilities) GetMaxHp() int32 {
+	if x != nil {
+		return x.MaxHp
+	}
+	return 0
+}
+
+func (x *PlayerCapabilities) GetWingmanSlots() int32 {
+	if x != nil {
+		return x.WingmanSlots
+	}
+	return 0
+}
+
 // DAG node state
 type DagNode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -4441,44 +4850,504 @@ func (x *MissionBeaconEncounterEvent) GetReason() string {
 	return ""
 }
 
-var File_proto_ws_messages_proto protoreflect.FileDescriptor
+// Point-defense mount state for the player's own ship
+type PointDefenseView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         float64                `protobuf:"fixed64,1,opt,name=range,proto3" json:"range,omitempty"`                       // Engagement range
+	Cooldown      float64                `protobuf:"fixed64,2,opt,name=cooldown,proto3" json:"cooldown,omitempty"`                 // Seconds between shots
+	HeatCost      float64                `protobuf:"fixed64,3,opt,name=heat_cost,json=heatCost,proto3" json:"heat_cost,omitempty"` // Heat per shot
+	ReadyAt       float64                `protobuf:"fixed64,4,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`    // Server time when the next shot is available
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_ws_messages_proto_rawDesc = "" +
-	"\n" +
-	"\x17proto/ws_messages.proto\x12\x11lightspeedduel.ws\"\xb2\x12\n" +
-	"\n" +
-	"WsEnvelope\x12C\n" +
-	"\fstate_update\x18\x01 \x01(\v2\x1e.lightspeedduel.ws.StateUpdateH\x00R\vstateUpdate\x12?\n" +
-	"\troom_full\x18\x02 \x01(\v2 .lightspeedduel.ws.RoomFullErrorH\x00R\broomFull\x123\n" +
-	"\x04join\x18\n" +
-	" \x01(\v2\x1d.lightspeedduel.ws.ClientJoinH\x00R\x04join\x12:\n" +
-	"\tspawn_bot\x18\v \x01(\v2\x1b.lightspeedduel.ws.SpawnBotH\x00R\bspawnBot\x12C\n" +
-	"\fadd_waypoint\x18\f \x01(\v2\x1e.lightspeedduel.ws.AddWaypointH\x00R\vaddWaypoint\x12L\n" +
-	"\x0fupdate_waypoint\x18\r \x01(\v2!.lightspeedduel.ws.UpdateWaypointH\x00R\x0eupdateWaypoint\x12F\n" +
-	"\rmove_waypoint\x18\x0e \x01(\v2\x1f.lightspeedduel.ws.MoveWaypointH\x00R\fmoveWaypoint\x12L\n" +
-	"\x0fdelete_waypoint\x18\x0f \x01(\v2!.lightspeedduel.ws.DeleteWaypointH\x00R\x0edeleteWaypoint\x12L\n" +
-	"\x0fclear_waypoints\x18\x10 \x01(\v2!.lightspeedduel.ws.ClearWaypointsH\x00R\x0eclearWaypoints\x12R\n" +
-	"\x11configure_missile\x18\x11 \x01(\v2#.lightspeedduel.ws.ConfigureMissileH\x00R\x10configureMissile\x12Y\n" +
-	"\x14add_missile_waypoint\x18\x12 \x01(\v2%.lightspeedduel.ws.AddMissileWaypointH\x00R\x12addMissileWaypoint\x12r\n" +
-	"\x1dupdate_missile_waypoint_speed\x18\x13 \x01(\v2-.lightspeedduel.ws.UpdateMissileWaypointSpeedH\x00R\x1aupdateMissileWaypointSpeed\x12\\\n" +
-	"\x15move_missile_waypoint\x18\x14 \x01(\v2&.lightspeedduel.ws.MoveMissileWaypointH\x00R\x13moveMissileWaypoint\x12b\n" +
-	"\x17delete_missile_waypoint\x18\x15 \x01(\v2(.lightspeedduel.ws.DeleteMissileWaypointH\x00R\x15deleteMissileWaypoint\x12V\n" +
-	"\x13clear_missile_route\x18\x16 \x01(\v2$.lightspeedduel.ws.ClearMissileRouteH\x00R\x11clearMissileRoute\x12P\n" +
-	"\x11add_missile_route\x18\x17 \x01(\v2\".lightspeedduel.ws.AddMissileRouteH\x00R\x0faddMissileRoute\x12Y\n" +
-	"\x14rename_missile_route\x18\x18 \x01(\v2%.lightspeedduel.ws.RenameMissileRouteH\x00R\x12renameMissileRoute\x12Y\n" +
-	"\x14delete_missile_route\x18\x19 \x01(\v2%.lightspeedduel.ws.DeleteMissileRouteH\x00R\x12deleteMissileRoute\x12c\n" +
-	"\x18set_active_missile_route\x18\x1a \x01(\v2(.lightspeedduel.ws.SetActiveMissileRouteH\x00R\x15setActiveMissileRoute\x12I\n" +
-	"\x0elaunch_missile\x18\x1b \x01(\v2 .lightspeedduel.ws.LaunchMissileH\x00R\rlaunchMissile\x12:\n" +
-	"\tdag_start\x18\x1e \x01(\v2\x1b.lightspeedduel.ws.DagStartH\x00R\bdagStart\x12=\n" +
-	"\n" +
-	"dag_cancel\x18\x1f \x01(\v2\x1c.lightspeedduel.ws.DagCancelH\x00R\tdagCancel\x12D\n" +
-	"\rdag_story_ack\x18  \x01(\v2\x1e.lightspeedduel.ws.DagStoryAckH\x00R\vdagStoryAck\x127\n" +
-	"\bdag_list\x18! \x01(\v2\x1a.lightspeedduel.ws.DagListH\x00R\adagList\x12S\n" +
+func (x *PointDefenseView) Reset() {
+	*x = PointDefenseView{}
+	mi := &file_proto_ws_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointDefenseView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointDefenseView) ProtoMessage() {}
+
+func (x *PointDefenseView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointDefenseView.ProtoReflect.Descriptor instead.
+func (*PointDefenseView) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PointDefenseView) GetRange() float64 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *PointDefenseView) GetCooldown() float64 {
+	if x != nil {
+		return x.Cooldown
+	}
+	return 0
+}
+
+func (x *PointDefenseView) GetHeatCost() float64 {
+	if x != nil {
+		return x.HeatCost
+	}
+	return 0
+}
+
+func (x *PointDefenseView) GetReadyAt() float64 {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return 0
+}
+
+// Client → Server: Spend a repair kit on the player's ship subsystems
+type UseRepairKit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UseRepairKit) Reset() {
+	*x = UseRepairKit{}
+	mi := &file_proto_ws_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseRepairKit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseRepairKit) ProtoMessage() {}
+
+func (x *UseRepairKit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseRepairKit.ProtoReflect.Descriptor instead.
+func (*UseRepairKit) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{55}
+}
+
+// Subsystem health for the player's own ship (each in [0,1])
+type ShipSubsystemView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Engines       float64                `protobuf:"fixed64,1,opt,name=engines,proto3" json:"engines,omitempty"`                            // Scales max speed
+	Sensors       float64                `protobuf:"fixed64,2,opt,name=sensors,proto3" json:"sensors,omitempty"`                            // Scales perception range
+	Launchers     float64                `protobuf:"fixed64,3,opt,name=launchers,proto3" json:"launchers,omitempty"`                        // Scales missile cooldown
+	Radiators     float64                `protobuf:"fixed64,4,opt,name=radiators,proto3" json:"radiators,omitempty"`                        // Scales heat dissipation
+	RepairAfter   float64                `protobuf:"fixed64,5,opt,name=repair_after,json=repairAfter,proto3" json:"repair_after,omitempty"` // Server time when passive repair resumes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipSubsystemView) Reset() {
+	*x = ShipSubsystemView{}
+	mi := &file_proto_ws_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipSubsystemView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipSubsystemView) ProtoMessage() {}
+
+func (x *ShipSubsystemView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipSubsystemView.ProtoReflect.Descriptor instead.
+func (*ShipSubsystemView) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ShipSubsystemView) GetEngines() float64 {
+	if x != nil {
+		return x.Engines
+	}
+	return 0
+}
+
+func (x *ShipSubsystemView) GetSensors() float64 {
+	if x != nil {
+		return x.Sensors
+	}
+	return 0
+}
+
+func (x *ShipSubsystemView) GetLaunchers() float64 {
+	if x != nil {
+		return x.Launchers
+	}
+	return 0
+}
+
+func (x *ShipSubsystemView) GetRadiators() float64 {
+	if x != nil {
+		return x.Radiators
+	}
+	return 0
+}
+
+func (x *ShipSubsystemView) GetRepairAfter() float64 {
+	if x != nil {
+		return x.RepairAfter
+	}
+	return 0
+}
+
+// Client → Server: Dump heat with an emergency vent
+type EmergencyVent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmergencyVent) Reset() {
+	*x = EmergencyVent{}
+	mi := &file_proto_ws_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyVent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyVent) ProtoMessage() {}
+
+func (x *EmergencyVent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyVent.ProtoReflect.Descriptor instead.
+func (*EmergencyVent) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{57}
+}
+
+// Client → Server: Deploy or stow the ship's radiators
+type DeployRadiators struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployed      bool                   `protobuf:"varint,1,opt,name=deployed,proto3" json:"deployed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployRadiators) Reset() {
+	*x = DeployRadiators{}
+	mi := &file_proto_ws_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployRadiators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployRadiators) ProtoMessage() {}
+
+func (x *DeployRadiators) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployRadiators.ProtoReflect.Descriptor instead.
+func (*DeployRadiators) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeployRadiators) GetDeployed() bool {
+	if x != nil {
+		return x.Deployed
+	}
+	return false
+}
+
+// Client → Server: Spend a heat sink from the inventory
+type UseHeatSink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UseHeatSink) Reset() {
+	*x = UseHeatSink{}
+	mi := &file_proto_ws_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseHeatSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseHeatSink) ProtoMessage() {}
+
+func (x *UseHeatSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseHeatSink.ProtoReflect.Descriptor instead.
+func (*UseHeatSink) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{59}
+}
+
+// Client → Server: Launch a wingman into the next free slot
+type AddWingman struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWingman) Reset() {
+	*x = AddWingman{}
+	mi := &file_proto_ws_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWingman) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWingman) ProtoMessage() {}
+
+func (x *AddWingman) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWingman.ProtoReflect.Descriptor instead.
+func (*AddWingman) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{60}
+}
+
+// Client → Server: Give all wingmen a standing order
+type WingmanOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         string                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"` // "escort", "hold" or "attack"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WingmanOrder) Reset() {
+	*x = WingmanOrder{}
+	mi := &file_proto_ws_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WingmanOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WingmanOrder) ProtoMessage() {}
+
+func (x *WingmanOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WingmanOrder.ProtoReflect.Descriptor instead.
+func (*WingmanOrder) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WingmanOrder) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+// Heat ability cooldowns for the player's own ship
+type HeatAbilitiesView struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	VentReadyAt       float64                `protobuf:"fixed64,1,opt,name=vent_ready_at,json=ventReadyAt,proto3" json:"vent_ready_at,omitempty"` // Server time when the next vent is available
+	SinkReadyAt       float64                `protobuf:"fixed64,2,opt,name=sink_ready_at,json=sinkReadyAt,proto3" json:"sink_ready_at,omitempty"` // Server time when the next heat sink is available
+	RadiatorsDeployed bool                   `protobuf:"varint,3,opt,name=radiators_deployed,json=radiatorsDeployed,proto3" json:"radiators_deployed,omitempty"`
+	RadiatorReadyAt   float64                `protobuf:"fixed64,4,opt,name=radiator_ready_at,json=radiatorReadyAt,proto3" json:"radiator_ready_at,omitempty"` // Server time when radiators can be toggled
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HeatAbilitiesView) Reset() {
+	*x = HeatAbilitiesView{}
+	mi := &file_proto_ws_messages_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeatAbilitiesView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatAbilitiesView) ProtoMessage() {}
+
+func (x *HeatAbilitiesView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_messages_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatAbilitiesView.ProtoReflect.Descriptor instead.
+func (*HeatAbilitiesView) Descriptor() ([]byte, []int) {
+	return file_proto_ws_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *HeatAbilitiesView) GetVentReadyAt() float64 {
+	if x != nil {
+		return x.VentReadyAt
+	}
+	return 0
+}
+
+func (x *HeatAbilitiesView) GetSinkReadyAt() float64 {
+	if x != nil {
+		return x.SinkReadyAt
+	}
+	return 0
+}
+
+func (x *HeatAbilitiesView) GetRadiatorsDeployed() bool {
+	if x != nil {
+		return x.RadiatorsDeployed
+	}
+	return false
+}
+
+func (x *HeatAbilitiesView) GetRadiatorReadyAt() float64 {
+	if x != nil {
+		return x.RadiatorReadyAt
+	}
+	return 0
+}
+
+var File_proto_ws_messages_proto protoreflect.FileDescriptor
+
+const file_proto_ws_messages_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/ws_messages.proto\x12\x11lightspeedduel.ws\"\xe7\x15\n" +
+	"\n" +
+	"WsEnvelope\x12C\n" +
+	"\fstate_update\x18\x01 \x01(\v2\x1e.lightspeedduel.ws.StateUpdateH\x00R\vstateUpdate\x12?\n" +
+	"\troom_full\x18\x02 \x01(\v2 .lightspeedduel.ws.RoomFullErrorH\x00R\broomFull\x123\n" +
+	"\x04join\x18\n" +
+	" \x01(\v2\x1d.lightspeedduel.ws.ClientJoinH\x00R\x04join\x12:\n" +
+	"\tspawn_bot\x18\v \x01(\v2\x1b.lightspeedduel.ws.SpawnBotH\x00R\bspawnBot\x12C\n" +
+	"\fadd_waypoint\x18\f \x01(\v2\x1e.lightspeedduel.ws.AddWaypointH\x00R\vaddWaypoint\x12L\n" +
+	"\x0fupdate_waypoint\x18\r \x01(\v2!.lightspeedduel.ws.UpdateWaypointH\x00R\x0eupdateWaypoint\x12F\n" +
+	"\rmove_waypoint\x18\x0e \x01(\v2\x1f.lightspeedduel.ws.MoveWaypointH\x00R\fmoveWaypoint\x12L\n" +
+	"\x0fdelete_waypoint\x18\x0f \x01(\v2!.lightspeedduel.ws.DeleteWaypointH\x00R\x0edeleteWaypoint\x12L\n" +
+	"\x0fclear_waypoints\x18\x10 \x01(\v2!.lightspeedduel.ws.ClearWaypointsH\x00R\x0eclearWaypoints\x12R\n" +
+	"\x11configure_missile\x18\x11 \x01(\v2#.lightspeedduel.ws.ConfigureMissileH\x00R\x10configureMissile\x12Y\n" +
+	"\x14add_missile_waypoint\x18\x12 \x01(\v2%.lightspeedduel.ws.AddMissileWaypointH\x00R\x12addMissileWaypoint\x12r\n" +
+	"\x1dupdate_missile_waypoint_speed\x18\x13 \x01(\v2-.lightspeedduel.ws.UpdateMissileWaypointSpeedH\x00R\x1aupdateMissileWaypointSpeed\x12\\\n" +
+	"\x15move_missile_waypoint\x18\x14 \x01(\v2&.lightspeedduel.ws.MoveMissileWaypointH\x00R\x13moveMissileWaypoint\x12b\n" +
+	"\x17delete_missile_waypoint\x18\x15 \x01(\v2(.lightspeedduel.ws.DeleteMissileWaypointH\x00R\x15deleteMissileWaypoint\x12V\n" +
+	"\x13clear_missile_route\x18\x16 \x01(\v2$.lightspeedduel.ws.ClearMissileRouteH\x00R\x11clearMissileRoute\x12P\n" +
+	"\x11add_missile_route\x18\x17 \x01(\v2\".lightspeedduel.ws.AddMissileRouteH\x00R\x0faddMissileRoute\x12Y\n" +
+	"\x14rename_missile_route\x18\x18 \x01(\v2%.lightspeedduel.ws.RenameMissileRouteH\x00R\x12renameMissileRoute\x12Y\n" +
+	"\x14delete_missile_route\x18\x19 \x01(\v2%.lightspeedduel.ws.DeleteMissileRouteH\x00R\x12deleteMissileRoute\x12c\n" +
+	"\x18set_active_missile_route\x18\x1a \x01(\v2(.lightspeedduel.ws.SetActiveMissileRouteH\x00R\x15setActiveMissileRoute\x12I\n" +
+	"\x0elaunch_missile\x18\x1b \x01(\v2 .lightspeedduel.ws.LaunchMissileH\x00R\rlaunchMissile\x12G\n" +
+	"\x0euse_repair_kit\x18\x1c \x01(\v2\x1f.lightspeedduel.ws.UseRepairKitH\x00R\fuseRepairKit\x12:\n" +
+	"\tdag_start\x18\x1e \x01(\v2\x1b.lightspeedduel.ws.DagStartH\x00R\bdagStart\x12=\n" +
+	"\n" +
+	"dag_cancel\x18\x1f \x01(\v2\x1c.lightspeedduel.ws.DagCancelH\x00R\tdagCancel\x12D\n" +
+	"\rdag_story_ack\x18  \x01(\v2\x1e.lightspeedduel.ws.DagStoryAckH\x00R\vdagStoryAck\x127\n" +
+	"\bdag_list\x18! \x01(\v2\x1a.lightspeedduel.ws.DagListH\x00R\adagList\x12S\n" +
 	"\x12mission_spawn_wave\x18( \x01(\v2#.lightspeedduel.ws.MissionSpawnWaveH\x00R\x10missionSpawnWave\x12V\n" +
 	"\x13mission_story_event\x18) \x01(\v2$.lightspeedduel.ws.MissionStoryEventH\x00R\x11missionStoryEvent\x12P\n" +
 	"\x11dag_list_response\x182 \x01(\v2\".lightspeedduel.ws.DagListResponseH\x00R\x0fdagListResponse\x12b\n" +
 	"\x17mission_beacon_snapshot\x18< \x01(\v2(.lightspeedduel.ws.MissionBeaconSnapshotH\x00R\x15missionBeaconSnapshot\x12Y\n" +
-	"\x14mission_beacon_delta\x18= \x01(\v2%.lightspeedduel.ws.MissionBeaconDeltaH\x00R\x12missionBeaconDeltaB\t\n" +
+	"\x14mission_beacon_delta\x18= \x01(\v2%.lightspeedduel.ws.MissionBeaconDeltaH\x00R\x12missionBeaconDelta\x12I\n" +
+	"\x0eemergency_vent\x18F \x01(\v2 .lightspeedduel.ws.EmergencyVentH\x00R\remergencyVent\x12O\n" +
+	"\x10deploy_radiators\x18G \x01(\v2\".lightspeedduel.ws.DeployRadiatorsH\x00R\x0fdeployRadiators\x12D\n" +
+	"\ruse_heat_sink\x18H \x01(\v2\x1e.lightspeedduel.ws.UseHeatSinkH\x00R\vuseHeatSink\x12@\n" +
+	"\vadd_wingman\x18I \x01(\v2\x1d.lightspeedduel.ws.AddWingmanH\x00R\n" +
+	"addWingman\x12F\n" +
+	"\rwingman_order\x18J \x01(\v2\x1f.lightspeedduel.ws.WingmanOrderH\x00R\fwingmanOrderB\t\n" +
 	"\apayload\"\xcf\x06\n" +
 	"\vStateUpdate\x12\x10\n" +
 	"\x03now\x18\x01 \x01(\x01R\x03now\x12(\n" +
@@ -4502,15 +5371,24 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\x06_storyB\x0f\n" +
 	"\r_capabilities\")\n" +
 	"\rRoomFullError\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"^\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"r\n" +
 	"\n" +
 	"ClientJoin\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04room\x18\x02 \x01(\tR\x04room\x12\x13\n" +
 	"\x05map_w\x18\x03 \x01(\x01R\x04mapW\x12\x13\n" +
-	"\x05map_h\x18\x04 \x01(\x01R\x04mapH\"\n" +
+	"\x05map_h\x18\x04 \x01(\x01R\x04mapH\x12\x12\n" +
+	"\x04hull\x18\x05 \x01(\tR\x04hull\"\x8c\x01\n" +
+	"\bSpawnBot\x12\x1a\n" +
+	"\bbehavior\x18\x01 \x01(\tR\bbehavior\x12\x1e\n" +
 	"\n" +
-	"\bSpawnBot\"?\n" +
+	"difficulty\x18\x02 \x01(\tR\n" +
+	"difficulty\x12\x12\n" +
+	"\x04hull\x18\x03 \x01(\tR\x04hull\x12\x11\n" +
+	"\x01x\x18\x04 \x01(\x01H\x00R\x01x\x88\x01\x01\x12\x11\n" +
+	"\x01y\x18\x05 \x01(\x01H\x01R\x01y\x88\x01\x01B\x04\n" +
+	"\x02_xB\x04\n" +
+	"\x02_y\"?\n" +
 	"\vAddWaypoint\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x01R\x01y\x12\x14\n" +
@@ -4524,10 +5402,12 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\x01y\x18\x03 \x01(\x01R\x01y\"&\n" +
 	"\x0eDeleteWaypoint\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\x05R\x05index\"\x10\n" +
-	"\x0eClearWaypoints\"Z\n" +
+	"\x0eClearWaypoints\"\xd0\x01\n" +
 	"\x10ConfigureMissile\x12#\n" +
 	"\rmissile_speed\x18\x01 \x01(\x01R\fmissileSpeed\x12!\n" +
-	"\fmissile_agro\x18\x02 \x01(\x01R\vmissileAgro\"a\n" +
+	"\fmissile_agro\x18\x02 \x01(\x01R\vmissileAgro\x12>\n" +
+	"\bguidance\x18\x03 \x01(\x0e2\".lightspeedduel.ws.MissileGuidanceR\bguidance\x124\n" +
+	"\awarhead\x18\x04 \x01(\x0e2\x1a.lightspeedduel.ws.WarheadR\awarhead\"a\n" +
 	"\x12AddMissileWaypoint\x12\x19\n" +
 	"\broute_id\x18\x01 \x01(\tR\arouteId\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
@@ -4557,7 +5437,7 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\x15SetActiveMissileRoute\x12\x19\n" +
 	"\broute_id\x18\x01 \x01(\tR\arouteId\"*\n" +
 	"\rLaunchMissile\x12\x19\n" +
-	"\broute_id\x18\x01 \x01(\tR\arouteId\"\xcf\x02\n" +
+	"\broute_id\x18\x01 \x01(\tR\arouteId\"\xcc\x05\n" +
 	"\x05Ghost\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
@@ -4571,8 +5451,20 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\x02hp\x18\n" +
 	" \x01(\x05R\x02hp\x12\x14\n" +
 	"\x05kills\x18\v \x01(\x05R\x05kills\x128\n" +
-	"\x04heat\x18\f \x01(\v2\x1f.lightspeedduel.ws.ShipHeatViewH\x00R\x04heat\x88\x01\x01B\a\n" +
-	"\x05_heat\"<\n" +
+	"\x04heat\x18\f \x01(\v2\x1f.lightspeedduel.ws.ShipHeatViewH\x00R\x04heat\x88\x01\x01\x12M\n" +
+	"\rpoint_defense\x18\r \x01(\v2#.lightspeedduel.ws.PointDefenseViewH\x01R\fpointDefense\x88\x01\x01\x12\x12\n" +
+	"\x04hull\x18\x0e \x01(\tR\x04hull\x12\x15\n" +
+	"\x06max_hp\x18\x0f \x01(\x05R\x05maxHp\x12I\n" +
+	"\n" +
+	"subsystems\x18\x10 \x01(\v2$.lightspeedduel.ws.ShipSubsystemViewH\x02R\n" +
+	"subsystems\x88\x01\x01\x12P\n" +
+	"\x0eheat_abilities\x18\x11 \x01(\v2$.lightspeedduel.ws.HeatAbilitiesViewH\x03R\rheatAbilities\x88\x01\x01\x12\x18\n" +
+	"\aventing\x18\x12 \x01(\bR\aventing\x12\x16\n" +
+	"\x06allied\x18\x13 \x01(\bR\x06alliedB\a\n" +
+	"\x05_heatB\x10\n" +
+	"\x0e_point_defenseB\r\n" +
+	"\v_subsystemsB\x11\n" +
+	"\x0f_heat_abilities\"<\n" +
 	"\bWaypoint\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x01R\x01y\x12\x14\n" +
@@ -4580,7 +5472,7 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\bRoomMeta\x12\f\n" +
 	"\x01c\x18\x01 \x01(\x01R\x01c\x12\f\n" +
 	"\x01w\x18\x02 \x01(\x01R\x01w\x12\f\n" +
-	"\x01h\x18\x03 \x01(\x01R\x01h\"\xea\x02\n" +
+	"\x01h\x18\x03 \x01(\x01R\x01h\"\x83\x04\n" +
 	"\aMissile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
@@ -4599,8 +5491,11 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\f \x01(\x01R\texpiresAt\x12\x1b\n" +
 	"\ttarget_id\x18\r \x01(\tR\btargetId\x128\n" +
-	"\x04heat\x18\x0e \x01(\v2\x1f.lightspeedduel.ws.ShipHeatViewH\x00R\x04heat\x88\x01\x01B\a\n" +
-	"\x05_heat\"\x8c\x02\n" +
+	"\x04heat\x18\x0e \x01(\v2\x1f.lightspeedduel.ws.ShipHeatViewH\x00R\x04heat\x88\x01\x01\x12>\n" +
+	"\bguidance\x18\x0f \x01(\x0e2\".lightspeedduel.ws.MissileGuidanceR\bguidance\x124\n" +
+	"\awarhead\x18\x10 \x01(\x0e2\x1a.lightspeedduel.ws.WarheadR\awarhead\x12!\n" +
+	"\fblast_radius\x18\x11 \x01(\x01R\vblastRadiusB\a\n" +
+	"\x05_heat\"\x82\x03\n" +
 	"\rMissileConfig\x12\x14\n" +
 	"\x05speed\x18\x01 \x01(\x01R\x05speed\x12\x1b\n" +
 	"\tspeed_min\x18\x02 \x01(\x01R\bspeedMin\x12\x1b\n" +
@@ -4610,7 +5505,9 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"agroRadius\x12\x1a\n" +
 	"\blifetime\x18\x06 \x01(\x01R\blifetime\x12C\n" +
 	"\vheat_config\x18\a \x01(\v2\x1d.lightspeedduel.ws.HeatParamsH\x00R\n" +
-	"heatConfig\x88\x01\x01B\x0e\n" +
+	"heatConfig\x88\x01\x01\x12>\n" +
+	"\bguidance\x18\b \x01(\x0e2\".lightspeedduel.ws.MissileGuidanceR\bguidance\x124\n" +
+	"\awarhead\x18\t \x01(\x0e2\x1a.lightspeedduel.ws.WarheadR\awarheadB\x0e\n" +
 	"\f_heat_config\"m\n" +
 	"\fMissileRoute\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -4642,12 +5539,17 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"multiplier\x18\x02 \x01(\x01H\x00R\n" +
 	"multiplier\x12\x1d\n" +
 	"\tunlock_id\x18\x03 \x01(\tH\x00R\bunlockIdB\a\n" +
-	"\x05value\"\xba\x01\n" +
+	"\x05value\"\xe7\x02\n" +
 	"\x12PlayerCapabilities\x12)\n" +
 	"\x10speed_multiplier\x18\x01 \x01(\x01R\x0fspeedMultiplier\x12+\n" +
 	"\x11unlocked_missiles\x18\x02 \x03(\tR\x10unlockedMissiles\x12#\n" +
 	"\rheat_capacity\x18\x03 \x01(\x01R\fheatCapacity\x12'\n" +
-	"\x0fheat_efficiency\x18\x04 \x01(\x01R\x0eheatEfficiency\"\xb9\x02\n" +
+	"\x0fheat_efficiency\x18\x04 \x01(\x01R\x0eheatEfficiency\x12\x12\n" +
+	"\x04hull\x18\x05 \x01(\tR\x04hull\x12>\n" +
+	"\x1bmissile_cooldown_multiplier\x18\x06 \x01(\x01R\x19missileCooldownMultiplier\x12\x1b\n" +
+	"\tmax_speed\x18\a \x01(\x01R\bmaxSpeed\x12\x15\n" +
+	"\x06max_hp\x18\b \x01(\x05R\x05maxHp\x12#\n" +
+	"\rwingman_slots\x18\t \x01(\x05R\fwingmanSlots\"\xb9\x02\n" +
 	"\aDagNode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\x04kind\x18\x02 \x01(\x0e2\x1e.lightspeedduel.ws.DagNodeKindR\x04kind\x12\x14\n" +
@@ -4789,7 +5691,32 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"spawned_at\x18\x05 \x01(\x01R\tspawnedAt\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x06 \x01(\x01R\texpiresAt\x12\x16\n" +
-	"\x06reason\x18\a \x01(\tR\x06reason*\xab\x01\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"|\n" +
+	"\x10PointDefenseView\x12\x14\n" +
+	"\x05range\x18\x01 \x01(\x01R\x05range\x12\x1a\n" +
+	"\bcooldown\x18\x02 \x01(\x01R\bcooldown\x12\x1b\n" +
+	"\theat_cost\x18\x03 \x01(\x01R\bheatCost\x12\x19\n" +
+	"\bready_at\x18\x04 \x01(\x01R\areadyAt\"\x0e\n" +
+	"\fUseRepairKit\"\xa6\x01\n" +
+	"\x11ShipSubsystemView\x12\x18\n" +
+	"\aengines\x18\x01 \x01(\x01R\aengines\x12\x18\n" +
+	"\asensors\x18\x02 \x01(\x01R\asensors\x12\x1c\n" +
+	"\tlaunchers\x18\x03 \x01(\x01R\tlaunchers\x12\x1c\n" +
+	"\tradiators\x18\x04 \x01(\x01R\tradiators\x12!\n" +
+	"\frepair_after\x18\x05 \x01(\x01R\vrepairAfter\"\x0f\n" +
+	"\rEmergencyVent\"-\n" +
+	"\x0fDeployRadiators\x12\x1a\n" +
+	"\bdeployed\x18\x01 \x01(\bR\bdeployed\"\r\n" +
+	"\vUseHeatSink\"\f\n" +
+	"\n" +
+	"AddWingman\"$\n" +
+	"\fWingmanOrder\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\tR\x05order\"\xb6\x01\n" +
+	"\x11HeatAbilitiesView\x12\"\n" +
+	"\rvent_ready_at\x18\x01 \x01(\x01R\vventReadyAt\x12\"\n" +
+	"\rsink_ready_at\x18\x02 \x01(\x01R\vsinkReadyAt\x12-\n" +
+	"\x12radiators_deployed\x18\x03 \x01(\bR\x11radiatorsDeployed\x12*\n" +
+	"\x11radiator_ready_at\x18\x04 \x01(\x01R\x0fradiatorReadyAt*\xab\x01\n" +
 	"\rDagNodeStatus\x12\x1f\n" +
 	"\x1bDAG_NODE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16DAG_NODE_STATUS_LOCKED\x10\x01\x12\x1d\n" +
@@ -4801,13 +5728,14 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\x15DAG_NODE_KIND_FACTORY\x10\x01\x12\x16\n" +
 	"\x12DAG_NODE_KIND_UNIT\x10\x02\x12\x17\n" +
 	"\x13DAG_NODE_KIND_STORY\x10\x03\x12\x17\n" +
-	"\x13DAG_NODE_KIND_CRAFT\x10\x04*\xda\x01\n" +
+	"\x13DAG_NODE_KIND_CRAFT\x10\x04*\x80\x02\n" +
 	"\x11UpgradeEffectType\x12#\n" +
 	"\x1fUPGRADE_EFFECT_TYPE_UNSPECIFIED\x10\x00\x12(\n" +
 	"$UPGRADE_EFFECT_TYPE_SPEED_MULTIPLIER\x10\x01\x12&\n" +
 	"\"UPGRADE_EFFECT_TYPE_MISSILE_UNLOCK\x10\x02\x12%\n" +
 	"!UPGRADE_EFFECT_TYPE_HEAT_CAPACITY\x10\x03\x12'\n" +
-	"#UPGRADE_EFFECT_TYPE_HEAT_EFFICIENCY\x10\x04*\\\n" +
+	"#UPGRADE_EFFECT_TYPE_HEAT_EFFICIENCY\x10\x04\x12$\n" +
+	" UPGRADE_EFFECT_TYPE_WINGMAN_SLOT\x10\x05*\\\n" +
 	"\vStoryIntent\x12\x1c\n" +
 	"\x18STORY_INTENT_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14STORY_INTENT_FACTORY\x10\x01\x12\x15\n" +
@@ -4819,13 +5747,24 @@ const file_proto_ws_messages_proto_rawDesc = "" +
 	"\x1fMISSION_BEACON_DELTA_HOLD_RESET\x10\x03\x12\x1f\n" +
 	"\x1bMISSION_BEACON_DELTA_LOCKED\x10\x04\x12!\n" +
 	"\x1dMISSION_BEACON_DELTA_COOLDOWN\x10\x05\x12*\n" +
-	"&MISSION_BEACON_DELTA_MISSION_COMPLETED\x10\x06*\xd7\x01\n" +
+	"&MISSION_BEACON_DELTA_MISSION_COMPLETED\x10\x06*\x85\x02\n" +
 	"\x19MissionEncounterEventType\x12'\n" +
 	"#MISSION_ENCOUNTER_EVENT_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fMISSION_ENCOUNTER_EVENT_SPAWNED\x10\x01\x12#\n" +
 	"\x1fMISSION_ENCOUNTER_EVENT_CLEARED\x10\x02\x12#\n" +
 	"\x1fMISSION_ENCOUNTER_EVENT_TIMEOUT\x10\x03\x12\"\n" +
-	"\x1eMISSION_ENCOUNTER_EVENT_PURGED\x10\x04B\"Z LightSpeedDuel/internal/proto/wsb\x06proto3"
+	"\x1eMISSION_ENCOUNTER_EVENT_PURGED\x10\x04\x12,\n" +
+	"(MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED\x10\x05*p\n" +
+	"\x0fMissileGuidance\x12 \n" +
+	"\x1cMISSILE_GUIDANCE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15MISSILE_GUIDANCE_SHIP\x10\x01\x12 \n" +
+	"\x1cMISSILE_GUIDANCE_INTERCEPTOR\x10\x02*\x8a\x01\n" +
+	"\aWarhead\x12\x17\n" +
+	"\x13WARHEAD_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fWARHEAD_KINETIC\x10\x01\x12\x19\n" +
+	"\x15WARHEAD_FRAGMENTATION\x10\x02\x12\x1a\n" +
+	"\x16WARHEAD_HIGH_EXPLOSIVE\x10\x03\x12\x1a\n" +
+	"\x16WARHEAD_PROXIMITY_MINE\x10\x04B\"Z LightSpeedDuel/internal/proto/wsb\x06proto3"
 
 var (
 	file_proto_ws_messages_proto_rawDescOnce sync.Once
@@ -4839,8 +5778,8 @@ func file_proto_ws_messages_proto_rawDescGZIP() []byte {
 	return file_proto_ws_messages_proto_rawDescData
 }
 
-var file_proto_ws_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_proto_ws_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_proto_ws_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_ws_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_proto_ws_messages_proto_goTypes = []any{
 	(DagNodeStatus)(0),                  // 0: lightspeedduel.ws.DagNodeStatus
 	(DagNodeKind)(0),                    // 1: lightspeedduel.ws.DagNodeKind
@@ -4848,135 +5787,161 @@ var file_proto_ws_messages_proto_goTypes = []any{
 	(StoryIntent)(0),                    // 3: lightspeedduel.ws.StoryIntent
 	(MissionBeaconDeltaType)(0),         // 4: lightspeedduel.ws.MissionBeaconDeltaType
 	(MissionEncounterEventType)(0),      // 5: lightspeedduel.ws.MissionEncounterEventType
-	(*WsEnvelope)(nil),                  // 6: lightspeedduel.ws.WsEnvelope
-	(*StateUpdate)(nil),                 // 7: lightspeedduel.ws.StateUpdate
-	(*RoomFullError)(nil),               // 8: lightspeedduel.ws.RoomFullError
-	(*ClientJoin)(nil),                  // 9: lightspeedduel.ws.ClientJoin
-	(*SpawnBot)(nil),                    // 10: lightspeedduel.ws.SpawnBot
-	(*AddWaypoint)(nil),                 // 11: lightspeedduel.ws.AddWaypoint
-	(*UpdateWaypoint)(nil),              // 12: lightspeedduel.ws.UpdateWaypoint
-	(*MoveWaypoint)(nil),                // 13: lightspeedduel.ws.MoveWaypoint
-	(*DeleteWaypoint)(nil),              // 14: lightspeedduel.ws.DeleteWaypoint
-	(*ClearWaypoints)(nil),              // 15: lightspeedduel.ws.ClearWaypoints
-	(*ConfigureMissile)(nil),            // 16: lightspeedduel.ws.ConfigureMissile
-	(*AddMissileWaypoint)(nil),          // 17: lightspeedduel.ws.AddMissileWaypoint
-	(*UpdateMissileWaypointSpeed)(nil),  // 18: lightspeedduel.ws.UpdateMissileWaypointSpeed
-	(*MoveMissileWaypoint)(nil),         // 19: lightspeedduel.ws.MoveMissileWaypoint
-	(*DeleteMissileWaypoint)(nil),       // 20: lightspeedduel.ws.DeleteMissileWaypoint
-	(*ClearMissileRoute)(nil),           // 21: lightspeedduel.ws.ClearMissileRoute
-	(*AddMissileRoute)(nil),             // 22: lightspeedduel.ws.AddMissileRoute
-	(*RenameMissileRoute)(nil),          // 23: lightspeedduel.ws.RenameMissileRoute
-	(*DeleteMissileRoute)(nil),          // 24: lightspeedduel.ws.DeleteMissileRoute
-	(*SetActiveMissileRoute)(nil),       // 25: lightspeedduel.ws.SetActiveMissileRoute
-	(*LaunchMissile)(nil),               // 26: lightspeedduel.ws.LaunchMissile
-	(*Ghost)(nil),                       // 27: lightspeedduel.ws.Ghost
-	(*Waypoint)(nil),                    // 28: lightspeedduel.ws.Waypoint
-	(*RoomMeta)(nil),                    // 29: lightspeedduel.ws.RoomMeta
-	(*Missile)(nil),                     // 30: lightspeedduel.ws.Missile
-	(*MissileConfig)(nil),               // 31: lightspeedduel.ws.MissileConfig
-	(*MissileRoute)(nil),                // 32: lightspeedduel.ws.MissileRoute
-	(*ShipHeatView)(nil),                // 33: lightspeedduel.ws.ShipHeatView
-	(*HeatParams)(nil),                  // 34: lightspeedduel.ws.HeatParams
-	(*UpgradeEffect)(nil),               // 35: lightspeedduel.ws.UpgradeEffect
-	(*PlayerCapabilities)(nil),          // 36: lightspeedduel.ws.PlayerCapabilities
-	(*DagNode)(nil),                     // 37: lightspeedduel.ws.DagNode
-	(*DagState)(nil),                    // 38: lightspeedduel.ws.DagState
-	(*DagStart)(nil),                    // 39: lightspeedduel.ws.DagStart
-	(*DagCancel)(nil),                   // 40: lightspeedduel.ws.DagCancel
-	(*DagStoryAck)(nil),                 // 41: lightspeedduel.ws.DagStoryAck
-	(*DagList)(nil),                     // 42: lightspeedduel.ws.DagList
-	(*DagListResponse)(nil),             // 43: lightspeedduel.ws.DagListResponse
-	(*InventoryItem)(nil),               // 44: lightspeedduel.ws.InventoryItem
-	(*Inventory)(nil),                   // 45: lightspeedduel.ws.Inventory
-	(*StoryDialogueChoice)(nil),         // 46: lightspeedduel.ws.StoryDialogueChoice
-	(*StoryTutorialTip)(nil),            // 47: lightspeedduel.ws.StoryTutorialTip
-	(*StoryDialogue)(nil),               // 48: lightspeedduel.ws.StoryDialogue
-	(*StoryEvent)(nil),                  // 49: lightspeedduel.ws.StoryEvent
-	(*StoryState)(nil),                  // 50: lightspeedduel.ws.StoryState
-	(*MissionSpawnWave)(nil),            // 51: lightspeedduel.ws.MissionSpawnWave
-	(*MissionStoryEvent)(nil),           // 52: lightspeedduel.ws.MissionStoryEvent
-	(*MissionBeaconSnapshot)(nil),       // 53: lightspeedduel.ws.MissionBeaconSnapshot
-	(*MissionBeaconDefinition)(nil),     // 54: lightspeedduel.ws.MissionBeaconDefinition
-	(*MissionBeaconPlayer)(nil),         // 55: lightspeedduel.ws.MissionBeaconPlayer
-	(*MissionBeaconDelta)(nil),          // 56: lightspeedduel.ws.MissionBeaconDelta
-	(*MissionBeaconPlayerDelta)(nil),    // 57: lightspeedduel.ws.MissionBeaconPlayerDelta
-	(*MissionBeaconEncounter)(nil),      // 58: lightspeedduel.ws.MissionBeaconEncounter
-	(*MissionBeaconEncounterEvent)(nil), // 59: lightspeedduel.ws.MissionBeaconEncounterEvent
-	nil,                                 // 60: lightspeedduel.ws.StoryState.FlagsEntry
-	nil,                                 // 61: lightspeedduel.ws.MissionBeaconPlayer.CooldownsEntry
+	(MissileGuidance)(0),                // 6: lightspeedduel.ws.MissileGuidance
+	(Warhead)(0),                        // 7: lightspeedduel.ws.Warhead
+	(*WsEnvelope)(nil),                  // 8: lightspeedduel.ws.WsEnvelope
+	(*StateUpdate)(nil),                 // 9: lightspeedduel.ws.StateUpdate
+	(*RoomFullError)(nil),               // 10: lightspeedduel.ws.RoomFullError
+	(*ClientJoin)(nil),                  // 11: lightspeedduel.ws.ClientJoin
+	(*SpawnBot)(nil),                    // 12: lightspeedduel.ws.SpawnBot
+	(*AddWaypoint)(nil),                 // 13: lightspeedduel.ws.AddWaypoint
+	(*UpdateWaypoint)(nil),              // 14: lightspeedduel.ws.UpdateWaypoint
+	(*MoveWaypoint)(nil),                // 15: lightspeedduel.ws.MoveWaypoint
+	(*DeleteWaypoint)(nil),              // 16: lightspeedduel.ws.DeleteWaypoint
+	(*ClearWaypoints)(nil),              // 17: lightspeedduel.ws.ClearWaypoints
+	(*ConfigureMissile)(nil),            // 18: lightspeedduel.ws.ConfigureMissile
+	(*AddMissileWaypoint)(nil),          // 19: lightspeedduel.ws.AddMissileWaypoint
+	(*UpdateMissileWaypointSpeed)(nil),  // 20: lightspeedduel.ws.UpdateMissileWaypointSpeed
+	(*MoveMissileWaypoint)(nil),         // 21: lightspeedduel.ws.MoveMissileWaypoint
+	(*DeleteMissileWaypoint)(nil),       // 22: lightspeedduel.ws.DeleteMissileWaypoint
+	(*ClearMissileRoute)(nil),           // 23: lightspeedduel.ws.ClearMissileRoute
+	(*AddMissileRoute)(nil),             // 24: lightspeedduel.ws.AddMissileRoute
+	(*RenameMissileRoute)(nil),          // 25: lightspeedduel.ws.RenameMissileRoute
+	(*DeleteMissileRoute)(nil),          // 26: lightspeedduel.ws.DeleteMissileRoute
+	(*SetActiveMissileRoute)(nil),       // 27: lightspeedduel.ws.SetActiveMissileRoute
+	(*LaunchMissile)(nil),               // 28: lightspeedduel.ws.LaunchMissile
+	(*Ghost)(nil),                       // 29: lightspeedduel.ws.Ghost
+	(*Waypoint)(nil),                    // 30: lightspeedduel.ws.Waypoint
+	(*RoomMeta)(nil),                    // 31: lightspeedduel.ws.RoomMeta
+	(*Missile)(nil),                     // 32: lightspeedduel.ws.Missile
+	(*MissileConfig)(nil),               // 33: lightspeedduel.ws.MissileConfig
+	(*MissileRoute)(nil),                // 34: lightspeedduel.ws.MissileRoute
+	(*ShipHeatView)(nil),                // 35: lightspeedduel.ws.ShipHeatView
+	(*HeatParams)(nil),                  // 36: lightspeedduel.ws.HeatParams
+	(*UpgradeEffect)(nil),               // 37: lightspeedduel.ws.UpgradeEffect
+	(*PlayerCapabilities)(nil),          // 38: lightspeedduel.ws.PlayerCapabilities
+	(*DagNode)(nil),                     // 39: lightspeedduel.ws.DagNode
+	(*DagState)(nil),                    // 40: lightspeedduel.ws.DagState
+	(*DagStart)(nil),                    // 41: lightspeedduel.ws.DagStart
+	(*DagCancel)(nil),                   // 42: lightspeedduel.ws.DagCancel
+	(*DagStoryAck)(nil),                 // 43: lightspeedduel.ws.DagStoryAck
+	(*DagList)(nil),                     // 44: lightspeedduel.ws.DagList
+	(*DagListResponse)(nil),             // 45: lightspeedduel.ws.DagListResponse
+	(*InventoryItem)(nil),               // 46: lightspeedduel.ws.InventoryItem
+	(*Inventory)(nil),                   // 47: lightspeedduel.ws.Inventory
+	(*StoryDialogueChoice)(nil),         // 48: lightspeedduel.ws.StoryDialogueChoice
+	(*StoryTutorialTip)(nil),            // 49: lightspeedduel.ws.StoryTutorialTip
+	(*StoryDialogue)(nil),               // 50: lightspeedduel.ws.StoryDialogue
+	(*StoryEvent)(nil),                  // 51: lightspeedduel.ws.StoryEvent
+	(*StoryState)(nil),                  // 52: lightspeedduel.ws.StoryState
+	(*MissionSpawnWave)(nil),            // 53: lightspeedduel.ws.MissionSpawnWave
+	(*MissionStoryEvent)(nil),           // 54: lightspeedduel.ws.MissionStoryEvent
+	(*MissionBeaconSnapshot)(nil),       // 55: lightspeedduel.ws.MissionBeaconSnapshot
+	(*MissionBeaconDefinition)(nil),     // 56: lightspeedduel.ws.MissionBeaconDefinition
+	(*MissionBeaconPlayer)(nil),         // 57: lightspeedduel.ws.MissionBeaconPlayer
+	(*MissionBeaconDelta)(nil),          // 58: lightspeedduel.ws.MissionBeaconDelta
+	(*MissionBeaconPlayerDelta)(nil),    // 59: lightspeedduel.ws.MissionBeaconPlayerDelta
+	(*MissionBeaconEncounter)(nil),      // 60: lightspeedduel.ws.MissionBeaconEncounter
+	(*MissionBeaconEncounterEvent)(nil), // 61: lightspeedduel.ws.MissionBeaconEncounterEvent
+	(*PointDefenseView)(nil),            // 62: lightspeedduel.ws.PointDefenseView
+	(*UseRepairKit)(nil),                // 63: lightspeedduel.ws.UseRepairKit
+	(*ShipSubsystemView)(nil),           // 64: lightspeedduel.ws.ShipSubsystemView
+	(*EmergencyVent)(nil),               // 65: lightspeedduel.ws.EmergencyVent
+	(*DeployRadiators)(nil),             // 66: lightspeedduel.ws.DeployRadiators
+	(*UseHeatSink)(nil),                 // 67: lightspeedduel.ws.UseHeatSink
+	(*AddWingman)(nil),                  // 68: lightspeedduel.ws.AddWingman
+	(*WingmanOrder)(nil),                // 69: lightspeedduel.ws.WingmanOrder
+	(*HeatAbilitiesView)(nil),           // 70: lightspeedduel.ws.HeatAbilitiesView
+	nil,                                 // 71: lightspeedduel.ws.StoryState.FlagsEntry
+	nil,                                 // 72: lightspeedduel.ws.MissionBeaconPlayer.CooldownsEntry
 }
 var file_proto_ws_messages_proto_depIdxs = []int32{
-	7,  // 0: lightspeedduel.ws.WsEnvelope.state_update:type_name -> lightspeedduel.ws.StateUpdate
-	8,  // 1: lightspeedduel.ws.WsEnvelope.room_full:type_name -> lightspeedduel.ws.RoomFullError
-	9,  // 2: lightspeedduel.ws.WsEnvelope.join:type_name -> lightspeedduel.ws.ClientJoin
-	10, // 3: lightspeedduel.ws.WsEnvelope.spawn_bot:type_name -> lightspeedduel.ws.SpawnBot
-	11, // 4: lightspeedduel.ws.WsEnvelope.add_waypoint:type_name -> lightspeedduel.ws.AddWaypoint
-	12, // 5: lightspeedduel.ws.WsEnvelope.update_waypoint:type_name -> lightspeedduel.ws.UpdateWaypoint
-	13, // 6: lightspeedduel.ws.WsEnvelope.move_waypoint:type_name -> lightspeedduel.ws.MoveWaypoint
-	14, // 7: lightspeedduel.ws.WsEnvelope.delete_waypoint:type_name -> lightspeedduel.ws.DeleteWaypoint
-	15, // 8: lightspeedduel.ws.WsEnvelope.clear_waypoints:type_name -> lightspeedduel.ws.ClearWaypoints
-	16, // 9: lightspeedduel.ws.WsEnvelope.configure_missile:type_name -> lightspeedduel.ws.ConfigureMissile
-	17, // 10: lightspeedduel.ws.WsEnvelope.add_missile_waypoint:type_name -> lightspeedduel.ws.AddMissileWaypoint
-	18, // 11: lightspeedduel.ws.WsEnvelope.update_missile_waypoint_speed:type_name -> lightspeedduel.ws.UpdateMissileWaypointSpeed
-	19, // 12: lightspeedduel.ws.WsEnvelope.move_missile_waypoint:type_name -> lightspeedduel.ws.MoveMissileWaypoint
-	20, // 13: lightspeedduel.ws.WsEnvelope.delete_missile_waypoint:type_name -> lightspeedduel.ws.DeleteMissileWaypoint
-	21, // 14: lightspeedduel.ws.WsEnvelope.clear_missile_route:type_name -> lightspeedduel.ws.ClearMissileRoute
-	22, // 15: lightspeedduel.ws.WsEnvelope.add_missile_route:type_name -> lightspeedduel.ws.AddMissileRoute
-	23, // 16: lightspeedduel.ws.WsEnvelope.rename_missile_route:type_name -> lightspeedduel.ws.RenameMissileRoute
-	24, // 17: lightspeedduel.ws.WsEnvelope.delete_missile_route:type_name -> lightspeedduel.ws.DeleteMissileRoute
-	25, // 18: lightspeedduel.ws.WsEnvelope.set_active_missile_route:type_name -> lightspeedduel.ws.SetActiveMissileRoute
-	26, // 19: lightspeedduel.ws.WsEnvelope.launch_missile:type_name -> lightspeedduel.ws.LaunchMissile
-	39, // 20: lightspeedduel.ws.WsEnvelope.dag_start:type_name -> lightspeedduel.ws.DagStart
-	40, // 21: lightspeedduel.ws.WsEnvelope.dag_cancel:type_name -> lightspeedduel.ws.DagCancel
-	41, // 22: lightspeedduel.ws.WsEnvelope.dag_story_ack:type_name -> lightspeedduel.ws.DagStoryAck
-	42, // 23: lightspeedduel.ws.WsEnvelope.dag_list:type_name -> lightspeedduel.ws.DagList
-	51, // 24: lightspeedduel.ws.WsEnvelope.mission_spawn_wave:type_name -> lightspeedduel.ws.MissionSpawnWave
-	52, // 25: lightspeedduel.ws.WsEnvelope.mission_story_event:type_name -> lightspeedduel.ws.MissionStoryEvent
-	43, // 26: lightspeedduel.ws.WsEnvelope.dag_list_response:type_name -> lightspeedduel.ws.DagListResponse
-	53, // 27: lightspeedduel.ws.WsEnvelope.mission_beacon_snapshot:type_name -> lightspeedduel.ws.MissionBeaconSnapshot
-	56, // 28: lightspeedduel.ws.WsEnvelope.mission_beacon_delta:type_name -> lightspeedduel.ws.MissionBeaconDelta
-	27, // 29: lightspeedduel.ws.StateUpdate.me:type_name -> lightspeedduel.ws.Ghost
-	27, // 30: lightspeedduel.ws.StateUpdate.ghosts:type_name -> lightspeedduel.ws.Ghost
-	29, // 31: lightspeedduel.ws.StateUpdate.meta:type_name -> lightspeedduel.ws.RoomMeta
-	30, // 32: lightspeedduel.ws.StateUpdate.missiles:type_name -> lightspeedduel.ws.Missile
-	31, // 33: lightspeedduel.ws.StateUpdate.missile_config:type_name -> lightspeedduel.ws.MissileConfig
-	28, // 34: lightspeedduel.ws.StateUpdate.missile_waypoints:type_name -> lightspeedduel.ws.Waypoint
-	32, // 35: lightspeedduel.ws.StateUpdate.missile_routes:type_name -> lightspeedduel.ws.MissileRoute
-	38, // 36: lightspeedduel.ws.StateUpdate.dag:type_name -> lightspeedduel.ws.DagState
-	45, // 37: lightspeedduel.ws.StateUpdate.inventory:type_name -> lightspeedduel.ws.Inventory
-	50, // 38: lightspeedduel.ws.StateUpdate.story:type_name -> lightspeedduel.ws.StoryState
-	36, // 39: lightspeedduel.ws.StateUpdate.capabilities:type_name -> lightspeedduel.ws.PlayerCapabilities
-	28, // 40: lightspeedduel.ws.Ghost.waypoints:type_name -> lightspeedduel.ws.Waypoint
-	33, // 41: lightspeedduel.ws.Ghost.heat:type_name -> lightspeedduel.ws.ShipHeatView
-	33, // 42: lightspeedduel.ws.Missile.heat:type_name -> lightspeedduel.ws.ShipHeatView
-	34, // 43: lightspeedduel.ws.MissileConfig.heat_config:type_name -> lightspeedduel.ws.HeatParams
-	28, // 44: lightspeedduel.ws.MissileRoute.waypoints:type_name -> lightspeedduel.ws.Waypoint
-	2,  // 45: lightspeedduel.ws.UpgradeEffect.type:type_name -> lightspeedduel.ws.UpgradeEffectType
-	1,  // 46: lightspeedduel.ws.DagNode.kind:type_name -> lightspeedduel.ws.DagNodeKind
-	0,  // 47: lightspeedduel.ws.DagNode.status:type_name -> lightspeedduel.ws.DagNodeStatus
-	35, // 48: lightspeedduel.ws.DagNode.effects:type_name -> lightspeedduel.ws.UpgradeEffect
-	37, // 49: lightspeedduel.ws.DagState.nodes:type_name -> lightspeedduel.ws.DagNode
-	38, // 50: lightspeedduel.ws.DagListResponse.dag:type_name -> lightspeedduel.ws.DagState
-	44, // 51: lightspeedduel.ws.Inventory.items:type_name -> lightspeedduel.ws.InventoryItem
-	3,  // 52: lightspeedduel.ws.StoryDialogue.intent:type_name -> lightspeedduel.ws.StoryIntent
-	46, // 53: lightspeedduel.ws.StoryDialogue.choices:type_name -> lightspeedduel.ws.StoryDialogueChoice
-	47, // 54: lightspeedduel.ws.StoryDialogue.tutorial_tip:type_name -> lightspeedduel.ws.StoryTutorialTip
-	48, // 55: lightspeedduel.ws.StoryState.dialogue:type_name -> lightspeedduel.ws.StoryDialogue
-	60, // 56: lightspeedduel.ws.StoryState.flags:type_name -> lightspeedduel.ws.StoryState.FlagsEntry
-	49, // 57: lightspeedduel.ws.StoryState.recent_events:type_name -> lightspeedduel.ws.StoryEvent
-	54, // 58: lightspeedduel.ws.MissionBeaconSnapshot.beacons:type_name -> lightspeedduel.ws.MissionBeaconDefinition
-	55, // 59: lightspeedduel.ws.MissionBeaconSnapshot.players:type_name -> lightspeedduel.ws.MissionBeaconPlayer
-	58, // 60: lightspeedduel.ws.MissionBeaconSnapshot.encounters:type_name -> lightspeedduel.ws.MissionBeaconEncounter
-	61, // 61: lightspeedduel.ws.MissionBeaconPlayer.cooldowns:type_name -> lightspeedduel.ws.MissionBeaconPlayer.CooldownsEntry
-	57, // 62: lightspeedduel.ws.MissionBeaconDelta.players:type_name -> lightspeedduel.ws.MissionBeaconPlayerDelta
-	59, // 63: lightspeedduel.ws.MissionBeaconDelta.encounters:type_name -> lightspeedduel.ws.MissionBeaconEncounterEvent
-	4,  // 64: lightspeedduel.ws.MissionBeaconPlayerDelta.type:type_name -> lightspeedduel.ws.MissionBeaconDeltaType
-	5,  // 65: lightspeedduel.ws.MissionBeaconEncounterEvent.type:type_name -> lightspeedduel.ws.MissionEncounterEventType
-	66, // [66:66] is the sub-list for method output_type
-	66, // [66:66] is the sub-list for method input_type
-	66, // [66:66] is the sub-list for extension type_name
-	66, // [66:66] is the sub-list for extension extendee
-	0,  // [0:66] is the sub-list for field type_name
+	9,  // 0: lightspeedduel.ws.WsEnvelope.state_update:type_name -> lightspeedduel.ws.StateUpdate
+	10, // 1: lightspeedduel.ws.WsEnvelope.room_full:type_name -> lightspeedduel.ws.RoomFullError
+	11, // 2: lightspeedduel.ws.WsEnvelope.join:type_name -> lightspeedduel.ws.ClientJoin
+	12, // 3: lightspeedduel.ws.WsEnvelope.spawn_bot:type_name -> lightspeedduel.ws.SpawnBot
+	13, // 4: lightspeedduel.ws.WsEnvelope.add_waypoint:type_name -> lightspeedduel.ws.AddWaypoint
+	14, // 5: lightspeedduel.ws.WsEnvelope.update_waypoint:type_name -> lightspeedduel.ws.UpdateWaypoint
+	15, // 6: lightspeedduel.ws.WsEnvelope.move_waypoint:type_name -> lightspeedduel.ws.MoveWaypoint
+	16, // 7: lightspeedduel.ws.WsEnvelope.delete_waypoint:type_name -> lightspeedduel.ws.DeleteWaypoint
+	17, // 8: lightspeedduel.ws.WsEnvelope.clear_waypoints:type_name -> lightspeedduel.ws.ClearWaypoints
+	18, // 9: lightspeedduel.ws.WsEnvelope.configure_missile:type_name -> lightspeedduel.ws.ConfigureMissile
+	19, // 10: lightspeedduel.ws.WsEnvelope.add_missile_waypoint:type_name -> lightspeedduel.ws.AddMissileWaypoint
+	20, // 11: lightspeedduel.ws.WsEnvelope.update_missile_waypoint_speed:type_name -> lightspeedduel.ws.UpdateMissileWaypointSpeed
+	21, // 12: lightspeedduel.ws.WsEnvelope.move_missile_waypoint:type_name -> lightspeedduel.ws.MoveMissileWaypoint
+	22, // 13: lightspeedduel.ws.WsEnvelope.delete_missile_waypoint:type_name -> lightspeedduel.ws.DeleteMissileWaypoint
+	23, // 14: lightspeedduel.ws.WsEnvelope.clear_missile_route:type_name -> lightspeedduel.ws.ClearMissileRoute
+	24, // 15: lightspeedduel.ws.WsEnvelope.add_missile_route:type_name -> lightspeedduel.ws.AddMissileRoute
+	25, // 16: lightspeedduel.ws.WsEnvelope.rename_missile_route:type_name -> lightspeedduel.ws.RenameMissileRoute
+	26, // 17: lightspeedduel.ws.WsEnvelope.delete_missile_route:type_name -> lightspeedduel.ws.DeleteMissileRoute
+	27, // 18: lightspeedduel.ws.WsEnvelope.set_active_missile_route:type_name -> lightspeedduel.ws.SetActiveMissileRoute
+	28, // 19: lightspeedduel.ws.WsEnvelope.launch_missile:type_name -> lightspeedduel.ws.LaunchMissile
+	63, // 20: lightspeedduel.ws.WsEnvelope.use_repair_kit:type_name -> lightspeedduel.ws.UseRepairKit
+	41, // 21: lightspeedduel.ws.WsEnvelope.dag_start:type_name -> lightspeedduel.ws.DagStart
+	42, // 22: lightspeedduel.ws.WsEnvelope.dag_cancel:type_name -> lightspeedduel.ws.DagCancel
+	43, // 23: lightspeedduel.ws.WsEnvelope.dag_story_ack:type_name -> lightspeedduel.ws.DagStoryAck
+	44, // 24: lightspeedduel.ws.WsEnvelope.dag_list:type_name -> lightspeedduel.ws.DagList
+	53, // 25: lightspeedduel.ws.WsEnvelope.mission_spawn_wave:type_name -> lightspeedduel.ws.MissionSpawnWave
+	54, // 26: lightspeedduel.ws.WsEnvelope.mission_story_event:type_name -> lightspeedduel.ws.MissionStoryEvent
+	45, // 27: lightspeedduel.ws.WsEnvelope.dag_list_response:type_name -> lightspeedduel.ws.DagListResponse
+	55, // 28: lightspeedduel.ws.WsEnvelope.mission_beacon_snapshot:type_name -> lightspeedduel.ws.MissionBeaconSnapshot
+	58, // 29: lightspeedduel.ws.WsEnvelope.mission_beacon_delta:type_name -> lightspeedduel.ws.MissionBeaconDelta
+	65, // 30: lightspeedduel.ws.WsEnvelope.emergency_vent:type_name -> lightspeedduel.ws.EmergencyVent
+	66, // 31: lightspeedduel.ws.WsEnvelope.deploy_radiators:type_name -> lightspeedduel.ws.DeployRadiators
+	67, // 32: lightspeedduel.ws.WsEnvelope.use_heat_sink:type_name -> lightspeedduel.ws.UseHeatSink
+	68, // 33: lightspeedduel.ws.WsEnvelope.add_wingman:type_name -> lightspeedduel.ws.AddWingman
+	69, // 34: lightspeedduel.ws.WsEnvelope.wingman_order:type_name -> lightspeedduel.ws.WingmanOrder
+	29, // 35: lightspeedduel.ws.StateUpdate.me:type_name -> lightspeedduel.ws.Ghost
+	29, // 36: lightspeedduel.ws.StateUpdate.ghosts:type_name -> lightspeedduel.ws.Ghost
+	31, // 37: lightspeedduel.ws.StateUpdate.meta:type_name -> lightspeedduel.ws.RoomMeta
+	32, // 38: lightspeedduel.ws.StateUpdate.missiles:type_name -> lightspeedduel.ws.Missile
+	33, // 39: lightspeedduel.ws.StateUpdate.missile_config:type_name -> lightspeedduel.ws.MissileConfig
+	30, // 40: lightspeedduel.ws.StateUpdate.missile_waypoints:type_name -> lightspeedduel.ws.Waypoint
+	34, // 41: lightspeedduel.ws.StateUpdate.missile_routes:type_name -> lightspeedduel.ws.MissileRoute
+	40, // 42: lightspeedduel.ws.StateUpdate.dag:type_name -> lightspeedduel.ws.DagState
+	47, // 43: lightspeedduel.ws.StateUpdate.inventory:type_name -> lightspeedduel.ws.Inventory
+	52, // 44: lightspeedduel.ws.StateUpdate.story:type_name -> lightspeedduel.ws.StoryState
+	38, // 45: lightspeedduel.ws.StateUpdate.capabilities:type_name -> lightspeedduel.ws.PlayerCapabilities
+	6,  // 46: lightspeedduel.ws.ConfigureMissile.guidance:type_name -> lightspeedduel.ws.MissileGuidance
+	7,  // 47: lightspeedduel.ws.ConfigureMissile.warhead:type_name -> lightspeedduel.ws.Warhead
+	30, // 48: lightspeedduel.ws.Ghost.waypoints:type_name -> lightspeedduel.ws.Waypoint
+	35, // 49: lightspeedduel.ws.Ghost.heat:type_name -> lightspeedduel.ws.ShipHeatView
+	62, // 50: lightspeedduel.ws.Ghost.point_defense:type_name -> lightspeedduel.ws.PointDefenseView
+	64, // 51: lightspeedduel.ws.Ghost.subsystems:type_name -> lightspeedduel.ws.ShipSubsystemView
+	70, // 52: lightspeedduel.ws.Ghost.heat_abilities:type_name -> lightspeedduel.ws.HeatAbilitiesView
+	35, // 53: lightspeedduel.ws.Missile.heat:type_name -> lightspeedduel.ws.ShipHeatView
+	6,  // 54: lightspeedduel.ws.Missile.guidance:type_name -> lightspeedduel.ws.MissileGuidance
+	7,  // 55: lightspeedduel.ws.Missile.warhead:type_name -> lightspeedduel.ws.Warhead
+	36, // 56: lightspeedduel.ws.MissileConfig.heat_config:type_name -> lightspeedduel.ws.HeatParams
+	6,  // 57: lightspeedduel.ws.MissileConfig.guidance:type_name -> lightspeedduel.ws.MissileGuidance
+	7,  // 58: lightspeedduel.ws.MissileConfig.warhead:type_name -> lightspeedduel.ws.Warhead
+	30, // 59: lightspeedduel.ws.MissileRoute.waypoints:type_name -> lightspeedduel.ws.Waypoint
+	2,  // 60: lightspeedduel.ws.UpgradeEffect.type:type_name -> lightspeedduel.ws.UpgradeEffectType
+	1,  // 61: lightspeedduel.ws.DagNode.kind:type_name -> lightspeedduel.ws.DagNodeKind
+	0,  // 62: lightspeedduel.ws.DagNode.status:type_name -> lightspeedduel.ws.DagNodeStatus
+	37, // 63: lightspeedduel.ws.DagNode.effects:type_name -> lightspeedduel.ws.UpgradeEffect
+	39, // 64: lightspeedduel.ws.DagState.nodes:type_name -> lightspeedduel.ws.DagNode
+	40, // 65: lightspeedduel.ws.DagListResponse.dag:type_name -> lightspeedduel.ws.DagState
+	46, // 66: lightspeedduel.ws.Inventory.items:type_name -> lightspeedduel.ws.InventoryItem
+	3,  // 67: lightspeedduel.ws.StoryDialogue.intent:type_name -> lightspeedduel.ws.StoryIntent
+	48, // 68: lightspeedduel.ws.StoryDialogue.choices:type_name -> lightspeedduel.ws.StoryDialogueChoice
+	49, // 69: lightspeedduel.ws.StoryDialogue.tutorial_tip:type_name -> lightspeedduel.ws.StoryTutorialTip
+	50, // 70: lightspeedduel.ws.StoryState.dialogue:type_name -> lightspeedduel.ws.StoryDialogue
+	71, // 71: lightspeedduel.ws.StoryState.flags:type_name -> lightspeedduel.ws.StoryState.FlagsEntry
+	51, // 72: lightspeedduel.ws.StoryState.recent_events:type_name -> lightspeedduel.ws.StoryEvent
+	56, // 73: lightspeedduel.ws.MissionBeaconSnapshot.beacons:type_name -> lightspeedduel.ws.MissionBeaconDefinition
+	57, // 74: lightspeedduel.ws.MissionBeaconSnapshot.players:type_name -> lightspeedduel.ws.MissionBeaconPlayer
+	60, // 75: lightspeedduel.ws.MissionBeaconSnapshot.encounters:type_name -> lightspeedduel.ws.MissionBeaconEncounter
+	72, // 76: lightspeedduel.ws.MissionBeaconPlayer.cooldowns:type_name -> lightspeedduel.ws.MissionBeaconPlayer.CooldownsEntry
+	59, // 77: lightspeedduel.ws.MissionBeaconDelta.players:type_name -> lightspeedduel.ws.MissionBeaconPlayerDelta
+	61, // 78: lightspeedduel.ws.MissionBeaconDelta.encounters:type_name -> lightspeedduel.ws.MissionBeaconEncounterEvent
+	4,  // 79: lightspeedduel.ws.MissionBeaconPlayerDelta.type:type_name -> lightspeedduel.ws.MissionBeaconDeltaType
+	5,  // 80: lightspeedduel.ws.MissionBeaconEncounterEvent.type:type_name -> lightspeedduel.ws.MissionEncounterEventType
+	81, // [81:81] is the sub-list for method output_type
+	81, // [81:81] is the sub-list for method input_type
+	81, // [81:81] is the sub-list for extension type_name
+	81, // [81:81] is the sub-list for extension extendee
+	0,  // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_proto_ws_messages_proto_init() }
@@ -5005,6 +5970,7 @@ func file_proto_ws_messages_proto_init() {
 		(*WsEnvelope_DeleteMissileRoute)(nil),
 		(*WsEnvelope_SetActiveMissileRoute)(nil),
 		(*WsEnvelope_LaunchMissile)(nil),
+		(*WsEnvelope_UseRepairKit)(nil),
 		(*WsEnvelope_DagStart)(nil),
 		(*WsEnvelope_DagCancel)(nil),
 		(*WsEnvelope_DagStoryAck)(nil),
@@ -5014,8 +5980,14 @@ func file_proto_ws_messages_proto_init() {
 		(*WsEnvelope_DagListResponse)(nil),
 		(*WsEnvelope_MissionBeaconSnapshot)(nil),
 		(*WsEnvelope_MissionBeaconDelta)(nil),
+		(*WsEnvelope_EmergencyVent)(nil),
+		(*WsEnvelope_DeployRadiators)(nil),
+		(*WsEnvelope_UseHeatSink)(nil),
+		(*WsEnvelope_AddWingman)(nil),
+		(*WsEnvelope_WingmanOrder)(nil),
 	}
 	file_proto_ws_messages_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_ws_messages_proto_msgTypes[4].OneofWrappers = []any{}
 	file_proto_ws_messages_proto_msgTypes[21].OneofWrappers = []any{}
 	file_proto_ws_messages_proto_msgTypes[24].OneofWrappers = []any{}
 	file_proto_ws_messages_proto_msgTypes[25].OneofWrappers = []any{}
@@ -5030,8 +6002,8 @@ func file_proto_ws_messages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ws_messages_proto_rawDesc), len(file_proto_ws_messages_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   56,
+			NumEnums:      8,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
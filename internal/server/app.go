@@ -11,11 +11,16 @@ import (
 type AppConfig struct {
 	HeatConfigPath string
 	HeatOverrides  HeatParamOverrides
+	BotTreesDir    string
+	ContentDir     string
+	WatchInterval  time.Duration // Poll for config and content edits; 0 reloads only on SIGHUP
 }
 
 func DefaultAppConfig() AppConfig {
 	return AppConfig{
 		HeatConfigPath: "configs/world.json",
+		BotTreesDir:    "configs/bots",
+		ContentDir:     "configs/content",
 	}
 }
 
@@ -31,20 +36,31 @@ func resolveHeatParams(cfg AppConfig) HeatParams {
 	return SanitizeHeatParams(params)
 }
 
+// initGameData loads the bot behavior trees, content files and DAG shared by
+// the web server and the training gym.
+func initGameData(cfg AppConfig) {
+	// Bot trees first so content can name tree behaviors for gunships.
+	if names, err := LoadBehaviorTreesDir(cfg.BotTreesDir); err != nil {
+		log.Printf("bot behavior trees: %v", err)
+	} else if len(names) > 0 {
+		log.Printf("loaded %d bot behavior trees from %s: %v", len(names), cfg.BotTreesDir, names)
+	}
+
+	// Content is checked against the DAG built from the seed and content nodes.
+	content, err := ReloadContent(cfg.ContentDir)
+	if err != nil {
+		log.Fatalf("failed to load content: %v", err)
+	}
+	log.Printf("DAG system initialized with %d nodes; loaded %d missions, %d encounters, %d spawn tables and %d nodes from %s",
+		len(dag.GetGraph().Nodes), len(content.Missions), len(content.Encounters), len(content.SpawnTables), len(content.Nodes), cfg.ContentDir)
+}
+
 func StartApp(addr string, cfg AppConfig) {
 	heat := resolveHeatParams(cfg)
 	hub := NewHub(heat)
 
-    // Initialize DAG system with missile crafting, story, and upgrades
-    craftNodes := dag.SeedMissileCraftNodes()
-    storyNodes := dag.SeedStoryNodes()
-    upgradeNodes := dag.SeedUpgradeNodes()
-    nodes := append(append(craftNodes, storyNodes...), upgradeNodes...)
-	if err := dag.Init(nodes); err != nil {
-		log.Fatalf("failed to initialize DAG: %v", err)
-	}
-    log.Printf("DAG system initialized with %d nodes (%d craft, %d story, %d upgrade)",
-        len(nodes), len(craftNodes), len(storyNodes), len(upgradeNodes))
+	initGameData(cfg)
+	handleReloads(hub, cfg)
 
 	// Periodic cleanup of empty rooms (every 60 seconds)
 	go func() {
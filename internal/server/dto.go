@@ -6,20 +6,23 @@ import (
 )
 
 type missileDTO struct {
-	ID         string           `json:"id"`
-	Owner      string           `json:"owner"`
-	Self       bool             `json:"self"`
-	X          float64          `json:"x"`
-	Y          float64          `json:"y"`
-	VX         float64          `json:"vx"`
-	VY         float64          `json:"vy"`
-	T          float64          `json:"t"`
-	AgroRadius float64          `json:"agro_radius"`
-	Lifetime   float64          `json:"lifetime"`
-	LaunchTime float64          `json:"launch"`
-	ExpiresAt  float64          `json:"expires"`
-	TargetID   string           `json:"target_id,omitempty"`
-	Heat       *shipHeatViewDTO `json:"heat,omitempty"` // Reuse shipHeatViewDTO for missile heat
+	ID          string           `json:"id"`
+	Owner       string           `json:"owner"`
+	Self        bool             `json:"self"`
+	X           float64          `json:"x"`
+	Y           float64          `json:"y"`
+	VX          float64          `json:"vx"`
+	VY          float64          `json:"vy"`
+	T           float64          `json:"t"`
+	AgroRadius  float64          `json:"agro_radius"`
+	Lifetime    float64          `json:"lifetime"`
+	LaunchTime  float64          `json:"launch"`
+	ExpiresAt   float64          `json:"expires"`
+	TargetID    string           `json:"target_id,omitempty"`
+	Heat        *shipHeatViewDTO `json:"heat,omitempty"` // Reuse shipHeatViewDTO for missile heat
+	Guidance    string           `json:"guidance"`       // "ship" or "interceptor"
+	Warhead     string           `json:"warhead"`        // kinetic, fragmentation, high_explosive, mine
+	BlastRadius float64          `json:"blast_radius,omitempty"`
 }
 
 type missileConfigDTO struct {
@@ -30,6 +33,8 @@ type missileConfigDTO struct {
 	AgroRadius float64        `json:"agro_radius"`
 	Lifetime   float64        `json:"lifetime"`
 	HeatConfig *heatParamsDTO `json:"heat_config,omitempty"` // Optional custom heat parameters
+	Guidance   string         `json:"guidance"`              // "ship" or "interceptor"
+	Warhead    string         `json:"warhead"`               // kinetic, fragmentation, high_explosive, mine
 }
 
 // heatParamsDTO allows clients to send custom heat configuration for missiles
@@ -67,6 +72,44 @@ type shipHeatViewDTO struct {
 	EX float64 `json:"ex"` // exp (response exponent)
 }
 
+// pointDefenseViewDTO exposes the player's own point-defense mount
+type pointDefenseViewDTO struct {
+	Range    float64 `json:"range"`
+	Cooldown float64 `json:"cooldown"`
+	HeatCost float64 `json:"heat_cost"`
+	ReadyAt  float64 `json:"ready_at"` // server time seconds
+}
+
+// shipSubsystemViewDTO reports subsystem health for the player's own ship
+type shipSubsystemViewDTO struct {
+	Engines     float64 `json:"engines"`
+	Sensors     float64 `json:"sensors"`
+	Launchers   float64 `json:"launchers"`
+	Radiators   float64 `json:"radiators"`
+	RepairAfter float64 `json:"repair_after"` // server time seconds
+}
+
+// heatAbilitiesViewDTO reports heat ability cooldowns for the player's own ship
+type heatAbilitiesViewDTO struct {
+	VentReadyAt       float64 `json:"vent_ready_at"`
+	SinkReadyAt       float64 `json:"sink_ready_at"`
+	RadiatorsDeployed bool    `json:"radiators_deployed"`
+	RadiatorReadyAt   float64 `json:"radiator_ready_at"`
+}
+
+// capabilitiesDTO reports upgrade effects and hull stats for the player
+type capabilitiesDTO struct {
+	SpeedMultiplier           float64  `json:"speed_multiplier"`
+	UnlockedMissiles          []string `json:"unlocked_missiles,omitempty"`
+	HeatCapacity              float64  `json:"heat_capacity"`
+	HeatEfficiency            float64  `json:"heat_efficiency"`
+	Hull                      string   `json:"hull"`
+	MissileCooldownMultiplier float64  `json:"missile_cooldown_multiplier"`
+	MaxSpeed                  float64  `json:"max_speed"`
+	MaxHP                     int      `json:"max_hp"`
+	WingmanSlots              int      `json:"wingman_slots"`
+}
+
 // dagNodeDTO represents a node in the DAG for client serialization
 type dagNodeDTO struct {
 	ID         string              `json:"id"`
@@ -126,3 +169,19 @@ type ObjectiveStateDTO = game.ObjectiveState
 type MissionAcceptDTO struct {
 	MissionID string `json:"missionId"`
 }
+
+// MissionGenerateDTO asks for a generated side mission. A zero Seed picks a
+// random one; Daily generates the day's challenge instead.
+type MissionGenerateDTO struct {
+	Seed       int64 `json:"seed"`
+	Difficulty int   `json:"difficulty"`
+	Daily      bool  `json:"daily"`
+}
+
+// MissionGeneratedDTO names the mission a mission:generate request produced.
+type MissionGeneratedDTO struct {
+	MissionID   string `json:"missionId"`
+	DisplayName string `json:"displayName"`
+	Seed        int64  `json:"seed"`
+	Difficulty  int    `json:"difficulty"`
+}
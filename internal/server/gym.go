@@ -0,0 +1,40 @@
+package server
+
+import (
+	"log"
+	"net"
+	"os"
+
+	. "LightSpeedDuel/internal/game"
+)
+
+// RunGym serves the training gym instead of the web server. With an empty
+// addr the protocol runs over stdin/stdout; otherwise it listens on addr and
+// gives every connection its own environment. Logs go to stderr so they do
+// not mix with protocol output.
+func RunGym(addr string, cfg AppConfig) error {
+	log.SetOutput(os.Stderr)
+	initGameData(cfg)
+	gymCfg := GymConfig{Heat: resolveHeatParams(cfg)}
+
+	if addr == "" {
+		return ServeGym(os.Stdin, os.Stdout, gymCfg)
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Printf("gym listening on %s", addr)
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go func(conn net.Conn) {
+			defer conn.Close()
+			if err := ServeGym(conn, conn, gymCfg); err != nil {
+				log.Printf("gym session %s: %v", conn.RemoteAddr(), err)
+			}
+		}(conn)
+	}
+}
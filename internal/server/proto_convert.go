@@ -2,6 +2,7 @@ package server
 
 import (
 	"LightSpeedDuel/internal/dag"
+	"LightSpeedDuel/internal/game"
 	pb "LightSpeedDuel/internal/proto/ws"
 )
 
@@ -18,6 +19,38 @@ func ghostToProto(g ghost) *pb.Ghost {
 		CurrentWaypointIndex: int32(g.CurrentWaypointIndex),
 		Hp:                   int32(g.HP),
 		Kills:                int32(g.Kills),
+		Hull:                 g.Hull,
+		MaxHp:                int32(g.MaxHP),
+		Venting:              g.Venting,
+		Allied:               g.Allied,
+	}
+
+	if g.PointDefense != nil {
+		msg.PointDefense = &pb.PointDefenseView{
+			Range:    g.PointDefense.Range,
+			Cooldown: g.PointDefense.Cooldown,
+			HeatCost: g.PointDefense.HeatCost,
+			ReadyAt:  g.PointDefense.ReadyAt,
+		}
+	}
+
+	if g.Subsystems != nil {
+		msg.Subsystems = &pb.ShipSubsystemView{
+			Engines:     g.Subsystems.Engines,
+			Sensors:     g.Subsystems.Sensors,
+			Launchers:   g.Subsystems.Launchers,
+			Radiators:   g.Subsystems.Radiators,
+			RepairAfter: g.Subsystems.RepairAfter,
+		}
+	}
+
+	if g.HeatAbilities != nil {
+		msg.HeatAbilities = &pb.HeatAbilitiesView{
+			VentReadyAt:       g.HeatAbilities.VentReadyAt,
+			SinkReadyAt:       g.HeatAbilities.SinkReadyAt,
+			RadiatorsDeployed: g.HeatAbilities.RadiatorsDeployed,
+			RadiatorReadyAt:   g.HeatAbilities.RadiatorReadyAt,
+		}
 	}
 
 	// Convert waypoints
@@ -53,19 +86,22 @@ func ghostToProto(g ghost) *pb.Ghost {
 // Convert internal missile to protobuf message
 func missileToProto(m missileDTO) *pb.Missile {
 	msg := &pb.Missile{
-		Id:         m.ID,
-		Owner:      m.Owner,
-		Self:       m.Self,
-		X:          m.X,
-		Y:          m.Y,
-		Vx:         m.VX,
-		Vy:         m.VY,
-		T:          m.T,
-		AgroRadius: m.AgroRadius,
-		Lifetime:   m.Lifetime,
-		LaunchTime: m.LaunchTime,
-		ExpiresAt:  m.ExpiresAt,
-		TargetId:   m.TargetID,
+		Id:          m.ID,
+		Owner:       m.Owner,
+		Self:        m.Self,
+		X:           m.X,
+		Y:           m.Y,
+		Vx:          m.VX,
+		Vy:          m.VY,
+		T:           m.T,
+		AgroRadius:  m.AgroRadius,
+		Lifetime:    m.Lifetime,
+		LaunchTime:  m.LaunchTime,
+		ExpiresAt:   m.ExpiresAt,
+		TargetId:    m.TargetID,
+		Guidance:    missileGuidanceToProto(m.Guidance),
+		Warhead:     warheadToProto(m.Warhead),
+		BlastRadius: m.BlastRadius,
 	}
 
 	if m.Heat != nil {
@@ -119,6 +155,8 @@ func stateToProto(s stateMsg) *pb.StateUpdate {
 		AgroMin:    s.MissileConfig.AgroMin,
 		AgroRadius: s.MissileConfig.AgroRadius,
 		Lifetime:   s.MissileConfig.Lifetime,
+		Guidance:   missileGuidanceToProto(s.MissileConfig.Guidance),
+		Warhead:    warheadToProto(s.MissileConfig.Warhead),
 	}
 
 	if s.MissileConfig.HeatConfig != nil {
@@ -171,6 +209,8 @@ func stateToProto(s stateMsg) *pb.StateUpdate {
 		msg.Story = storyStateToProto(s.Story)
 	}
 
+	msg.Capabilities = capabilitiesToProto(s.Capabilities)
+
 	return msg
 }
 
@@ -207,6 +247,24 @@ func dagKindToProto(kind dag.NodeKind) pb.DagNodeKind {
 	}
 }
 
+// Convert internal capabilitiesDTO to protobuf PlayerCapabilities
+func capabilitiesToProto(caps *capabilitiesDTO) *pb.PlayerCapabilities {
+	if caps == nil {
+		return nil
+	}
+	return &pb.PlayerCapabilities{
+		SpeedMultiplier:           caps.SpeedMultiplier,
+		UnlockedMissiles:          caps.UnlockedMissiles,
+		HeatCapacity:              caps.HeatCapacity,
+		HeatEfficiency:            caps.HeatEfficiency,
+		Hull:                      caps.Hull,
+		MissileCooldownMultiplier: caps.MissileCooldownMultiplier,
+		MaxSpeed:                  caps.MaxSpeed,
+		MaxHp:                     int32(caps.MaxHP),
+		WingmanSlots:              int32(caps.WingmanSlots),
+	}
+}
+
 // Convert story intent to proto enum
 func storyIntentToProto(intent string) pb.StoryIntent {
 	switch intent {
@@ -219,6 +277,55 @@ func storyIntentToProto(intent string) pb.StoryIntent {
 	}
 }
 
+// Convert missile guidance name to proto enum
+func missileGuidanceToProto(guidance string) pb.MissileGuidance {
+	switch guidance {
+	case "ship":
+		return pb.MissileGuidance_MISSILE_GUIDANCE_SHIP
+	case "interceptor":
+		return pb.MissileGuidance_MISSILE_GUIDANCE_INTERCEPTOR
+	default:
+		return pb.MissileGuidance_MISSILE_GUIDANCE_UNSPECIFIED
+	}
+}
+
+// Convert proto missile guidance enum to the game type
+func missileGuidanceFromProto(guidance pb.MissileGuidance) game.MissileGuidance {
+	if guidance == pb.MissileGuidance_MISSILE_GUIDANCE_INTERCEPTOR {
+		return game.MissileGuidanceInterceptor
+	}
+	return game.MissileGuidanceShip
+}
+
+// Convert warhead name to proto enum
+func warheadToProto(warhead string) pb.Warhead {
+	switch warhead {
+	case "kinetic":
+		return pb.Warhead_WARHEAD_KINETIC
+	case "fragmentation":
+		return pb.Warhead_WARHEAD_FRAGMENTATION
+	case "high_explosive":
+		return pb.Warhead_WARHEAD_HIGH_EXPLOSIVE
+	case "mine":
+		return pb.Warhead_WARHEAD_PROXIMITY_MINE
+	default:
+		return pb.Warhead_WARHEAD_UNSPECIFIED
+	}
+}
+
+// Convert proto warhead enum to the game type. Clients may not fit mine
+// warheads to their own missiles.
+func warheadFromProto(warhead pb.Warhead) game.WarheadType {
+	switch warhead {
+	case pb.Warhead_WARHEAD_FRAGMENTATION:
+		return game.WarheadFragmentation
+	case pb.Warhead_WARHEAD_HIGH_EXPLOSIVE:
+		return game.WarheadHighExplosive
+	default:
+		return game.WarheadKinetic
+	}
+}
+
 // ========== Phase 2: DAG Conversions ==========
 
 // Convert upgrade effect type to proto enum
@@ -232,6 +339,8 @@ func effectTypeToProto(t dag.EffectType) pb.UpgradeEffectType {
 		return pb.UpgradeEffectType_UPGRADE_EFFECT_TYPE_HEAT_CAPACITY
 	case dag.EffectHeatEfficiency:
 		return pb.UpgradeEffectType_UPGRADE_EFFECT_TYPE_HEAT_EFFICIENCY
+	case dag.EffectWingmanSlot:
+		return pb.UpgradeEffectType_UPGRADE_EFFECT_TYPE_WINGMAN_SLOT
 	default:
 		return pb.UpgradeEffectType_UPGRADE_EFFECT_TYPE_UNSPECIFIED
 	}
@@ -250,7 +359,7 @@ func effectsToProto(effects []dag.UpgradeEffect) []*pb.UpgradeEffect {
 		}
 
 		switch effect.Type {
-		case dag.EffectSpeedMultiplier, dag.EffectHeatCapacity, dag.EffectHeatEfficiency:
+		case dag.EffectSpeedMultiplier, dag.EffectHeatCapacity, dag.EffectHeatEfficiency, dag.EffectWingmanSlot:
 			if multiplier, ok := effect.Value.(float64); ok {
 				protoEffect.Value = &pb.UpgradeEffect_Multiplier{Multiplier: multiplier}
 			}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	. "LightSpeedDuel/internal/game"
+)
+
+// reloadMu keeps the watcher and SIGHUP from reloading at the same time.
+var reloadMu sync.Mutex
+
+// reloadGameData re-reads the world config and content directory and swaps
+// them in. Both are validated first; if either fails nothing changes. New
+// rooms get the new heat defaults, running rooms only if they were opened with
+// reloadHeat=1. Bot behavior trees are only read at startup.
+func reloadGameData(hub *Hub, cfg AppConfig) error {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	heat, err := loadHeatParamsFromFile(cfg.HeatConfigPath, DefaultHeatParams())
+	if err != nil {
+		return err
+	}
+	heat = SanitizeHeatParams(applyHeatOverrides(heat, cfg.HeatOverrides))
+	content, err := ReloadContent(cfg.ContentDir)
+	if err != nil {
+		return err
+	}
+	hub.SetHeatDefaults(heat)
+	log.Printf("reloaded %s and %s: %d missions, %d campaigns, %d encounters, %d spawn tables, %d nodes (heat marker %.1f)",
+		cfg.HeatConfigPath, cfg.ContentDir, len(content.Missions), len(content.Campaigns), len(content.Encounters), len(content.SpawnTables), len(content.Nodes),
+		heat.MarkerSpeed)
+	return nil
+}
+
+// handleReloads reloads on SIGHUP and, when cfg.WatchInterval is set, whenever
+// the world config or a content file changes.
+func handleReloads(hub *Hub, cfg AppConfig) {
+	reload := func(reason string) {
+		if err := reloadGameData(hub, cfg); err != nil {
+			log.Printf("reload after %s failed, keeping current content: %v", reason, err)
+		}
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			reload("SIGHUP")
+		}
+	}()
+
+	if cfg.WatchInterval <= 0 {
+		return
+	}
+	go func() {
+		last := gameDataStamp(cfg)
+		ticker := time.NewTicker(cfg.WatchInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			stamp := gameDataStamp(cfg)
+			if stamp == last {
+				continue
+			}
+			last = stamp
+			reload("file change")
+		}
+	}()
+}
+
+// gameDataStamp summarises the files a reload reads, so edits can be spotted
+// by polling.
+func gameDataStamp(cfg AppConfig) string {
+	var b strings.Builder
+	add := func(path string, info fs.FileInfo) {
+		fmt.Fprintf(&b, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+	}
+	if info, err := os.Stat(cfg.HeatConfigPath); err == nil {
+		add(cfg.HeatConfigPath, info)
+	}
+	_ = filepath.WalkDir(cfg.ContentDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(d.Name(), ".json") {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			add(path, info)
+		}
+		return nil
+	})
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "LightSpeedDuel/internal/game"
+)
+
+func TestReloadGameDataKeepsOldConfigOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	cfg := AppConfig{
+		HeatConfigPath: filepath.Join(dir, "world.json"),
+		ContentDir:     filepath.Join(dir, "content"),
+	}
+	hub := NewHub(DefaultHeatParams())
+	room := hub.GetRoom("reload-room")
+	t.Cleanup(room.Stop)
+	room.Mu.Lock()
+	room.SetFollowHeatReloadsLocked(true)
+	room.Mu.Unlock()
+	fixed := hub.GetRoom("fixed-room")
+	t.Cleanup(fixed.Stop)
+
+	if err := os.WriteFile(cfg.HeatConfigPath, []byte(`{"heat": {"markerSpeed": 120}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := reloadGameData(hub, cfg); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if hub.HeatDefaults().MarkerSpeed != 120 || room.HeatParams().MarkerSpeed != 120 {
+		t.Fatalf("expected new rooms and running rooms to get the reloaded heat, got %.1f and %.1f",
+			hub.HeatDefaults().MarkerSpeed, room.HeatParams().MarkerSpeed)
+	}
+	if fixed.HeatParams().MarkerSpeed == 120 {
+		t.Fatal("expected rooms that did not opt in to keep their heat")
+	}
+
+	if err := os.WriteFile(cfg.HeatConfigPath, []byte(`{"heat": {"markerSpeed": 90}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(cfg.ContentDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cfg.ContentDir, "bad.json"), []byte(`{"missions": [{"id": "x"}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := reloadGameData(hub, cfg); err == nil {
+		t.Fatal("expected invalid content to fail the reload")
+	}
+	if hub.HeatDefaults().MarkerSpeed != 120 {
+		t.Fatal("expected a failed reload to keep the previous heat defaults")
+	}
+}
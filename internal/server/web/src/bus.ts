@@ -1,6 +1,8 @@
 import type {
   MissileSelection,
+  MissionAvailability,
   MissionObjectiveState,
+  StoryVote,
   DebugBeaconInfo,
   DebugEncounterInfo,
 } from "./state";
@@ -64,6 +66,7 @@ export interface EventMap {
   "story:flagUpdated": { flag: string; value: boolean };
   "story:progressed": { chapterId: string; nodeId: string };
   "story:nodeActivated": { nodeId: string; dialogue?: DialogueContent };
+  "story:vote": StoryVote;
   "mission:update":
     | { reason: "snapshot" | "delta" }
     | {
@@ -86,6 +89,8 @@ export interface EventMap {
   "mission:beacon-locked": { index: number };
   "mission:completed": { missionId: string };
   "mission:failed": { missionId: string; reason?: string };
+  "mission:list": { missions: MissionAvailability[] };
+  "mission:generated": { missionId: string; displayName: string; seed: number; difficulty: number };
   "beacon:discovered": { id: string; ordinal: number };
   "beacon:activated": { id: string; ordinal: number };
   "audio:resume": void;
@@ -12,6 +12,7 @@ let saveStatusTimer: number | null = null;
 const callSignInput = document.querySelector<HTMLInputElement>("#call-sign-input");
 const saveStatus = document.getElementById("save-status");
 const campaignButton = document.getElementById("campaign-button");
+const coopCampaignButton = document.getElementById("coop-campaign-button");
 const tutorialButton = document.getElementById("tutorial-button");
 const freeplayButton = document.getElementById("freeplay-button");
 const mapSizeSelect = document.querySelector<HTMLSelectElement>("#map-size-select");
@@ -85,6 +86,13 @@ function bootstrap(): void {
     window.location.href = url;
   });
 
+  coopCampaignButton?.addEventListener("click", () => {
+    const name = ensureCallSign();
+    const roomId = generateRoomId("coop");
+    const url = buildRoomUrl(roomId, name, "campaign", { w: 32000, h: 18000 }, "1", "vote");
+    window.location.href = url;
+  });
+
   tutorialButton?.addEventListener("click", () => {
     const name = ensureCallSign();
     const mapSize = getSelectedMapSize();
@@ -177,6 +185,7 @@ function buildRoomUrl(
   mode?: string,
   mapSize?: { w: number; h: number },
   missionId?: string,
+  coop?: string,
 ): string {
   let url = `${window.location.origin}/?room=${encodeURIComponent(roomId)}`;
   if (mode) {
@@ -185,6 +194,9 @@ function buildRoomUrl(
   if (missionId) {
     url += `&mission=${encodeURIComponent(missionId)}`;
   }
+  if (coop) {
+    url += `&coop=${encodeURIComponent(coop)}`;
+  }
   if (callSign) {
     url += `&name=${encodeURIComponent(callSign)}`;
   }
@@ -21,6 +21,8 @@ const CALL_SIGN_STORAGE_KEY = "lsd:callsign";
   const room = qs.get("room") || "default";
   const mode = qs.get("mode") || "";
   const missionId = qs.get("mission") || (mode === "campaign" ? "1" : null);
+  const coop = qs.get("coop") || "";
+  const hull = qs.get("hull") || "";
   const nameParam = sanitizeCallSign(qs.get("name"));
   const storedName = sanitizeCallSign(readStoredCallSign());
   const callSign = nameParam || storedName;
@@ -127,10 +129,11 @@ const CALL_SIGN_STORAGE_KEY = "lsd:callsign";
     mapH,
     mode,
     missionId: missionId ?? undefined,
+    coop: coop || undefined,
     onStateUpdated: () => game.onStateUpdated(),
     onOpen: () => {
       const nameToSend = callSign || sanitizeCallSign(readStoredCallSign());
-      if (nameToSend) sendMessage({ type: "join", name: nameToSend });
+      if (nameToSend || hull) sendMessage({ type: "join", name: nameToSend, hull });
     },
   });
 
@@ -66,7 +66,7 @@ export function mountMissionHud({ state, bus }: MissionHudOptions): MissionHud {
     const archetypeLabel = mission.archetype ? mission.archetype.toUpperCase() : "";
     const now = getApproxServerNow(state);
     let timerMarkup = "";
-    if (mission.timeout && mission.timeout > 0 && mission.startTime) {
+    if (mission.status === "active" && mission.timeout && mission.timeout > 0 && mission.startTime) {
       const elapsed = Math.max(0, now - mission.startTime);
       const remaining = Math.max(0, mission.timeout - elapsed);
       const minutes = Math.floor(remaining / 60);
@@ -124,6 +124,22 @@ export function mountMissionHud({ state, bus }: MissionHudOptions): MissionHud {
       hudContainer.classList.remove("inside");
     }
 
+    const failureMarkup = mission.status === "failed" && mission.failureReason
+      ? `<div class="mission-timer warning">Failed: ${mission.failureReason}</div>`
+      : "";
+
+    let convoyStatus = "";
+    if (mission.convoy) {
+      const convoy = mission.convoy;
+      const hpLabel = convoy.destroyed ? "Destroyed" : `${convoy.hp}/${convoy.maxHp}`;
+      convoyStatus = `
+        <div class="mission-player-status">
+          <div>Convoy: ${hpLabel}</div>
+          <div>Leg ${Math.min(convoy.leg + 1, convoy.legs)}/${convoy.legs}${convoy.arrived ? " (arrived)" : ""}</div>
+        </div>
+      `;
+    }
+
     hudContainer.innerHTML = `
       <div class="mission-header">
         <h3>${mission.displayName || "Mission"}</h3>
@@ -137,8 +153,10 @@ export function mountMissionHud({ state, bus }: MissionHudOptions): MissionHud {
         <span class="mission-progress-value">${Math.round(aggregateProgress * 100)}%</span>
       </div>
       ${timerMarkup}
+      ${failureMarkup}
       ${objectiveItems ? `<ul class="mission-objectives">${objectiveItems}</ul>` : ""}
       ${playerStatus}
+      ${convoyStatus}
     `;
 
     hudContainer.classList.remove("hidden");
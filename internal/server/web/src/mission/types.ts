@@ -16,9 +16,54 @@ export interface ObjectiveStateDTO {
   description: string;
 }
 
+export interface MissionAvailabilityDTO {
+  missionId: string;
+  campaignId?: string;
+  displayName: string;
+  archetype: string;
+  status: "locked" | "available" | "completed" | "cooldown";
+  missing?: string[];
+  retryIn?: number;
+  active: boolean;
+}
+
+export interface MissionGeneratedDTO {
+  missionId: string;
+  displayName: string;
+  seed: number;
+  difficulty: number;
+}
+
+export interface StoryVoteDTO {
+  nodeId: string;
+  mode: "vote" | "leader";
+  leaderId: string;
+  votes?: Record<string, number>;
+  waiting?: number;
+  resolved?: string;
+}
+
+export interface MissionListDTO {
+  missions: MissionAvailabilityDTO[];
+  serverTime: number;
+}
+
+export interface ConvoyStatusDTO {
+  hp: number;
+  maxHp: number;
+  leg: number;
+  legs: number;
+  x: number;
+  y: number;
+  arrived: boolean;
+  destroyed: boolean;
+}
+
 export interface MissionUpdateDTO {
   missionId: string;
   status: string;
   objectives: ObjectiveStateDTO[];
   serverTime: number;
+  convoy?: ConvoyStatusDTO;
+  reason?: string;
 }
@@ -24,7 +24,7 @@ import {
 } from "./proto/proto/ws_messages_pb";
 import type { MissionBeaconSnapshot, MissionBeaconDelta } from "./proto/proto/ws_messages_pb";
 import { protoToState, protoToDagState } from "./proto_helpers";
-import type { MissionOfferDTO, MissionUpdateDTO, ObjectiveStateDTO } from "./mission/types";
+import type { MissionListDTO, MissionOfferDTO, MissionUpdateDTO, ObjectiveStateDTO, StoryVoteDTO, MissionGeneratedDTO } from "./mission/types";
 
 interface ConnectOptions {
   room: string;
@@ -36,6 +36,7 @@ interface ConnectOptions {
   mapH?: number;
   mode?: string;
   missionId?: string;
+  coop?: string;
 }
 
 let ws: WebSocket | null = null;
@@ -68,6 +69,7 @@ export function sendMessage(payload: unknown): void {
               room: msg.room || "",
               mapW: msg.map_w || 0,
               mapH: msg.map_h || 0,
+              hull: msg.hull || "",
             },
           },
         }));
@@ -75,7 +77,16 @@ export function sendMessage(payload: unknown): void {
 
       case "spawn_bot":
         sendProto(create(WsEnvelopeSchema, {
-          payload: { case: "spawnBot", value: {} },
+          payload: {
+            case: "spawnBot",
+            value: {
+              behavior: msg.behavior || "",
+              difficulty: msg.difficulty || "",
+              hull: msg.hull || "",
+              x: typeof msg.x === "number" ? msg.x : undefined,
+              y: typeof msg.y === "number" ? msg.y : undefined,
+            },
+          },
         }));
         return;
 
@@ -139,6 +150,45 @@ export function sendMessage(payload: unknown): void {
         }));
         return;
 
+      case "use_repair_kit":
+        sendProto(create(WsEnvelopeSchema, {
+          payload: { case: "useRepairKit", value: {} },
+        }));
+        return;
+
+      case "emergency_vent":
+        sendProto(create(WsEnvelopeSchema, {
+          payload: { case: "emergencyVent", value: {} },
+        }));
+        return;
+
+      case "deploy_radiators":
+        sendProto(create(WsEnvelopeSchema, {
+          payload: {
+            case: "deployRadiators",
+            value: { deployed: !!msg.deployed },
+          },
+        }));
+        return;
+
+      case "use_heat_sink":
+        sendProto(create(WsEnvelopeSchema, {
+          payload: { case: "useHeatSink", value: {} },
+        }));
+        return;
+
+      case "add_wingman":
+        sendProto(create(WsEnvelopeSchema, {
+          payload: { case: "addWingman", value: {} },
+        }));
+        return;
+
+      case "wingman_order":
+        sendProto(create(WsEnvelopeSchema, {
+          payload: { case: "wingmanOrder", value: { order: msg.order || "escort" } },
+        }));
+        return;
+
       case "add_missile_waypoint":
         sendProto(create(WsEnvelopeSchema, {
           payload: {
@@ -298,6 +348,26 @@ export function sendDagList(): void {
   }));
 }
 
+// requestMissionList asks the server for the mission-select list; the reply
+// arrives as a "mission:list" event.
+export function requestMissionList(): void {
+  if (!ws || ws.readyState !== WebSocket.OPEN) return;
+  ws.send(JSON.stringify({ type: "mission:list", payload: {} }));
+}
+
+// requestGeneratedMission asks the server for a generated side mission of the
+// given difficulty (1-5), or the daily challenge when daily is set. The reply
+// arrives as "mission:generated" followed by a refreshed "mission:list".
+export function requestGeneratedMission(difficulty: number, options: { seed?: number; daily?: boolean } = {}): void {
+  if (!ws || ws.readyState !== WebSocket.OPEN) return;
+  ws.send(
+    JSON.stringify({
+      type: "mission:generate",
+      payload: { difficulty, seed: options.seed ?? 0, daily: Boolean(options.daily) },
+    }),
+  );
+}
+
 export function acceptMission(missionId: string): void {
   if (!missionId) return;
   if (!ws || ws.readyState !== WebSocket.OPEN) {
@@ -333,6 +403,7 @@ export function connectWebSocket({
   mapH,
   mode,
   missionId,
+  coop,
 }: ConnectOptions): void {
   const protocol = window.location.protocol === "https:" ? "wss://" : "ws://";
   let wsUrl = `${protocol}${window.location.host}/ws?room=${encodeURIComponent(room)}`;
@@ -348,6 +419,9 @@ export function connectWebSocket({
   if (missionId) {
     wsUrl += `&mission=${encodeURIComponent(missionId)}`;
   }
+  if (coop) {
+    wsUrl += `&coop=${encodeURIComponent(coop)}`;
+  }
   ws = new WebSocket(wsUrl);
   connectedState = state;
   connectedBus = bus;
@@ -454,6 +528,8 @@ function handleJsonMessage(state: AppState, bus: EventBus, raw: string): void {
       mission.completionTime = null;
       mission.progress = 0;
       mission.objectives = [];
+      mission.convoy = null;
+      mission.failureReason = "";
       mission.objectiveSummaries = Array.isArray(payload.objectives) ? [...payload.objectives] : [];
       mission.serverTime = getApproxServerNow(state);
 
@@ -480,12 +556,16 @@ function handleJsonMessage(state: AppState, bus: EventBus, raw: string): void {
       if (Number.isFinite(payload.serverTime)) {
         mission.serverTime = Number(payload.serverTime);
       }
+      const previousStatus = mission.status;
       if (typeof payload.status === "string") {
         mission.status = payload.status as MissionStatus;
       }
-      if (mission.status === "active" && mission.startTime == null) {
+      // A retried mission restarts its timer.
+      if (mission.status === "active" && (mission.startTime == null || previousStatus !== "active")) {
         mission.startTime = getApproxServerNow(state);
       }
+      mission.convoy = payload.convoy ? { ...payload.convoy } : null;
+      mission.failureReason = typeof payload.reason === "string" ? payload.reason : "";
       if (payload.objectives) {
         const objectives = updateMissionObjectives(state, bus, payload.objectives);
         bus.emit("mission:update", {
@@ -509,11 +589,55 @@ function handleJsonMessage(state: AppState, bus: EventBus, raw: string): void {
         bus.emit("mission:completed", { missionId: mission.missionId });
       } else if (mission.status === "failed") {
         mission.completionTime = mission.serverTime;
-        bus.emit("mission:failed", { missionId: mission.missionId });
+        bus.emit("mission:failed", { missionId: mission.missionId, reason: mission.failureReason });
       }
       break;
     }
 
+    case "mission:list": {
+      const payload = msg.payload as Partial<MissionListDTO> | undefined;
+      const missions = Array.isArray(payload?.missions) ? payload!.missions : [];
+      state.missionList = missions.map((entry) => ({
+        missionId: entry.missionId,
+        campaignId: entry.campaignId ?? "",
+        displayName: entry.displayName,
+        archetype: entry.archetype,
+        status: entry.status,
+        missing: entry.missing ?? [],
+        retryIn: entry.retryIn ?? 0,
+        active: Boolean(entry.active),
+      }));
+      bus.emit("mission:list", { missions: state.missionList });
+      break;
+    }
+
+    case "mission:generated": {
+      const payload = msg.payload as Partial<MissionGeneratedDTO> | undefined;
+      if (!payload?.missionId) break;
+      bus.emit("mission:generated", {
+        missionId: payload.missionId,
+        displayName: payload.displayName ?? payload.missionId,
+        seed: payload.seed ?? 0,
+        difficulty: payload.difficulty ?? 0,
+      });
+      break;
+    }
+
+    case "story:vote": {
+      const payload = msg.payload as Partial<StoryVoteDTO> | undefined;
+      if (!payload?.nodeId) break;
+      state.storyVote = {
+        nodeId: payload.nodeId,
+        mode: payload.mode ?? "vote",
+        leaderId: payload.leaderId ?? "",
+        votes: payload.votes ?? {},
+        waiting: payload.waiting ?? 0,
+        resolved: payload.resolved ?? "",
+      };
+      bus.emit("story:vote", state.storyVote);
+      break;
+    }
+
     case "debug:beacons": {
       const payload = msg.payload as { beacons?: unknown } | undefined;
       const beaconsArray = Array.isArray(payload?.beacons) ? payload!.beacons : [];
@@ -623,6 +747,9 @@ function handleProtoStateMessage(
       waypoints: msg.me.waypoints ?? [],
       currentWaypointIndex: msg.me.currentWaypointIndex ?? 0,
       heat: msg.me.heat ? convertHeatView(msg.me.heat, state.nowSyncedAt, state.now) : undefined,
+      subsystems: msg.me.subsystems,
+      heatAbilities: msg.me.heatAbilities,
+      venting: msg.me.venting,
     };
   } else {
     state.me = null;
@@ -718,6 +845,11 @@ function handleProtoStateMessage(
       unlockedMissiles: msg.capabilities.unlockedMissiles,
       heatCapacity: msg.capabilities.heatCapacity,
       heatEfficiency: msg.capabilities.heatEfficiency,
+      hull: msg.capabilities.hull,
+      missileCooldownMultiplier: msg.capabilities.missileCooldownMultiplier,
+      maxSpeed: msg.capabilities.maxSpeed,
+      maxHp: msg.capabilities.maxHp,
+      wingmanSlots: msg.capabilities.wingmanSlots,
     };
   }
 
@@ -988,6 +1120,10 @@ function handleMissionDelta(state: AppState, delta: MissionBeaconDelta, bus: Eve
           encounter.active = false;
           encounter.reason = event.reason || "purged";
           break;
+        case MissionEncounterEventType.MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED:
+          encounter.active = false;
+          encounter.reason = event.reason || "source destroyed";
+          break;
         default:
           break;
       }
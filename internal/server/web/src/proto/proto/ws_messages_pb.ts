@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/ws_messages.proto.
  */
 export const file_proto_ws_messages: GenFile = /*@__PURE__*/
-  fileDesc("Chdwcm90by93c19tZXNzYWdlcy5wcm90bxIRbGlnaHRzcGVlZGR1ZWwud3Mi1A4KCldzRW52ZWxvcGUSNgoMc3RhdGVfdXBkYXRlGAEgASgLMh4ubGlnaHRzcGVlZGR1ZWwud3MuU3RhdGVVcGRhdGVIABI1Cglyb29tX2Z1bGwYAiABKAsyIC5saWdodHNwZWVkZHVlbC53cy5Sb29tRnVsbEVycm9ySAASLQoEam9pbhgKIAEoCzIdLmxpZ2h0c3BlZWRkdWVsLndzLkNsaWVudEpvaW5IABIwCglzcGF3bl9ib3QYCyABKAsyGy5saWdodHNwZWVkZHVlbC53cy5TcGF3bkJvdEgAEjYKDGFkZF93YXlwb2ludBgMIAEoCzIeLmxpZ2h0c3BlZWRkdWVsLndzLkFkZFdheXBvaW50SAASPAoPdXBkYXRlX3dheXBvaW50GA0gASgLMiEubGlnaHRzcGVlZGR1ZWwud3MuVXBkYXRlV2F5cG9pbnRIABI4Cg1tb3ZlX3dheXBvaW50GA4gASgLMh8ubGlnaHRzcGVlZGR1ZWwud3MuTW92ZVdheXBvaW50SAASPAoPZGVsZXRlX3dheXBvaW50GA8gASgLMiEubGlnaHRzcGVlZGR1ZWwud3MuRGVsZXRlV2F5cG9pbnRIABI8Cg9jbGVhcl93YXlwb2ludHMYECABKAsyIS5saWdodHNwZWVkZHVlbC53cy5DbGVhcldheXBvaW50c0gAEkAKEWNvbmZpZ3VyZV9taXNzaWxlGBEgASgLMiMubGlnaHRzcGVlZGR1ZWwud3MuQ29uZmlndXJlTWlzc2lsZUgAEkUKFGFkZF9taXNzaWxlX3dheXBvaW50GBIgASgLMiUubGlnaHRzcGVlZGR1ZWwud3MuQWRkTWlzc2lsZVdheXBvaW50SAASVgoddXBkYXRlX21pc3NpbGVfd2F5cG9pbnRfc3BlZWQYEyABKAsyLS5saWdodHNwZWVkZHVlbC53cy5VcGRhdGVNaXNzaWxlV2F5cG9pbnRTcGVlZEgAEkcKFW1vdmVfbWlzc2lsZV93YXlwb2ludBgUIAEoCzImLmxpZ2h0c3BlZWRkdWVsLndzLk1vdmVNaXNzaWxlV2F5cG9pbnRIABJLChdkZWxldGVfbWlzc2lsZV93YXlwb2ludBgVIAEoCzIoLmxpZ2h0c3BlZWRkdWVsLndzLkRlbGV0ZU1pc3NpbGVXYXlwb2ludEgAEkMKE2NsZWFyX21pc3NpbGVfcm91dGUYFiABKAsyJC5saWdodHNwZWVkZHVlbC53cy5DbGVhck1pc3NpbGVSb3V0ZUgAEj8KEWFkZF9taXNzaWxlX3JvdXRlGBcgASgLMiIubGlnaHRzcGVlZGR1ZWwud3MuQWRkTWlzc2lsZVJvdXRlSAASRQoUcmVuYW1lX21pc3NpbGVfcm91dGUYGCABKAsyJS5saWdodHNwZWVkZHVlbC53cy5SZW5hbWVNaXNzaWxlUm91dGVIABJFChRkZWxldGVfbWlzc2lsZV9yb3V0ZRgZIAEoCzIlLmxpZ2h0c3BlZWRkdWVsLndzLkRlbGV0ZU1pc3NpbGVSb3V0ZUgAEkwKGHNldF9hY3RpdmVfbWlzc2lsZV9yb3V0ZRgaIAEoCzIoLmxpZ2h0c3BlZWRkdWVsLndzLlNldEFjdGl2ZU1pc3NpbGVSb3V0ZUgAEjoKDmxhdW5jaF9taXNzaWxlGBsgASgLMiAubGlnaHRzcGVlZGR1ZWwud3MuTGF1bmNoTWlzc2lsZUgAEjAKCWRhZ19zdGFydBgeIAEoCzIbLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ1N0YXJ0SAASMgoKZGFnX2NhbmNlbBgfIAEoCzIcLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ0NhbmNlbEgAEjcKDWRhZ19zdG9yeV9hY2sYICABKAsyHi5saWdodHNwZWVkZHVlbC53cy5EYWdTdG9yeUFja0gAEi4KCGRhZ19saXN0GCEgASgLMhoubGlnaHRzcGVlZGR1ZWwud3MuRGFnTGlzdEgAEkEKEm1pc3Npb25fc3Bhd25fd2F2ZRgoIAEoCzIjLmxpZ2h0c3BlZWRkdWVsLndzLk1pc3Npb25TcGF3bldhdmVIABJDChNtaXNzaW9uX3N0b3J5X2V2ZW50GCkgASgLMiQubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lvblN0b3J5RXZlbnRIABI/ChFkYWdfbGlzdF9yZXNwb25zZRgyIAEoCzIiLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ0xpc3RSZXNwb25zZUgAEksKF21pc3Npb25fYmVhY29uX3NuYXBzaG90GDwgASgLMigubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lvbkJlYWNvblNuYXBzaG90SAASRQoUbWlzc2lvbl9iZWFjb25fZGVsdGEYPSABKAsyJS5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uRGVsdGFIAEIJCgdwYXlsb2FkIrMFCgtTdGF0ZVVwZGF0ZRILCgNub3cYASABKAESJAoCbWUYAiABKAsyGC5saWdodHNwZWVkZHVlbC53cy5HaG9zdBIoCgZnaG9zdHMYAyADKAsyGC5saWdodHNwZWVkZHVlbC53cy5HaG9zdBIpCgRtZXRhGAQgASgLMhsubGlnaHRzcGVlZGR1ZWwud3MuUm9vbU1ldGESLAoIbWlzc2lsZXMYBSADKAsyGi5saWdodHNwZWVkZHVlbC53cy5NaXNzaWxlEjgKDm1pc3NpbGVfY29uZmlnGAYgASgLMiAubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lsZUNvbmZpZxI2ChFtaXNzaWxlX3dheXBvaW50cxgHIAMoCzIbLmxpZ2h0c3BlZWRkdWVsLndzLldheXBvaW50EjcKDm1pc3NpbGVfcm91dGVzGAggAygLMh8ubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lsZVJvdXRlEhwKFGFjdGl2ZV9taXNzaWxlX3JvdXRlGAkgASgJEhoKEm5leHRfbWlzc2lsZV9yZWFkeRgKIAEoARItCgNkYWcYCyABKAsyGy5saWdodHNwZWVkZHVlbC53cy5EYWdTdGF0ZUgAiAEBEjQKCWludmVudG9yeRgMIAEoCzIcLmxpZ2h0c3BlZWRkdWVsLndzLkludmVudG9yeUgBiAEBEjEKBXN0b3J5GA0gASgLMh0ubGlnaHRzcGVlZGR1ZWwud3MuU3RvcnlTdGF0ZUgCiAEBEkAKDGNhcGFiaWxpdGllcxgOIAEoCzIlLmxpZ2h0c3BlZWRkdWVsLndzLlBsYXllckNhcGFiaWxpdGllc0gDiAEBQgYKBF9kYWdCDAoKX2ludmVudG9yeUIICgZfc3RvcnlCDwoNX2NhcGFiaWxpdGllcyIgCg1Sb29tRnVsbEVycm9yEg8KB21lc3NhZ2UYASABKAkiRgoKQ2xpZW50Sm9pbhIMCgRuYW1lGAEgASgJEgwKBHJvb20YAiABKAkSDQoFbWFwX3cYAyABKAESDQoFbWFwX2gYBCABKAEiCgoIU3Bhd25Cb3QiMgoLQWRkV2F5cG9pbnQSCQoBeBgBIAEoARIJCgF5GAIgASgBEg0KBXNwZWVkGAMgASgBIi4KDlVwZGF0ZVdheXBvaW50Eg0KBWluZGV4GAEgASgFEg0KBXNwZWVkGAIgASgBIjMKDE1vdmVXYXlwb2ludBINCgVpbmRleBgBIAEoBRIJCgF4GAIgASgBEgkKAXkYAyABKAEiHwoORGVsZXRlV2F5cG9pbnQSDQoFaW5kZXgYASABKAUiEAoOQ2xlYXJXYXlwb2ludHMiPwoQQ29uZmlndXJlTWlzc2lsZRIVCg1taXNzaWxlX3NwZWVkGAEgASgBEhQKDG1pc3NpbGVfYWdybxgCIAEoASJLChJBZGRNaXNzaWxlV2F5cG9pbnQSEAoIcm91dGVfaWQYASABKAkSCQoBeBgCIAEoARIJCgF5GAMgASgBEg0KBXNwZWVkGAQgASgBIkwKGlVwZGF0ZU1pc3NpbGVXYXlwb2ludFNwZWVkEhAKCHJvdXRlX2lkGAEgASgJEg0KBWluZGV4GAIgASgFEg0KBXNwZWVkGAMgASgBIkwKE01vdmVNaXNzaWxlV2F5cG9pbnQSEAoIcm91dGVfaWQYASABKAkSDQoFaW5kZXgYAiABKAUSCQoBeBgDIAEoARIJCgF5GAQgASgBIjgKFURlbGV0ZU1pc3NpbGVXYXlwb2ludBIQCghyb3V0ZV9pZBgBIAEoCRINCgVpbmRleBgCIAEoBSIlChFDbGVhck1pc3NpbGVSb3V0ZRIQCghyb3V0ZV9pZBgBIAEoCSIfCg9BZGRNaXNzaWxlUm91dGUSDAoEbmFtZRgBIAEoCSI0ChJSZW5hbWVNaXNzaWxlUm91dGUSEAoIcm91dGVfaWQYASABKAkSDAoEbmFtZRgCIAEoCSImChJEZWxldGVNaXNzaWxlUm91dGUSEAoIcm91dGVfaWQYASABKAkiKQoVU2V0QWN0aXZlTWlzc2lsZVJvdXRlEhAKCHJvdXRlX2lkGAEgASgJIiEKDUxhdW5jaE1pc3NpbGUSEAoIcm91dGVfaWQYASABKAkiggIKBUdob3N0EgoKAmlkGAEgASgJEgkKAXgYAiABKAESCQoBeRgDIAEoARIKCgJ2eBgEIAEoARIKCgJ2eRgFIAEoARIJCgF0GAYgASgBEgwKBHNlbGYYByABKAgSLgoJd2F5cG9pbnRzGAggAygLMhsubGlnaHRzcGVlZGR1ZWwud3MuV2F5cG9pbnQSHgoWY3VycmVudF93YXlwb2ludF9pbmRleBgJIAEoBRIKCgJocBgKIAEoBRINCgVraWxscxgLIAEoBRIyCgRoZWF0GAwgASgLMh8ubGlnaHRzcGVlZGR1ZWwud3MuU2hpcEhlYXRWaWV3SACIAQFCBwoFX2hlYXQiLwoIV2F5cG9pbnQSCQoBeBgBIAEoARIJCgF5GAIgASgBEg0KBXNwZWVkGAMgASgBIisKCFJvb21NZXRhEgkKAWMYASABKAESCQoBdxgCIAEoARIJCgFoGAMgASgBIosCCgdNaXNzaWxlEgoKAmlkGAEgASgJEg0KBW93bmVyGAIgASgJEgwKBHNlbGYYAyABKAgSCQoBeBgEIAEoARIJCgF5GAUgASgBEgoKAnZ4GAYgASgBEgoKAnZ5GAcgASgBEgkKAXQYCCABKAESEwoLYWdyb19yYWRpdXMYCSABKAESEAoIbGlmZXRpbWUYCiABKAESEwoLbGF1bmNoX3RpbWUYCyABKAESEgoKZXhwaXJlc19hdBgMIAEoARIRCgl0YXJnZXRfaWQYDSABKAkSMgoEaGVhdBgOIAEoCzIfLmxpZ2h0c3BlZWRkdWVsLndzLlNoaXBIZWF0Vmlld0gAiAEBQgcKBV9oZWF0IsYBCg1NaXNzaWxlQ29uZmlnEg0KBXNwZWVkGAEgASgBEhEKCXNwZWVkX21pbhgCIAEoARIRCglzcGVlZF9tYXgYAyABKAESEAoIYWdyb19taW4YBCABKAESEwoLYWdyb19yYWRpdXMYBSABKAESEAoIbGlmZXRpbWUYBiABKAESNwoLaGVhdF9jb25maWcYByABKAsyHS5saWdodHNwZWVkZHVlbC53cy5IZWF0UGFyYW1zSACIAQFCDgoMX2hlYXRfY29uZmlnIlgKDE1pc3NpbGVSb3V0ZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEi4KCXdheXBvaW50cxgDIAMoCzIbLmxpZ2h0c3BlZWRkdWVsLndzLldheXBvaW50InYKDFNoaXBIZWF0VmlldxIJCgF2GAEgASgBEgkKAW0YAiABKAESCQoBdxgDIAEoARIJCgFvGAQgASgBEgoKAm1zGAUgASgBEgoKAnN1GAYgASgBEgoKAmt1GAcgASgBEgoKAmtkGAggASgBEgoKAmV4GAkgASgBIoABCgpIZWF0UGFyYW1zEgsKA21heBgBIAEoARIPCgd3YXJuX2F0GAIgASgBEhMKC292ZXJoZWF0X2F0GAMgASgBEhQKDG1hcmtlcl9zcGVlZBgEIAEoARIMCgRrX3VwGAUgASgBEg4KBmtfZG93bhgGIAEoARILCgNleHAYByABKAEidwoNVXBncmFkZUVmZmVjdBIyCgR0eXBlGAEgASgOMiQubGlnaHRzcGVlZGR1ZWwud3MuVXBncmFkZUVmZmVjdFR5cGUSFAoKbXVsdGlwbGllchgCIAEoAUgAEhMKCXVubG9ja19pZBgDIAEoCUgAQgcKBXZhbHVlInkKElBsYXllckNhcGFiaWxpdGllcxIYChBzcGVlZF9tdWx0aXBsaWVyGAEgASgBEhkKEXVubG9ja2VkX21pc3NpbGVzGAIgAygJEhUKDWhlYXRfY2FwYWNpdHkYAyABKAESFwoPaGVhdF9lZmZpY2llbmN5GAQgASgBIvQBCgdEYWdOb2RlEgoKAmlkGAEgASgJEiwKBGtpbmQYAiABKA4yHi5saWdodHNwZWVkZHVlbC53cy5EYWdOb2RlS2luZBINCgVsYWJlbBgDIAEoCRIwCgZzdGF0dXMYBCABKA4yIC5saWdodHNwZWVkZHVlbC53cy5EYWdOb2RlU3RhdHVzEhMKC3JlbWFpbmluZ19zGAUgASgBEhIKCmR1cmF0aW9uX3MYBiABKAESEgoKcmVwZWF0YWJsZRgHIAEoCBIxCgdlZmZlY3RzGAggAygLMiAubGlnaHRzcGVlZGR1ZWwud3MuVXBncmFkZUVmZmVjdCI1CghEYWdTdGF0ZRIpCgVub2RlcxgBIAMoCzIaLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ05vZGUiGwoIRGFnU3RhcnQSDwoHbm9kZV9pZBgBIAEoCSIcCglEYWdDYW5jZWwSDwoHbm9kZV9pZBgBIAEoCSIxCgtEYWdTdG9yeUFjaxIPCgdub2RlX2lkGAEgASgJEhEKCWNob2ljZV9pZBgCIAEoCSIJCgdEYWdMaXN0IjsKD0RhZ0xpc3RSZXNwb25zZRIoCgNkYWcYASABKAsyGy5saWdodHNwZWVkZHVlbC53cy5EYWdTdGF0ZSJaCg1JbnZlbnRvcnlJdGVtEgwKBHR5cGUYASABKAkSEgoKdmFyaWFudF9pZBgCIAEoCRIVCg1oZWF0X2NhcGFjaXR5GAMgASgBEhAKCHF1YW50aXR5GAQgASgFIjwKCUludmVudG9yeRIvCgVpdGVtcxgBIAMoCzIgLmxpZ2h0c3BlZWRkdWVsLndzLkludmVudG9yeUl0ZW0iLwoTU3RvcnlEaWFsb2d1ZUNob2ljZRIKCgJpZBgBIAEoCRIMCgR0ZXh0GAIgASgJIi8KEFN0b3J5VHV0b3JpYWxUaXASDQoFdGl0bGUYASABKAkSDAoEdGV4dBgCIAEoCSKAAgoNU3RvcnlEaWFsb2d1ZRIPCgdzcGVha2VyGAEgASgJEgwKBHRleHQYAiABKAkSLgoGaW50ZW50GAMgASgOMh4ubGlnaHRzcGVlZGR1ZWwud3MuU3RvcnlJbnRlbnQSFgoOY29udGludWVfbGFiZWwYBCABKAkSNwoHY2hvaWNlcxgFIAMoCzImLmxpZ2h0c3BlZWRkdWVsLndzLlN0b3J5RGlhbG9ndWVDaG9pY2USPgoMdHV0b3JpYWxfdGlwGAYgASgLMiMubGlnaHRzcGVlZGR1ZWwud3MuU3RvcnlUdXRvcmlhbFRpcEgAiAEBQg8KDV90dXRvcmlhbF90aXAiRAoKU3RvcnlFdmVudBISCgpjaGFwdGVyX2lkGAEgASgJEg8KB25vZGVfaWQYAiABKAkSEQoJdGltZXN0YW1wGAMgASgBIpcCCgpTdG9yeVN0YXRlEhMKC2FjdGl2ZV9ub2RlGAEgASgJEjcKCGRpYWxvZ3VlGAIgASgLMiAubGlnaHRzcGVlZGR1ZWwud3MuU3RvcnlEaWFsb2d1ZUgAiAEBEhEKCWF2YWlsYWJsZRgDIAMoCRI3CgVmbGFncxgEIAMoCzIoLmxpZ2h0c3BlZWRkdWVsLndzLlN0b3J5U3RhdGUuRmxhZ3NFbnRyeRI0Cg1yZWNlbnRfZXZlbnRzGAUgAygLMh0ubGlnaHRzcGVlZGR1ZWwud3MuU3RvcnlFdmVudBosCgpGbGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCDoCOAFCCwoJX2RpYWxvZ3VlIiYKEE1pc3Npb25TcGF3bldhdmUSEgoKd2F2ZV9pbmRleBgBIAEoBSIyChFNaXNzaW9uU3RvcnlFdmVudBINCgVldmVudBgBIAEoCRIOCgZiZWFjb24YAiABKAUiigIKFU1pc3Npb25CZWFjb25TbmFwc2hvdBISCgptaXNzaW9uX2lkGAEgASgJEhMKC2xheW91dF9zZWVkGAIgASgEEhMKC3NlcnZlcl90aW1lGAMgASgBEjsKB2JlYWNvbnMYBCADKAsyKi5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uRGVmaW5pdGlvbhI3CgdwbGF5ZXJzGAUgAygLMiYubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lvbkJlYWNvblBsYXllchI9CgplbmNvdW50ZXJzGAYgAygLMikubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lvbkJlYWNvbkVuY291bnRlciJqChdNaXNzaW9uQmVhY29uRGVmaW5pdGlvbhIKCgJpZBgBIAEoCRIPCgdvcmRpbmFsGAIgASgFEgkKAXgYAyABKAESCQoBeRgEIAEoARIOCgZyYWRpdXMYBSABKAESDAoEc2VlZBgGIAEoAyKkAgoTTWlzc2lvbkJlYWNvblBsYXllchIRCglwbGF5ZXJfaWQYASABKAkSFQoNY3VycmVudF9pbmRleBgCIAEoBRISCgpob2xkX2FjY3VtGAMgASgBEhUKDWhvbGRfcmVxdWlyZWQYBCABKAESFQoNYWN0aXZlX2JlYWNvbhgFIAEoCRISCgpkaXNjb3ZlcmVkGAYgAygJEhEKCWNvbXBsZXRlZBgHIAMoCRJICgljb29sZG93bnMYCCADKAsyNS5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uUGxheWVyLkNvb2xkb3duc0VudHJ5GjAKDkNvb2xkb3duc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEilgEKEk1pc3Npb25CZWFjb25EZWx0YRI8CgdwbGF5ZXJzGAEgAygLMisubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lvbkJlYWNvblBsYXllckRlbHRhEkIKCmVuY291bnRlcnMYAiADKAsyLi5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uRW5jb3VudGVyRXZlbnQi4gEKGE1pc3Npb25CZWFjb25QbGF5ZXJEZWx0YRI3CgR0eXBlGAEgASgOMikubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lvbkJlYWNvbkRlbHRhVHlwZRIRCglwbGF5ZXJfaWQYAiABKAkSEQoJYmVhY29uX2lkGAMgASgJEg8KB29yZGluYWwYBCABKAUSEgoKaG9sZF9hY2N1bRgFIAEoARIVCg1ob2xkX3JlcXVpcmVkGAYgASgBEhYKDmNvb2xkb3duX3VudGlsGAcgASgBEhMKC3NlcnZlcl90aW1lGAggASgBIn0KFk1pc3Npb25CZWFjb25FbmNvdW50ZXISFAoMZW5jb3VudGVyX2lkGAEgASgJEhEKCWJlYWNvbl9pZBgCIAEoCRISCgp3YXZlX2luZGV4GAMgASgFEhIKCnNwYXduZWRfYXQYBCABKAESEgoKZXhwaXJlc19hdBgFIAEoASLOAQobTWlzc2lvbkJlYWNvbkVuY291bnRlckV2ZW50EjoKBHR5cGUYASABKA4yLC5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uRW5jb3VudGVyRXZlbnRUeXBlEhQKDGVuY291bnRlcl9pZBgCIAEoCRIRCgliZWFjb25faWQYAyABKAkSEgoKd2F2ZV9pbmRleBgEIAEoBRISCgpzcGF3bmVkX2F0GAUgASgBEhIKCmV4cGlyZXNfYXQYBiABKAESDgoGcmVhc29uGAcgASgJKqsBCg1EYWdOb2RlU3RhdHVzEh8KG0RBR19OT0RFX1NUQVRVU19VTlNQRUNJRklFRBAAEhoKFkRBR19OT0RFX1NUQVRVU19MT0NLRUQQARIdChlEQUdfTk9ERV9TVEFUVVNfQVZBSUxBQkxFEAISHwobREFHX05PREVfU1RBVFVTX0lOX1BST0dSRVNTEAMSHQoZREFHX05PREVfU1RBVFVTX0NPTVBMRVRFRBAEKpEBCgtEYWdOb2RlS2luZBIdChlEQUdfTk9ERV9LSU5EX1VOU1BFQ0lGSUVEEAASGQoVREFHX05PREVfS0lORF9GQUNUT1JZEAESFgoSREFHX05PREVfS0lORF9VTklUEAISFwoTREFHX05PREVfS0lORF9TVE9SWRADEhcKE0RBR19OT0RFX0tJTkRfQ1JBRlQQBCraAQoRVXBncmFkZUVmZmVjdFR5cGUSIwofVVBHUkFERV9FRkZFQ1RfVFlQRV9VTlNQRUNJRklFRBAAEigKJFVQR1JBREVfRUZGRUNUX1RZUEVfU1BFRURfTVVMVElQTElFUhABEiYKIlVQR1JBREVfRUZGRUNUX1RZUEVfTUlTU0lMRV9VTkxPQ0sQAhIlCiFVUEdSQURFX0VGRkVDVF9UWVBFX0hFQVRfQ0FQQUNJVFkQAxInCiNVUEdSQURFX0VGRkVDVF9UWVBFX0hFQVRfRUZGSUNJRU5DWRAEKlwKC1N0b3J5SW50ZW50EhwKGFNUT1JZX0lOVEVOVF9VTlNQRUNJRklFRBAAEhgKFFNUT1JZX0lOVEVOVF9GQUNUT1JZEAESFQoRU1RPUllfSU5URU5UX1VOSVQQAiqgAgoWTWlzc2lvbkJlYWNvbkRlbHRhVHlwZRIkCiBNSVNTSU9OX0JFQUNPTl9ERUxUQV9VTlNQRUNJRklFRBAAEiMKH01JU1NJT05fQkVBQ09OX0RFTFRBX0RJU0NPVkVSRUQQARImCiJNSVNTSU9OX0JFQUNPTl9ERUxUQV9IT0xEX1BST0dSRVNTEAISIwofTUlTU0lPTl9CRUFDT05fREVMVEFfSE9MRF9SRVNFVBADEh8KG01JU1NJT05fQkVBQ09OX0RFTFRBX0xPQ0tFRBAEEiEKHU1JU1NJT05fQkVBQ09OX0RFTFRBX0NPT0xET1dOEAUSKgomTUlTU0lPTl9CRUFDT05fREVMVEFfTUlTU0lPTl9DT01QTEVURUQQBirXAQoZTWlzc2lvbkVuY291bnRlckV2ZW50VHlwZRInCiNNSVNTSU9OX0VOQ09VTlRFUl9FVkVOVF9VTlNQRUNJRklFRBAAEiMKH01JU1NJT05fRU5DT1VOVEVSX0VWRU5UX1NQQVdORUQQARIjCh9NSVNTSU9OX0VOQ09VTlRFUl9FVkVOVF9DTEVBUkVEEAISIwofTUlTU0lPTl9FTkNPVU5URVJfRVZFTlRfVElNRU9VVBADEiIKHk1JU1NJT05fRU5DT1VOVEVSX0VWRU5UX1BVUkdFRBAEQiJaIExpZ2h0U3BlZWREdWVsL2ludGVybmFsL3Byb3RvL3dzYgZwcm90bzM");
+  fileDesc("Chdwcm90by93c19tZXNzYWdlcy5wcm90bxIRbGlnaHRzcGVlZGR1ZWwud3MitBEKCldzRW52ZWxvcGUSNgoMc3RhdGVfdXBkYXRlGAEgASgLMh4ubGlnaHRzcGVlZGR1ZWwud3MuU3RhdGVVcGRhdGVIABI1Cglyb29tX2Z1bGwYAiABKAsyIC5saWdodHNwZWVkZHVlbC53cy5Sb29tRnVsbEVycm9ySAASLQoEam9pbhgKIAEoCzIdLmxpZ2h0c3BlZWRkdWVsLndzLkNsaWVudEpvaW5IABIwCglzcGF3bl9ib3QYCyABKAsyGy5saWdodHNwZWVkZHVlbC53cy5TcGF3bkJvdEgAEjYKDGFkZF93YXlwb2ludBgMIAEoCzIeLmxpZ2h0c3BlZWRkdWVsLndzLkFkZFdheXBvaW50SAASPAoPdXBkYXRlX3dheXBvaW50GA0gASgLMiEubGlnaHRzcGVlZGR1ZWwud3MuVXBkYXRlV2F5cG9pbnRIABI4Cg1tb3ZlX3dheXBvaW50GA4gASgLMh8ubGlnaHRzcGVlZGR1ZWwud3MuTW92ZVdheXBvaW50SAASPAoPZGVsZXRlX3dheXBvaW50GA8gASgLMiEubGlnaHRzcGVlZGR1ZWwud3MuRGVsZXRlV2F5cG9pbnRIABI8Cg9jbGVhcl93YXlwb2ludHMYECABKAsyIS5saWdodHNwZWVkZHVlbC53cy5DbGVhcldheXBvaW50c0gAEkAKEWNvbmZpZ3VyZV9taXNzaWxlGBEgASgLMiMubGlnaHRzcGVlZGR1ZWwud3MuQ29uZmlndXJlTWlzc2lsZUgAEkUKFGFkZF9taXNzaWxlX3dheXBvaW50GBIgASgLMiUubGlnaHRzcGVlZGR1ZWwud3MuQWRkTWlzc2lsZVdheXBvaW50SAASVgoddXBkYXRlX21pc3NpbGVfd2F5cG9pbnRfc3BlZWQYEyABKAsyLS5saWdodHNwZWVkZHVlbC53cy5VcGRhdGVNaXNzaWxlV2F5cG9pbnRTcGVlZEgAEkcKFW1vdmVfbWlzc2lsZV93YXlwb2ludBgUIAEoCzImLmxpZ2h0c3BlZWRkdWVsLndzLk1vdmVNaXNzaWxlV2F5cG9pbnRIABJLChdkZWxldGVfbWlzc2lsZV93YXlwb2ludBgVIAEoCzIoLmxpZ2h0c3BlZWRkdWVsLndzLkRlbGV0ZU1pc3NpbGVXYXlwb2ludEgAEkMKE2NsZWFyX21pc3NpbGVfcm91dGUYFiABKAsyJC5saWdodHNwZWVkZHVlbC53cy5DbGVhck1pc3NpbGVSb3V0ZUgAEj8KEWFkZF9taXNzaWxlX3JvdXRlGBcgASgLMiIubGlnaHRzcGVlZGR1ZWwud3MuQWRkTWlzc2lsZVJvdXRlSAASRQoUcmVuYW1lX21pc3NpbGVfcm91dGUYGCABKAsyJS5saWdodHNwZWVkZHVlbC53cy5SZW5hbWVNaXNzaWxlUm91dGVIABJFChRkZWxldGVfbWlzc2lsZV9yb3V0ZRgZIAEoCzIlLmxpZ2h0c3BlZWRkdWVsLndzLkRlbGV0ZU1pc3NpbGVSb3V0ZUgAEkwKGHNldF9hY3RpdmVfbWlzc2lsZV9yb3V0ZRgaIAEoCzIoLmxpZ2h0c3BlZWRkdWVsLndzLlNldEFjdGl2ZU1pc3NpbGVSb3V0ZUgAEjoKDmxhdW5jaF9taXNzaWxlGBsgASgLMiAubGlnaHRzcGVlZGR1ZWwud3MuTGF1bmNoTWlzc2lsZUgAEjkKDnVzZV9yZXBhaXJfa2l0GBwgASgLMh8ubGlnaHRzcGVlZGR1ZWwud3MuVXNlUmVwYWlyS2l0SAASMAoJZGFnX3N0YXJ0GB4gASgLMhsubGlnaHRzcGVlZGR1ZWwud3MuRGFnU3RhcnRIABIyCgpkYWdfY2FuY2VsGB8gASgLMhwubGlnaHRzcGVlZGR1ZWwud3MuRGFnQ2FuY2VsSAASNwoNZGFnX3N0b3J5X2FjaxggIAEoCzIeLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ1N0b3J5QWNrSAASLgoIZGFnX2xpc3QYISABKAsyGi5saWdodHNwZWVkZHVlbC53cy5EYWdMaXN0SAASQQoSbWlzc2lvbl9zcGF3bl93YXZlGCggASgLMiMubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lvblNwYXduV2F2ZUgAEkMKE21pc3Npb25fc3RvcnlfZXZlbnQYKSABKAsyJC5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uU3RvcnlFdmVudEgAEj8KEWRhZ19saXN0X3Jlc3BvbnNlGDIgASgLMiIubGlnaHRzcGVlZGR1ZWwud3MuRGFnTGlzdFJlc3BvbnNlSAASSwoXbWlzc2lvbl9iZWFjb25fc25hcHNob3QYPCABKAsyKC5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uU25hcHNob3RIABJFChRtaXNzaW9uX2JlYWNvbl9kZWx0YRg9IAEoCzIlLmxpZ2h0c3BlZWRkdWVsLndzLk1pc3Npb25CZWFjb25EZWx0YUgAEjoKDmVtZXJnZW5jeV92ZW50GEYgASgLMiAubGlnaHRzcGVlZGR1ZWwud3MuRW1lcmdlbmN5VmVudEgAEj4KEGRlcGxveV9yYWRpYXRvcnMYRyABKAsyIi5saWdodHNwZWVkZHVlbC53cy5EZXBsb3lSYWRpYXRvcnNIABI3Cg11c2VfaGVhdF9zaW5rGEggASgLMh4ubGlnaHRzcGVlZGR1ZWwud3MuVXNlSGVhdFNpbmtIABI0CgthZGRfd2luZ21hbhhJIAEoCzIdLmxpZ2h0c3BlZWRkdWVsLndzLkFkZFdpbmdtYW5IABI4Cg13aW5nbWFuX29yZGVyGEogASgLMh8ubGlnaHRzcGVlZGR1ZWwud3MuV2luZ21hbk9yZGVySABCCQoHcGF5bG9hZCKzBQoLU3RhdGVVcGRhdGUSCwoDbm93GAEgASgBEiQKAm1lGAIgASgLMhgubGlnaHRzcGVlZGR1ZWwud3MuR2hvc3QSKAoGZ2hvc3RzGAMgAygLMhgubGlnaHRzcGVlZGR1ZWwud3MuR2hvc3QSKQoEbWV0YRgEIAEoCzIbLmxpZ2h0c3BlZWRkdWVsLndzLlJvb21NZXRhEiwKCG1pc3NpbGVzGAUgAygLMhoubGlnaHRzcGVlZGR1ZWwud3MuTWlzc2lsZRI4Cg5taXNzaWxlX2NvbmZpZxgGIAEoCzIgLmxpZ2h0c3BlZWRkdWVsLndzLk1pc3NpbGVDb25maWcSNgoRbWlzc2lsZV93YXlwb2ludHMYByADKAsyGy5saWdodHNwZWVkZHVlbC53cy5XYXlwb2ludBI3Cg5taXNzaWxlX3JvdXRlcxgIIAMoCzIfLmxpZ2h0c3BlZWRkdWVsLndzLk1pc3NpbGVSb3V0ZRIcChRhY3RpdmVfbWlzc2lsZV9yb3V0ZRgJIAEoCRIaChJuZXh0X21pc3NpbGVfcmVhZHkYCiABKAESLQoDZGFnGAsgASgLMhsubGlnaHRzcGVlZGR1ZWwud3MuRGFnU3RhdGVIAIgBARI0CglpbnZlbnRvcnkYDCABKAsyHC5saWdodHNwZWVkZHVlbC53cy5JbnZlbnRvcnlIAYgBARIxCgVzdG9yeRgNIAEoCzIdLmxpZ2h0c3BlZWRkdWVsLndzLlN0b3J5U3RhdGVIAogBARJACgxjYXBhYmlsaXRpZXMYDiABKAsyJS5saWdodHNwZWVkZHVlbC53cy5QbGF5ZXJDYXBhYmlsaXRpZXNIA4gBAUIGCgRfZGFnQgwKCl9pbnZlbnRvcnlCCAoGX3N0b3J5Qg8KDV9jYXBhYmlsaXRpZXMiIAoNUm9vbUZ1bGxFcnJvchIPCgdtZXNzYWdlGAEgASgJIlQKCkNsaWVudEpvaW4SDAoEbmFtZRgBIAEoCRIMCgRyb29tGAIgASgJEg0KBW1hcF93GAMgASgBEg0KBW1hcF9oGAQgASgBEgwKBGh1bGwYBSABKAkiagoIU3Bhd25Cb3QSEAoIYmVoYXZpb3IYASABKAkSEgoKZGlmZmljdWx0eRgCIAEoCRIMCgRodWxsGAMgASgJEg4KAXgYBCABKAFIAIgBARIOCgF5GAUgASgBSAGIAQFCBAoCX3hCBAoCX3kiMgoLQWRkV2F5cG9pbnQSCQoBeBgBIAEoARIJCgF5GAIgASgBEg0KBXNwZWVkGAMgASgBIi4KDlVwZGF0ZVdheXBvaW50Eg0KBWluZGV4GAEgASgFEg0KBXNwZWVkGAIgASgBIjMKDE1vdmVXYXlwb2ludBINCgVpbmRleBgBIAEoBRIJCgF4GAIgASgBEgkKAXkYAyABKAEiHwoORGVsZXRlV2F5cG9pbnQSDQoFaW5kZXgYASABKAUiEAoOQ2xlYXJXYXlwb2ludHMiogEKEENvbmZpZ3VyZU1pc3NpbGUSFQoNbWlzc2lsZV9zcGVlZBgBIAEoARIUCgxtaXNzaWxlX2Fncm8YAiABKAESNAoIZ3VpZGFuY2UYAyABKA4yIi5saWdodHNwZWVkZHVlbC53cy5NaXNzaWxlR3VpZGFuY2USKwoHd2FyaGVhZBgEIAEoDjIaLmxpZ2h0c3BlZWRkdWVsLndzLldhcmhlYWQiSwoSQWRkTWlzc2lsZVdheXBvaW50EhAKCHJvdXRlX2lkGAEgASgJEgkKAXgYAiABKAESCQoBeRgDIAEoARINCgVzcGVlZBgEIAEoASJMChpVcGRhdGVNaXNzaWxlV2F5cG9pbnRTcGVlZBIQCghyb3V0ZV9pZBgBIAEoCRINCgVpbmRleBgCIAEoBRINCgVzcGVlZBgDIAEoASJMChNNb3ZlTWlzc2lsZVdheXBvaW50EhAKCHJvdXRlX2lkGAEgASgJEg0KBWluZGV4GAIgASgFEgkKAXgYAyABKAESCQoBeRgEIAEoASI4ChVEZWxldGVNaXNzaWxlV2F5cG9pbnQSEAoIcm91dGVfaWQYASABKAkSDQoFaW5kZXgYAiABKAUiJQoRQ2xlYXJNaXNzaWxlUm91dGUSEAoIcm91dGVfaWQYASABKAkiHwoPQWRkTWlzc2lsZVJvdXRlEgwKBG5hbWUYASABKAkiNAoSUmVuYW1lTWlzc2lsZVJvdXRlEhAKCHJvdXRlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkiJgoSRGVsZXRlTWlzc2lsZVJvdXRlEhAKCHJvdXRlX2lkGAEgASgJIikKFVNldEFjdGl2ZU1pc3NpbGVSb3V0ZRIQCghyb3V0ZV9pZBgBIAEoCSIhCg1MYXVuY2hNaXNzaWxlEhAKCHJvdXRlX2lkGAEgASgJIrgECgVHaG9zdBIKCgJpZBgBIAEoCRIJCgF4GAIgASgBEgkKAXkYAyABKAESCgoCdngYBCABKAESCgoCdnkYBSABKAESCQoBdBgGIAEoARIMCgRzZWxmGAcgASgIEi4KCXdheXBvaW50cxgIIAMoCzIbLmxpZ2h0c3BlZWRkdWVsLndzLldheXBvaW50Eh4KFmN1cnJlbnRfd2F5cG9pbnRfaW5kZXgYCSABKAUSCgoCaHAYCiABKAUSDQoFa2lsbHMYCyABKAUSMgoEaGVhdBgMIAEoCzIfLmxpZ2h0c3BlZWRkdWVsLndzLlNoaXBIZWF0Vmlld0gAiAEBEj8KDXBvaW50X2RlZmVuc2UYDSABKAsyIy5saWdodHNwZWVkZHVlbC53cy5Qb2ludERlZmVuc2VWaWV3SAGIAQESDAoEaHVsbBgOIAEoCRIOCgZtYXhfaHAYDyABKAUSPQoKc3Vic3lzdGVtcxgQIAEoCzIkLmxpZ2h0c3BlZWRkdWVsLndzLlNoaXBTdWJzeXN0ZW1WaWV3SAKIAQESQQoOaGVhdF9hYmlsaXRpZXMYESABKAsyJC5saWdodHNwZWVkZHVlbC53cy5IZWF0QWJpbGl0aWVzVmlld0gDiAEBEg8KB3ZlbnRpbmcYEiABKAgSDgoGYWxsaWVkGBMgASgIQgcKBV9oZWF0QhAKDl9wb2ludF9kZWZlbnNlQg0KC19zdWJzeXN0ZW1zQhEKD19oZWF0X2FiaWxpdGllcyIvCghXYXlwb2ludBIJCgF4GAEgASgBEgkKAXkYAiABKAESDQoFc3BlZWQYAyABKAEiKwoIUm9vbU1ldGESCQoBYxgBIAEoARIJCgF3GAIgASgBEgkKAWgYAyABKAEihAMKB01pc3NpbGUSCgoCaWQYASABKAkSDQoFb3duZXIYAiABKAkSDAoEc2VsZhgDIAEoCBIJCgF4GAQgASgBEgkKAXkYBSABKAESCgoCdngYBiABKAESCgoCdnkYByABKAESCQoBdBgIIAEoARITCgthZ3JvX3JhZGl1cxgJIAEoARIQCghsaWZldGltZRgKIAEoARITCgtsYXVuY2hfdGltZRgLIAEoARISCgpleHBpcmVzX2F0GAwgASgBEhEKCXRhcmdldF9pZBgNIAEoCRIyCgRoZWF0GA4gASgLMh8ubGlnaHRzcGVlZGR1ZWwud3MuU2hpcEhlYXRWaWV3SACIAQESNAoIZ3VpZGFuY2UYDyABKA4yIi5saWdodHNwZWVkZHVlbC53cy5NaXNzaWxlR3VpZGFuY2USKwoHd2FyaGVhZBgQIAEoDjIaLmxpZ2h0c3BlZWRkdWVsLndzLldhcmhlYWQSFAoMYmxhc3RfcmFkaXVzGBEgASgBQgcKBV9oZWF0IqkCCg1NaXNzaWxlQ29uZmlnEg0KBXNwZWVkGAEgASgBEhEKCXNwZWVkX21pbhgCIAEoARIRCglzcGVlZF9tYXgYAyABKAESEAoIYWdyb19taW4YBCABKAESEwoLYWdyb19yYWRpdXMYBSABKAESEAoIbGlmZXRpbWUYBiABKAESNwoLaGVhdF9jb25maWcYByABKAsyHS5saWdodHNwZWVkZHVlbC53cy5IZWF0UGFyYW1zSACIAQESNAoIZ3VpZGFuY2UYCCABKA4yIi5saWdodHNwZWVkZHVlbC53cy5NaXNzaWxlR3VpZGFuY2USKwoHd2FyaGVhZBgJIAEoDjIaLmxpZ2h0c3BlZWRkdWVsLndzLldhcmhlYWRCDgoMX2hlYXRfY29uZmlnIlgKDE1pc3NpbGVSb3V0ZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEi4KCXdheXBvaW50cxgDIAMoCzIbLmxpZ2h0c3BlZWRkdWVsLndzLldheXBvaW50InYKDFNoaXBIZWF0VmlldxIJCgF2GAEgASgBEgkKAW0YAiABKAESCQoBdxgDIAEoARIJCgFvGAQgASgBEgoKAm1zGAUgASgBEgoKAnN1GAYgASgBEgoKAmt1GAcgASgBEgoKAmtkGAggASgBEgoKAmV4GAkgASgBIoABCgpIZWF0UGFyYW1zEgsKA21heBgBIAEoARIPCgd3YXJuX2F0GAIgASgBEhMKC292ZXJoZWF0X2F0GAMgASgBEhQKDG1hcmtlcl9zcGVlZBgEIAEoARIMCgRrX3VwGAUgASgBEg4KBmtfZG93bhgGIAEoARILCgNleHAYByABKAEidwoNVXBncmFkZUVmZmVjdBIyCgR0eXBlGAEgASgOMiQubGlnaHRzcGVlZGR1ZWwud3MuVXBncmFkZUVmZmVjdFR5cGUSFAoKbXVsdGlwbGllchgCIAEoAUgAEhMKCXVubG9ja19pZBgDIAEoCUgAQgcKBXZhbHVlIuYBChJQbGF5ZXJDYXBhYmlsaXRpZXMSGAoQc3BlZWRfbXVsdGlwbGllchgBIAEoARIZChF1bmxvY2tlZF9taXNzaWxlcxgCIAMoCRIVCg1oZWF0X2NhcGFjaXR5GAMgASgBEhcKD2hlYXRfZWZmaWNpZW5jeRgEIAEoARIMCgRodWxsGAUgASgJEiMKG21pc3NpbGVfY29vbGRvd25fbXVsdGlwbGllchgGIAEoARIRCgltYXhfc3BlZWQYByABKAESDgoGbWF4X2hwGAggASgFEhUKDXdpbmdtYW5fc2xvdHMYCSABKAUi9AEKB0RhZ05vZGUSCgoCaWQYASABKAkSLAoEa2luZBgCIAEoDjIeLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ05vZGVLaW5kEg0KBWxhYmVsGAMgASgJEjAKBnN0YXR1cxgEIAEoDjIgLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ05vZGVTdGF0dXMSEwoLcmVtYWluaW5nX3MYBSABKAESEgoKZHVyYXRpb25fcxgGIAEoARISCgpyZXBlYXRhYmxlGAcgASgIEjEKB2VmZmVjdHMYCCADKAsyIC5saWdodHNwZWVkZHVlbC53cy5VcGdyYWRlRWZmZWN0IjUKCERhZ1N0YXRlEikKBW5vZGVzGAEgAygLMhoubGlnaHRzcGVlZGR1ZWwud3MuRGFnTm9kZSIbCghEYWdTdGFydBIPCgdub2RlX2lkGAEgASgJIhwKCURhZ0NhbmNlbBIPCgdub2RlX2lkGAEgASgJIjEKC0RhZ1N0b3J5QWNrEg8KB25vZGVfaWQYASABKAkSEQoJY2hvaWNlX2lkGAIgASgJIgkKB0RhZ0xpc3QiOwoPRGFnTGlzdFJlc3BvbnNlEigKA2RhZxgBIAEoCzIbLmxpZ2h0c3BlZWRkdWVsLndzLkRhZ1N0YXRlIloKDUludmVudG9yeUl0ZW0SDAoEdHlwZRgBIAEoCRISCgp2YXJpYW50X2lkGAIgASgJEhUKDWhlYXRfY2FwYWNpdHkYAyABKAESEAoIcXVhbnRpdHkYBCABKAUiPAoJSW52ZW50b3J5Ei8KBWl0ZW1zGAEgAygLMiAubGlnaHRzcGVlZGR1ZWwud3MuSW52ZW50b3J5SXRlbSIvChNTdG9yeURpYWxvZ3VlQ2hvaWNlEgoKAmlkGAEgASgJEgwKBHRleHQYAiABKAkiLwoQU3RvcnlUdXRvcmlhbFRpcBINCgV0aXRsZRgBIAEoCRIMCgR0ZXh0GAIgASgJIoACCg1TdG9yeURpYWxvZ3VlEg8KB3NwZWFrZXIYASABKAkSDAoEdGV4dBgCIAEoCRIuCgZpbnRlbnQYAyABKA4yHi5saWdodHNwZWVkZHVlbC53cy5TdG9yeUludGVudBIWCg5jb250aW51ZV9sYWJlbBgEIAEoCRI3CgdjaG9pY2VzGAUgAygLMiYubGlnaHRzcGVlZGR1ZWwud3MuU3RvcnlEaWFsb2d1ZUNob2ljZRI+Cgx0dXRvcmlhbF90aXAYBiABKAsyIy5saWdodHNwZWVkZHVlbC53cy5TdG9yeVR1dG9yaWFsVGlwSACIAQFCDwoNX3R1dG9yaWFsX3RpcCJECgpTdG9yeUV2ZW50EhIKCmNoYXB0ZXJfaWQYASABKAkSDwoHbm9kZV9pZBgCIAEoCRIRCgl0aW1lc3RhbXAYAyABKAEilwIKClN0b3J5U3RhdGUSEwoLYWN0aXZlX25vZGUYASABKAkSNwoIZGlhbG9ndWUYAiABKAsyIC5saWdodHNwZWVkZHVlbC53cy5TdG9yeURpYWxvZ3VlSACIAQESEQoJYXZhaWxhYmxlGAMgAygJEjcKBWZsYWdzGAQgAygLMigubGlnaHRzcGVlZGR1ZWwud3MuU3RvcnlTdGF0ZS5GbGFnc0VudHJ5EjQKDXJlY2VudF9ldmVudHMYBSADKAsyHS5saWdodHNwZWVkZHVlbC53cy5TdG9yeUV2ZW50GiwKCkZsYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgIOgI4AUILCglfZGlhbG9ndWUiJgoQTWlzc2lvblNwYXduV2F2ZRISCgp3YXZlX2luZGV4GAEgASgFIjIKEU1pc3Npb25TdG9yeUV2ZW50Eg0KBWV2ZW50GAEgASgJEg4KBmJlYWNvbhgCIAEoBSKKAgoVTWlzc2lvbkJlYWNvblNuYXBzaG90EhIKCm1pc3Npb25faWQYASABKAkSEwoLbGF5b3V0X3NlZWQYAiABKAQSEwoLc2VydmVyX3RpbWUYAyABKAESOwoHYmVhY29ucxgEIAMoCzIqLmxpZ2h0c3BlZWRkdWVsLndzLk1pc3Npb25CZWFjb25EZWZpbml0aW9uEjcKB3BsYXllcnMYBSADKAsyJi5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uUGxheWVyEj0KCmVuY291bnRlcnMYBiADKAsyKS5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uRW5jb3VudGVyImoKF01pc3Npb25CZWFjb25EZWZpbml0aW9uEgoKAmlkGAEgASgJEg8KB29yZGluYWwYAiABKAUSCQoBeBgDIAEoARIJCgF5GAQgASgBEg4KBnJhZGl1cxgFIAEoARIMCgRzZWVkGAYgASgDIqQCChNNaXNzaW9uQmVhY29uUGxheWVyEhEKCXBsYXllcl9pZBgBIAEoCRIVCg1jdXJyZW50X2luZGV4GAIgASgFEhIKCmhvbGRfYWNjdW0YAyABKAESFQoNaG9sZF9yZXF1aXJlZBgEIAEoARIVCg1hY3RpdmVfYmVhY29uGAUgASgJEhIKCmRpc2NvdmVyZWQYBiADKAkSEQoJY29tcGxldGVkGAcgAygJEkgKCWNvb2xkb3ducxgIIAMoCzI1LmxpZ2h0c3BlZWRkdWVsLndzLk1pc3Npb25CZWFjb25QbGF5ZXIuQ29vbGRvd25zRW50cnkaMAoOQ29vbGRvd25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASKWAQoSTWlzc2lvbkJlYWNvbkRlbHRhEjwKB3BsYXllcnMYASADKAsyKy5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uUGxheWVyRGVsdGESQgoKZW5jb3VudGVycxgCIAMoCzIuLmxpZ2h0c3BlZWRkdWVsLndzLk1pc3Npb25CZWFjb25FbmNvdW50ZXJFdmVudCLiAQoYTWlzc2lvbkJlYWNvblBsYXllckRlbHRhEjcKBHR5cGUYASABKA4yKS5saWdodHNwZWVkZHVlbC53cy5NaXNzaW9uQmVhY29uRGVsdGFUeXBlEhEKCXBsYXllcl9pZBgCIAEoCRIRCgliZWFjb25faWQYAyABKAkSDwoHb3JkaW5hbBgEIAEoBRISCgpob2xkX2FjY3VtGAUgASgBEhUKDWhvbGRfcmVxdWlyZWQYBiABKAESFgoOY29vbGRvd25fdW50aWwYByABKAESEwoLc2VydmVyX3RpbWUYCCABKAEifQoWTWlzc2lvbkJlYWNvbkVuY291bnRlchIUCgxlbmNvdW50ZXJfaWQYASABKAkSEQoJYmVhY29uX2lkGAIgASgJEhIKCndhdmVfaW5kZXgYAyABKAUSEgoKc3Bhd25lZF9hdBgEIAEoARISCgpleHBpcmVzX2F0GAUgASgBIs4BChtNaXNzaW9uQmVhY29uRW5jb3VudGVyRXZlbnQSOgoEdHlwZRgBIAEoDjIsLmxpZ2h0c3BlZWRkdWVsLndzLk1pc3Npb25FbmNvdW50ZXJFdmVudFR5cGUSFAoMZW5jb3VudGVyX2lkGAIgASgJEhEKCWJlYWNvbl9pZBgDIAEoCRISCgp3YXZlX2luZGV4GAQgASgFEhIKCnNwYXduZWRfYXQYBSABKAESEgoKZXhwaXJlc19hdBgGIAEoARIOCgZyZWFzb24YByABKAkiWAoQUG9pbnREZWZlbnNlVmlldxINCgVyYW5nZRgBIAEoARIQCghjb29sZG93bhgCIAEoARIRCgloZWF0X2Nvc3QYAyABKAESEAoIcmVhZHlfYXQYBCABKAEiDgoMVXNlUmVwYWlyS2l0InEKEVNoaXBTdWJzeXN0ZW1WaWV3Eg8KB2VuZ2luZXMYASABKAESDwoHc2Vuc29ycxgCIAEoARIRCglsYXVuY2hlcnMYAyABKAESEQoJcmFkaWF0b3JzGAQgASgBEhQKDHJlcGFpcl9hZnRlchgFIAEoASIPCg1FbWVyZ2VuY3lWZW50IiMKD0RlcGxveVJhZGlhdG9ycxIQCghkZXBsb3llZBgBIAEoCCINCgtVc2VIZWF0U2luayIMCgpBZGRXaW5nbWFuIh0KDFdpbmdtYW5PcmRlchINCgVvcmRlchgBIAEoCSJ4ChFIZWF0QWJpbGl0aWVzVmlldxIVCg12ZW50X3JlYWR5X2F0GAEgASgBEhUKDXNpbmtfcmVhZHlfYXQYAiABKAESGgoScmFkaWF0b3JzX2RlcGxveWVkGAMgASgIEhkKEXJhZGlhdG9yX3JlYWR5X2F0GAQgASgBKqsBCg1EYWdOb2RlU3RhdHVzEh8KG0RBR19OT0RFX1NUQVRVU19VTlNQRUNJRklFRBAAEhoKFkRBR19OT0RFX1NUQVRVU19MT0NLRUQQARIdChlEQUdfTk9ERV9TVEFUVVNfQVZBSUxBQkxFEAISHwobREFHX05PREVfU1RBVFVTX0lOX1BST0dSRVNTEAMSHQoZREFHX05PREVfU1RBVFVTX0NPTVBMRVRFRBAEKpEBCgtEYWdOb2RlS2luZBIdChlEQUdfTk9ERV9LSU5EX1VOU1BFQ0lGSUVEEAASGQoVREFHX05PREVfS0lORF9GQUNUT1JZEAESFgoSREFHX05PREVfS0lORF9VTklUEAISFwoTREFHX05PREVfS0lORF9TVE9SWRADEhcKE0RBR19OT0RFX0tJTkRfQ1JBRlQQBCqAAgoRVXBncmFkZUVmZmVjdFR5cGUSIwofVVBHUkFERV9FRkZFQ1RfVFlQRV9VTlNQRUNJRklFRBAAEigKJFVQR1JBREVfRUZGRUNUX1RZUEVfU1BFRURfTVVMVElQTElFUhABEiYKIlVQR1JBREVfRUZGRUNUX1RZUEVfTUlTU0lMRV9VTkxPQ0sQAhIlCiFVUEdSQURFX0VGRkVDVF9UWVBFX0hFQVRfQ0FQQUNJVFkQAxInCiNVUEdSQURFX0VGRkVDVF9UWVBFX0hFQVRfRUZGSUNJRU5DWRAEEiQKIFVQR1JBREVfRUZGRUNUX1RZUEVfV0lOR01BTl9TTE9UEAUqXAoLU3RvcnlJbnRlbnQSHAoYU1RPUllfSU5URU5UX1VOU1BFQ0lGSUVEEAASGAoUU1RPUllfSU5URU5UX0ZBQ1RPUlkQARIVChFTVE9SWV9JTlRFTlRfVU5JVBACKqACChZNaXNzaW9uQmVhY29uRGVsdGFUeXBlEiQKIE1JU1NJT05fQkVBQ09OX0RFTFRBX1VOU1BFQ0lGSUVEEAASIwofTUlTU0lPTl9CRUFDT05fREVMVEFfRElTQ09WRVJFRBABEiYKIk1JU1NJT05fQkVBQ09OX0RFTFRBX0hPTERfUFJPR1JFU1MQAhIjCh9NSVNTSU9OX0JFQUNPTl9ERUxUQV9IT0xEX1JFU0VUEAMSHwobTUlTU0lPTl9CRUFDT05fREVMVEFfTE9DS0VEEAQSIQodTUlTU0lPTl9CRUFDT05fREVMVEFfQ09PTERPV04QBRIqCiZNSVNTSU9OX0JFQUNPTl9ERUxUQV9NSVNTSU9OX0NPTVBMRVRFRBAGKoUCChlNaXNzaW9uRW5jb3VudGVyRXZlbnRUeXBlEicKI01JU1NJT05fRU5DT1VOVEVSX0VWRU5UX1VOU1BFQ0lGSUVEEAASIwofTUlTU0lPTl9FTkNPVU5URVJfRVZFTlRfU1BBV05FRBABEiMKH01JU1NJT05fRU5DT1VOVEVSX0VWRU5UX0NMRUFSRUQQAhIjCh9NSVNTSU9OX0VOQ09VTlRFUl9FVkVOVF9USU1FT1VUEAMSIgoeTUlTU0lPTl9FTkNPVU5URVJfRVZFTlRfUFVSR0VEEAQSLAooTUlTU0lPTl9FTkNPVU5URVJfRVZFTlRfU09VUkNFX0RFU1RST1lFRBAFKnAKD01pc3NpbGVHdWlkYW5jZRIgChxNSVNTSUxFX0dVSURBTkNFX1VOU1BFQ0lGSUVEEAASGQoVTUlTU0lMRV9HVUlEQU5DRV9TSElQEAESIAocTUlTU0lMRV9HVUlEQU5DRV9JTlRFUkNFUFRPUhACKooBCgdXYXJoZWFkEhcKE1dBUkhFQURfVU5TUEVDSUZJRUQQABITCg9XQVJIRUFEX0tJTkVUSUMQARIZChVXQVJIRUFEX0ZSQUdNRU5UQVRJT04QAhIaChZXQVJIRUFEX0hJR0hfRVhQTE9TSVZFEAMSGgoWV0FSSEVBRF9QUk9YSU1JVFlfTUlORRAEQiJaIExpZ2h0U3BlZWREdWVsL2ludGVybmFsL3Byb3RvL3dzYgZwcm90bzM");
 
 /**
  * WsEnvelope wraps all WebSocket messages in a discriminated union
@@ -145,6 +145,12 @@ export type WsEnvelope = Message<"lightspeedduel.ws.WsEnvelope"> & {
      */
     value: LaunchMissile;
     case: "launchMissile";
+  } | {
+    /**
+     * @generated from field: lightspeedduel.ws.UseRepairKit use_repair_kit = 28;
+     */
+    value: UseRepairKit;
+    case: "useRepairKit";
   } | {
     /**
      * Phase 2: DAG commands
@@ -205,6 +211,38 @@ export type WsEnvelope = Message<"lightspeedduel.ws.WsEnvelope"> & {
      */
     value: MissionBeaconDelta;
     case: "missionBeaconDelta";
+  } | {
+    /**
+     * Heat management commands
+     *
+     * @generated from field: lightspeedduel.ws.EmergencyVent emergency_vent = 70;
+     */
+    value: EmergencyVent;
+    case: "emergencyVent";
+  } | {
+    /**
+     * @generated from field: lightspeedduel.ws.DeployRadiators deploy_radiators = 71;
+     */
+    value: DeployRadiators;
+    case: "deployRadiators";
+  } | {
+    /**
+     * @generated from field: lightspeedduel.ws.UseHeatSink use_heat_sink = 72;
+     */
+    value: UseHeatSink;
+    case: "useHeatSink";
+  } | {
+    /**
+     * @generated from field: lightspeedduel.ws.AddWingman add_wingman = 73;
+     */
+    value: AddWingman;
+    case: "addWingman";
+  } | {
+    /**
+     * @generated from field: lightspeedduel.ws.WingmanOrder wingman_order = 74;
+     */
+    value: WingmanOrder;
+    case: "wingmanOrder";
   } | { case: undefined; value?: undefined };
 };
 
@@ -347,6 +385,13 @@ export type ClientJoin = Message<"lightspeedduel.ws.ClientJoin"> & {
    * @generated from field: double map_h = 4;
    */
   mapH: number;
+
+  /**
+   * Hull class ID (empty = default)
+   *
+   * @generated from field: string hull = 5;
+   */
+  hull: string;
 };
 
 /**
@@ -362,6 +407,38 @@ export const ClientJoinSchema: GenMessage<ClientJoin> = /*@__PURE__*/
  * @generated from message lightspeedduel.ws.SpawnBot
  */
 export type SpawnBot = Message<"lightspeedduel.ws.SpawnBot"> & {
+  /**
+   * AI behavior name (empty = defensive)
+   *
+   * @generated from field: string behavior = 1;
+   */
+  behavior: string;
+
+  /**
+   * Difficulty profile ID (empty = normal)
+   *
+   * @generated from field: string difficulty = 2;
+   */
+  difficulty: string;
+
+  /**
+   * Hull class ID (empty = default)
+   *
+   * @generated from field: string hull = 3;
+   */
+  hull: string;
+
+  /**
+   * Spawn position (default: mirrored from the requester)
+   *
+   * @generated from field: optional double x = 4;
+   */
+  x?: number;
+
+  /**
+   * @generated from field: optional double y = 5;
+   */
+  y?: number;
 };
 
 /**
@@ -502,6 +579,16 @@ export type ConfigureMissile = Message<"lightspeedduel.ws.ConfigureMissile"> & {
    * @generated from field: double missile_agro = 2;
    */
   missileAgro: number;
+
+  /**
+   * @generated from field: lightspeedduel.ws.MissileGuidance guidance = 3;
+   */
+  guidance: MissileGuidance;
+
+  /**
+   * @generated from field: lightspeedduel.ws.Warhead warhead = 4;
+   */
+  warhead: Warhead;
 };
 
 /**
@@ -816,6 +903,45 @@ export type Ghost = Message<"lightspeedduel.ws.Ghost"> & {
    * @generated from field: optional lightspeedduel.ws.ShipHeatView heat = 12;
    */
   heat?: ShipHeatView;
+
+  /**
+   * @generated from field: optional lightspeedduel.ws.PointDefenseView point_defense = 13;
+   */
+  pointDefense?: PointDefenseView;
+
+  /**
+   * @generated from field: string hull = 14;
+   */
+  hull: string;
+
+  /**
+   * @generated from field: int32 max_hp = 15;
+   */
+  maxHp: number;
+
+  /**
+   * @generated from field: optional lightspeedduel.ws.ShipSubsystemView subsystems = 16;
+   */
+  subsystems?: ShipSubsystemView;
+
+  /**
+   * @generated from field: optional lightspeedduel.ws.HeatAbilitiesView heat_abilities = 17;
+   */
+  heatAbilities?: HeatAbilitiesView;
+
+  /**
+   * Vent plume visible in the perceived snapshot
+   *
+   * @generated from field: bool venting = 18;
+   */
+  venting: boolean;
+
+  /**
+   * Ship fights on the viewer's side (e.g. their wingmen)
+   *
+   * @generated from field: bool allied = 19;
+   */
+  allied: boolean;
 };
 
 /**
@@ -964,6 +1090,21 @@ export type Missile = Message<"lightspeedduel.ws.Missile"> & {
    * @generated from field: optional lightspeedduel.ws.ShipHeatView heat = 14;
    */
   heat?: ShipHeatView;
+
+  /**
+   * @generated from field: lightspeedduel.ws.MissileGuidance guidance = 15;
+   */
+  guidance: MissileGuidance;
+
+  /**
+   * @generated from field: lightspeedduel.ws.Warhead warhead = 16;
+   */
+  warhead: Warhead;
+
+  /**
+   * @generated from field: double blast_radius = 17;
+   */
+  blastRadius: number;
 };
 
 /**
@@ -1013,6 +1154,16 @@ export type MissileConfig = Message<"lightspeedduel.ws.MissileConfig"> & {
    * @generated from field: optional lightspeedduel.ws.HeatParams heat_config = 7;
    */
   heatConfig?: HeatParams;
+
+  /**
+   * @generated from field: lightspeedduel.ws.MissileGuidance guidance = 8;
+   */
+  guidance: MissileGuidance;
+
+  /**
+   * @generated from field: lightspeedduel.ws.Warhead warhead = 9;
+   */
+  warhead: Warhead;
 };
 
 /**
@@ -1243,6 +1394,41 @@ export type PlayerCapabilities = Message<"lightspeedduel.ws.PlayerCapabilities">
    * @generated from field: double heat_efficiency = 4;
    */
   heatEfficiency: number;
+
+  /**
+   * Selected hull class ID
+   *
+   * @generated from field: string hull = 5;
+   */
+  hull: string;
+
+  /**
+   * Hull launch cooldown scale
+   *
+   * @generated from field: double missile_cooldown_multiplier = 6;
+   */
+  missileCooldownMultiplier: number;
+
+  /**
+   * Effective ship max speed
+   *
+   * @generated from field: double max_speed = 7;
+   */
+  maxSpeed: number;
+
+  /**
+   * Hull max HP
+   *
+   * @generated from field: int32 max_hp = 8;
+   */
+  maxHp: number;
+
+  /**
+   * Wingmen the player may field
+   *
+   * @generated from field: int32 wingman_slots = 9;
+   */
+  wingmanSlots: number;
 };
 
 /**
@@ -2015,6 +2201,237 @@ export type MissionBeaconEncounterEvent = Message<"lightspeedduel.ws.MissionBeac
 export const MissionBeaconEncounterEventSchema: GenMessage<MissionBeaconEncounterEvent> = /*@__PURE__*/
   messageDesc(file_proto_ws_messages, 53);
 
+/**
+ * Point-defense mount state for the player's own ship
+ *
+ * @generated from message lightspeedduel.ws.PointDefenseView
+ */
+export type PointDefenseView = Message<"lightspeedduel.ws.PointDefenseView"> & {
+  /**
+   * Engagement range
+   *
+   * @generated from field: double range = 1;
+   */
+  range: number;
+
+  /**
+   * Seconds between shots
+   *
+   * @generated from field: double cooldown = 2;
+   */
+  cooldown: number;
+
+  /**
+   * Heat per shot
+   *
+   * @generated from field: double heat_cost = 3;
+   */
+  heatCost: number;
+
+  /**
+   * Server time when the next shot is available
+   *
+   * @generated from field: double ready_at = 4;
+   */
+  readyAt: number;
+};
+
+/**
+ * Describes the message lightspeedduel.ws.PointDefenseView.
+ * Use `create(PointDefenseViewSchema)` to create a new message.
+ */
+export const PointDefenseViewSchema: GenMessage<PointDefenseView> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 54);
+
+/**
+ * Client → Server: Spend a repair kit on the player's ship subsystems
+ *
+ * @generated from message lightspeedduel.ws.UseRepairKit
+ */
+export type UseRepairKit = Message<"lightspeedduel.ws.UseRepairKit"> & {
+};
+
+/**
+ * Describes the message lightspeedduel.ws.UseRepairKit.
+ * Use `create(UseRepairKitSchema)` to create a new message.
+ */
+export const UseRepairKitSchema: GenMessage<UseRepairKit> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 55);
+
+/**
+ * Subsystem health for the player's own ship (each in [0,1])
+ *
+ * @generated from message lightspeedduel.ws.ShipSubsystemView
+ */
+export type ShipSubsystemView = Message<"lightspeedduel.ws.ShipSubsystemView"> & {
+  /**
+   * Scales max speed
+   *
+   * @generated from field: double engines = 1;
+   */
+  engines: number;
+
+  /**
+   * Scales perception range
+   *
+   * @generated from field: double sensors = 2;
+   */
+  sensors: number;
+
+  /**
+   * Scales missile cooldown
+   *
+   * @generated from field: double launchers = 3;
+   */
+  launchers: number;
+
+  /**
+   * Scales heat dissipation
+   *
+   * @generated from field: double radiators = 4;
+   */
+  radiators: number;
+
+  /**
+   * Server time when passive repair resumes
+   *
+   * @generated from field: double repair_after = 5;
+   */
+  repairAfter: number;
+};
+
+/**
+ * Describes the message lightspeedduel.ws.ShipSubsystemView.
+ * Use `create(ShipSubsystemViewSchema)` to create a new message.
+ */
+export const ShipSubsystemViewSchema: GenMessage<ShipSubsystemView> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 56);
+
+/**
+ * Client → Server: Dump heat with an emergency vent
+ *
+ * @generated from message lightspeedduel.ws.EmergencyVent
+ */
+export type EmergencyVent = Message<"lightspeedduel.ws.EmergencyVent"> & {
+};
+
+/**
+ * Describes the message lightspeedduel.ws.EmergencyVent.
+ * Use `create(EmergencyVentSchema)` to create a new message.
+ */
+export const EmergencyVentSchema: GenMessage<EmergencyVent> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 57);
+
+/**
+ * Client → Server: Deploy or stow the ship's radiators
+ *
+ * @generated from message lightspeedduel.ws.DeployRadiators
+ */
+export type DeployRadiators = Message<"lightspeedduel.ws.DeployRadiators"> & {
+  /**
+   * @generated from field: bool deployed = 1;
+   */
+  deployed: boolean;
+};
+
+/**
+ * Describes the message lightspeedduel.ws.DeployRadiators.
+ * Use `create(DeployRadiatorsSchema)` to create a new message.
+ */
+export const DeployRadiatorsSchema: GenMessage<DeployRadiators> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 58);
+
+/**
+ * Client → Server: Spend a heat sink from the inventory
+ *
+ * @generated from message lightspeedduel.ws.UseHeatSink
+ */
+export type UseHeatSink = Message<"lightspeedduel.ws.UseHeatSink"> & {
+};
+
+/**
+ * Describes the message lightspeedduel.ws.UseHeatSink.
+ * Use `create(UseHeatSinkSchema)` to create a new message.
+ */
+export const UseHeatSinkSchema: GenMessage<UseHeatSink> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 59);
+
+/**
+ * Client → Server: Launch a wingman into the next free slot
+ *
+ * @generated from message lightspeedduel.ws.AddWingman
+ */
+export type AddWingman = Message<"lightspeedduel.ws.AddWingman"> & {
+};
+
+/**
+ * Describes the message lightspeedduel.ws.AddWingman.
+ * Use `create(AddWingmanSchema)` to create a new message.
+ */
+export const AddWingmanSchema: GenMessage<AddWingman> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 60);
+
+/**
+ * Client → Server: Give all wingmen a standing order
+ *
+ * @generated from message lightspeedduel.ws.WingmanOrder
+ */
+export type WingmanOrder = Message<"lightspeedduel.ws.WingmanOrder"> & {
+  /**
+   * "escort", "hold" or "attack"
+   *
+   * @generated from field: string order = 1;
+   */
+  order: string;
+};
+
+/**
+ * Describes the message lightspeedduel.ws.WingmanOrder.
+ * Use `create(WingmanOrderSchema)` to create a new message.
+ */
+export const WingmanOrderSchema: GenMessage<WingmanOrder> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 61);
+
+/**
+ * Heat ability cooldowns for the player's own ship
+ *
+ * @generated from message lightspeedduel.ws.HeatAbilitiesView
+ */
+export type HeatAbilitiesView = Message<"lightspeedduel.ws.HeatAbilitiesView"> & {
+  /**
+   * Server time when the next vent is available
+   *
+   * @generated from field: double vent_ready_at = 1;
+   */
+  ventReadyAt: number;
+
+  /**
+   * Server time when the next heat sink is available
+   *
+   * @generated from field: double sink_ready_at = 2;
+   */
+  sinkReadyAt: number;
+
+  /**
+   * @generated from field: bool radiators_deployed = 3;
+   */
+  radiatorsDeployed: boolean;
+
+  /**
+   * Server time when radiators can be toggled
+   *
+   * @generated from field: double radiator_ready_at = 4;
+   */
+  radiatorReadyAt: number;
+};
+
+/**
+ * Describes the message lightspeedduel.ws.HeatAbilitiesView.
+ * Use `create(HeatAbilitiesViewSchema)` to create a new message.
+ */
+export const HeatAbilitiesViewSchema: GenMessage<HeatAbilitiesView> = /*@__PURE__*/
+  messageDesc(file_proto_ws_messages, 62);
+
 /**
  * DAG node status enum
  *
@@ -2121,6 +2538,11 @@ export enum UpgradeEffectType {
    * @generated from enum value: UPGRADE_EFFECT_TYPE_HEAT_EFFICIENCY = 4;
    */
   HEAT_EFFICIENCY = 4,
+
+  /**
+   * @generated from enum value: UPGRADE_EFFECT_TYPE_WINGMAN_SLOT = 5;
+   */
+  WINGMAN_SLOT = 5,
 }
 
 /**
@@ -2231,6 +2653,11 @@ export enum MissionEncounterEventType {
    * @generated from enum value: MISSION_ENCOUNTER_EVENT_PURGED = 4;
    */
   MISSION_ENCOUNTER_EVENT_PURGED = 4,
+
+  /**
+   * @generated from enum value: MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED = 5;
+   */
+  MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED = 5,
 }
 
 /**
@@ -2239,3 +2666,69 @@ export enum MissionEncounterEventType {
 export const MissionEncounterEventTypeSchema: GenEnum<MissionEncounterEventType> = /*@__PURE__*/
   enumDesc(file_proto_ws_messages, 5);
 
+/**
+ * Missile guidance mode (what the missile hunts)
+ *
+ * @generated from enum lightspeedduel.ws.MissileGuidance
+ */
+export enum MissileGuidance {
+  /**
+   * @generated from enum value: MISSILE_GUIDANCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MISSILE_GUIDANCE_SHIP = 1;
+   */
+  SHIP = 1,
+
+  /**
+   * @generated from enum value: MISSILE_GUIDANCE_INTERCEPTOR = 2;
+   */
+  INTERCEPTOR = 2,
+}
+
+/**
+ * Describes the enum lightspeedduel.ws.MissileGuidance.
+ */
+export const MissileGuidanceSchema: GenEnum<MissileGuidance> = /*@__PURE__*/
+  enumDesc(file_proto_ws_messages, 6);
+
+/**
+ * Missile warhead type (blast profile on detonation)
+ *
+ * @generated from enum lightspeedduel.ws.Warhead
+ */
+export enum Warhead {
+  /**
+   * @generated from enum value: WARHEAD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WARHEAD_KINETIC = 1;
+   */
+  KINETIC = 1,
+
+  /**
+   * @generated from enum value: WARHEAD_FRAGMENTATION = 2;
+   */
+  FRAGMENTATION = 2,
+
+  /**
+   * @generated from enum value: WARHEAD_HIGH_EXPLOSIVE = 3;
+   */
+  HIGH_EXPLOSIVE = 3,
+
+  /**
+   * @generated from enum value: WARHEAD_PROXIMITY_MINE = 4;
+   */
+  PROXIMITY_MINE = 4,
+}
+
+/**
+ * Describes the enum lightspeedduel.ws.Warhead.
+ */
+export const WarheadSchema: GenEnum<Warhead> = /*@__PURE__*/
+  enumDesc(file_proto_ws_messages, 7);
+
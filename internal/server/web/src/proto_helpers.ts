@@ -20,6 +20,8 @@ import {
   DagNodeStatus,
   DagNodeKind,
   StoryIntent,
+  MissileGuidance,
+  Warhead,
 } from './proto/proto/ws_messages_pb';
 
 // Adapter types for compatibility with existing code
@@ -34,6 +36,8 @@ export interface GhostSnapshot {
   waypoints?: { x: number; y: number; speed: number }[];
   currentWaypointIndex?: number;
   hp: number;
+  maxHp: number;
+  hull: string;
   kills: number;
   heat?: {
     v: number;
@@ -46,6 +50,27 @@ export interface GhostSnapshot {
     kd: number;
     ex: number;
   };
+  pointDefense?: {
+    range: number;
+    cooldown: number;
+    heatCost: number;
+    readyAt: number;
+  };
+  subsystems?: {
+    engines: number;
+    sensors: number;
+    launchers: number;
+    radiators: number;
+    repairAfter: number;
+  };
+  heatAbilities?: {
+    ventReadyAt: number;
+    sinkReadyAt: number;
+    radiatorsDeployed: boolean;
+    radiatorReadyAt: number;
+  };
+  venting: boolean;
+  allied: boolean;
 }
 
 export interface MissileSnapshot {
@@ -62,6 +87,9 @@ export interface MissileSnapshot {
   launch: number;
   expires: number;
   targetId?: string;
+  guidance: string;
+  warhead: string;
+  blastRadius: number;
   heat?: {
     v: number;
     m: number;
@@ -88,6 +116,8 @@ export function protoToGhost(proto: Ghost): GhostSnapshot {
     waypoints: proto.waypoints?.map(wp => ({ x: wp.x, y: wp.y, speed: wp.speed })),
     currentWaypointIndex: proto.currentWaypointIndex,
     hp: proto.hp,
+    maxHp: proto.maxHp,
+    hull: proto.hull,
     kills: proto.kills,
     heat: proto.heat ? {
       v: proto.heat.v,
@@ -100,6 +130,27 @@ export function protoToGhost(proto: Ghost): GhostSnapshot {
       kd: proto.heat.kd,
       ex: proto.heat.ex,
     } : undefined,
+    pointDefense: proto.pointDefense ? {
+      range: proto.pointDefense.range,
+      cooldown: proto.pointDefense.cooldown,
+      heatCost: proto.pointDefense.heatCost,
+      readyAt: proto.pointDefense.readyAt,
+    } : undefined,
+    subsystems: proto.subsystems ? {
+      engines: proto.subsystems.engines,
+      sensors: proto.subsystems.sensors,
+      launchers: proto.subsystems.launchers,
+      radiators: proto.subsystems.radiators,
+      repairAfter: proto.subsystems.repairAfter,
+    } : undefined,
+    heatAbilities: proto.heatAbilities ? {
+      ventReadyAt: proto.heatAbilities.ventReadyAt,
+      sinkReadyAt: proto.heatAbilities.sinkReadyAt,
+      radiatorsDeployed: proto.heatAbilities.radiatorsDeployed,
+      radiatorReadyAt: proto.heatAbilities.radiatorReadyAt,
+    } : undefined,
+    venting: proto.venting,
+    allied: proto.allied,
   };
 }
 
@@ -119,6 +170,9 @@ export function protoToMissile(proto: Missile): MissileSnapshot {
     launch: proto.launchTime,
     expires: proto.expiresAt,
     targetId: proto.targetId || undefined,
+    guidance: protoGuidanceToString(proto.guidance),
+    warhead: protoWarheadToString(proto.warhead),
+    blastRadius: proto.blastRadius,
     heat: proto.heat ? {
       v: proto.heat.v,
       m: proto.heat.m,
@@ -152,6 +206,8 @@ export function protoToState(proto: StateUpdate) {
       agroMin: proto.missileConfig.agroMin,
       agroRadius: proto.missileConfig.agroRadius,
       lifetime: proto.missileConfig.lifetime,
+      guidance: protoGuidanceToString(proto.missileConfig.guidance),
+      warhead: protoWarheadToString(proto.missileConfig.warhead),
       heatConfig: proto.missileConfig.heatConfig ? {
         max: proto.missileConfig.heatConfig.max,
         warnAt: proto.missileConfig.heatConfig.warnAt,
@@ -191,6 +247,22 @@ export function protoToState(proto: StateUpdate) {
 
 // ========== Phase 2: Enum Converters ==========
 
+export function protoGuidanceToString(guidance: MissileGuidance): string {
+  switch (guidance) {
+    case MissileGuidance.INTERCEPTOR: return 'interceptor';
+    default: return 'ship';
+  }
+}
+
+export function protoWarheadToString(warhead: Warhead): string {
+  switch (warhead) {
+    case Warhead.FRAGMENTATION: return 'fragmentation';
+    case Warhead.HIGH_EXPLOSIVE: return 'high_explosive';
+    case Warhead.PROXIMITY_MINE: return 'mine';
+    default: return 'kinetic';
+  }
+}
+
 export function protoStatusToString(status: DagNodeStatus): string {
   switch (status) {
     case DagNodeStatus.LOCKED: return 'locked';
@@ -254,6 +326,11 @@ export interface PlayerCapabilitiesData {
   unlockedMissiles: string[];
   heatCapacity: number;
   heatEfficiency: number;
+  hull: string;
+  missileCooldownMultiplier: number;
+  maxSpeed: number;
+  maxHp: number;
+  wingmanSlots: number;
 }
 
 export interface DagStateData {
@@ -319,6 +396,11 @@ export function protoToPlayerCapabilities(proto: PlayerCapabilities): PlayerCapa
     unlockedMissiles: proto.unlockedMissiles,
     heatCapacity: proto.heatCapacity,
     heatEfficiency: proto.heatEfficiency,
+    hull: proto.hull,
+    missileCooldownMultiplier: proto.missileCooldownMultiplier,
+    maxSpeed: proto.maxSpeed,
+    maxHp: proto.maxHp,
+    wingmanSlots: proto.wingmanSlots,
   };
 }
 
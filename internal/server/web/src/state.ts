@@ -34,6 +34,21 @@ export interface HeatView {
   exp: number;
 }
 
+export interface SubsystemView {
+  engines: number; // health 0..1
+  sensors: number;
+  launchers: number;
+  radiators: number;
+  repairAfter: number; // server time seconds
+}
+
+export interface HeatAbilitiesView {
+  ventReadyAt: number; // server time seconds
+  sinkReadyAt: number;
+  radiatorsDeployed: boolean;
+  radiatorReadyAt: number;
+}
+
 export interface ShipSnapshot {
   id?: string;
   x: number;
@@ -45,6 +60,10 @@ export interface ShipSnapshot {
   waypoints: Waypoint[];
   currentWaypointIndex?: number;
   heat?: HeatView;
+  subsystems?: SubsystemView;
+  heatAbilities?: HeatAbilitiesView;
+  venting?: boolean;
+  allied?: boolean;
 }
 
 export interface GhostSnapshot {
@@ -131,6 +150,11 @@ export interface PlayerCapabilities {
   unlockedMissiles: string[];
   heatCapacity: number;
   heatEfficiency: number;
+  hull?: string;
+  missileCooldownMultiplier?: number;
+  maxSpeed?: number;
+  maxHp?: number;
+  wingmanSlots?: number;
 }
 
 // Missile preset definitions matching backend
@@ -238,6 +262,38 @@ export interface MissionObjectiveState {
 
 export type MissionStatus = "idle" | "active" | "completed" | "failed";
 
+export interface MissionConvoyState {
+  hp: number;
+  maxHp: number;
+  leg: number;
+  legs: number;
+  x: number;
+  y: number;
+  arrived: boolean;
+  destroyed: boolean;
+}
+
+export interface MissionAvailability {
+  missionId: string;
+  campaignId: string;
+  displayName: string;
+  archetype: string;
+  status: "locked" | "available" | "completed" | "cooldown";
+  missing: string[];
+  retryIn: number;
+  active: boolean;
+}
+
+// StoryVote is the co-op party's standing on a story choice.
+export interface StoryVote {
+  nodeId: string;
+  mode: "vote" | "leader";
+  leaderId: string;
+  votes: Record<string, number>;
+  waiting: number;
+  resolved: string;
+}
+
 export interface MissionState {
   missionId: string;
   templateId: string;
@@ -255,6 +311,8 @@ export interface MissionState {
   encounters: MissionEncounterState[];
   objectives: MissionObjectiveState[];
   objectiveSummaries: string[];
+  convoy?: MissionConvoyState | null;
+  failureReason?: string;
 }
 
 export function resetMissionState(mission: MissionState): void {
@@ -269,6 +327,8 @@ export function resetMissionState(mission: MissionState): void {
   mission.progress = 0;
   mission.objectives = [];
   mission.objectiveSummaries = [];
+  mission.convoy = null;
+  mission.failureReason = "";
   mission.player = null;
   mission.beacons = [];
   mission.encounters = [];
@@ -336,6 +396,8 @@ export interface AppState {
   inventory: Inventory | null;
   dag: DagState | null;
   mission: MissionState | null;
+  missionList: MissionAvailability[];
+  storyVote: StoryVote | null;
   story: StoryState | null;
   craftHeatCapacity: number; // Heat capacity slider value for crafting
   capabilities: PlayerCapabilities | null;
@@ -412,6 +474,8 @@ export function createInitialState(limits: MissileLimits = {
     inventory: null,
     dag: null,
     mission: null,
+    missionList: [],
+    storyVote: null,
     story: null,
     craftHeatCapacity: 80, // Default to basic missile heat capacity
     capabilities: null,
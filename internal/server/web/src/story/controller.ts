@@ -1,5 +1,5 @@
 import type { EventBus } from "../bus";
-import type { AppState } from "../state";
+import type { AppState, StoryVote } from "../state";
 import type { DialogueOverlay } from "./overlay";
 import type { DialogueContent } from "./types";
 import { sendMessage } from "../net";
@@ -100,6 +100,24 @@ export function createStoryController({ bus, overlay, state }: StoryControllerOp
     bus.emit("dialogue:opened", { nodeId: node, chapterId: chapter });
   }
 
+  function handleStoryVote(vote: StoryVote): void {
+    if (vote.resolved) {
+      showTutorialTip({ title: "Party decision", text: `The party chose ${vote.resolved}.` });
+      const tip = tutorialTipElement;
+      window.setTimeout(() => {
+        if (tutorialTipElement === tip) hideTutorialTip();
+      }, 4000);
+      return;
+    }
+    const tally = Object.entries(vote.votes)
+      .map(([choice, count]) => `${choice}: ${count}`)
+      .join(", ");
+    showTutorialTip({
+      title: "Party vote",
+      text: `Waiting for ${vote.waiting} crewmate${vote.waiting === 1 ? "" : "s"}${tally ? ` (${tally})` : ""}.`,
+    });
+  }
+
   function showTutorialTip(tip: { title: string; text: string }): void {
     hideTutorialTip();
 
@@ -197,6 +215,8 @@ export function createStoryController({ bus, overlay, state }: StoryControllerOp
     console.log("[story] Starting story controller");
     // Listen for story node activation from the server
     listeners.push(bus.on("story:nodeActivated", handleNodeActivated));
+    // Co-op rooms report how the party's story choice stands
+    listeners.push(bus.on("story:vote", handleStoryVote));
 
     // Check if there's already an active story node on startup
     if (state.story?.activeNode) {
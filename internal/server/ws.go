@@ -137,6 +137,7 @@ type stateMsg struct {
 	Dag                *dagStateDTO      `json:"dag,omitempty"`       // DAG progression state
 	Inventory          *inventoryDTO     `json:"inventory,omitempty"` // Player's crafted items
 	Story              *storyStateDTO    `json:"story,omitempty"`
+	Capabilities       *capabilitiesDTO  `json:"capabilities,omitempty"`
 }
 
 type roomMeta struct {
@@ -146,18 +147,25 @@ type roomMeta struct {
 }
 
 type ghost struct {
-	ID                   string           `json:"id"`
-	X                    float64          `json:"x"`
-	Y                    float64          `json:"y"`
-	VX                   float64          `json:"vx"`
-	VY                   float64          `json:"vy"`
-	T                    float64          `json:"t"`
-	Self                 bool             `json:"self"`
-	Waypoints            []waypointDTO    `json:"waypoints,omitempty"`
-	CurrentWaypointIndex int              `json:"current_waypoint_index,omitempty"`
-	HP                   int              `json:"hp"`
-	Kills                int              `json:"kills"`
-	Heat                 *shipHeatViewDTO `json:"heat,omitempty"`
+	ID                   string                `json:"id"`
+	X                    float64               `json:"x"`
+	Y                    float64               `json:"y"`
+	VX                   float64               `json:"vx"`
+	VY                   float64               `json:"vy"`
+	T                    float64               `json:"t"`
+	Self                 bool                  `json:"self"`
+	Waypoints            []waypointDTO         `json:"waypoints,omitempty"`
+	CurrentWaypointIndex int                   `json:"current_waypoint_index,omitempty"`
+	HP                   int                   `json:"hp"`
+	Kills                int                   `json:"kills"`
+	Heat                 *shipHeatViewDTO      `json:"heat,omitempty"`
+	PointDefense         *pointDefenseViewDTO  `json:"point_defense,omitempty"`
+	Hull                 string                `json:"hull,omitempty"`
+	MaxHP                int                   `json:"max_hp,omitempty"`
+	Subsystems           *shipSubsystemViewDTO `json:"subsystems,omitempty"`
+	HeatAbilities        *heatAbilitiesViewDTO `json:"heat_abilities,omitempty"`
+	Venting              bool                  `json:"venting,omitempty"`
+	Allied               bool                  `json:"allied,omitempty"`
 }
 
 type storyStateDTO struct {
@@ -189,6 +197,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 
 	mode := strings.ToLower(query.Get("mode"))
 	missionKey := ""
+	coopChoices := strings.ToLower(query.Get("coop"))
 
 	mapW := WorldW
 	mapH := WorldH
@@ -217,6 +226,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 	}
 
 	heatOverrides, hasHeatOverrides := parseHeatOverrides(query)
+	reloadHeat := query.Get("reloadHeat") == "1"
 
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -254,10 +264,19 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 			newParams := applyHeatOverrides(base, heatOverrides)
 			room.SetHeatParamsLocked(newParams)
 			log.Printf("room %s heat overrides: marker %.1f warn %.1f overheat %.1f", room.ID, newParams.MarkerSpeed, newParams.WarnAt, newParams.OverheatAt)
+		} else if reloadHeat {
+			// Rooms tuned by URL keep their own heat; others may follow reloads.
+			room.SetFollowHeatReloadsLocked(true)
 		}
 	}
 	if mode == "campaign" {
 		director = room.EnsureBeaconDirectorLocked(missionKey)
+		// The first player decides whether the campaign room is co-op.
+		if coopChoices != "" && room.HumanPlayerCountLocked() == 0 && room.CoopLocked() == nil {
+			if err := room.EnableCoopLocked(coopChoices, playerID); err != nil {
+				log.Printf("room %s co-op: %v", room.ID, err)
+			}
+		}
 	}
 
 	defaultMissileSpeed := ShipMaxSpeed * 0.75
@@ -286,7 +305,6 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 	player.Ship = shipEntity
 	room.Players[playerID] = player
 	if mode == "campaign" {
-		room.HandleMissionStoryEventLocked(player, "mission:start", 0)
 		if graph := dag.GetGraph(); graph != nil {
 			effects := game.NewRoomDagEffects(room, player)
 			room.EvaluatePlayerDagLocked(graph, player, effects) // Make method public or add helper
@@ -296,6 +314,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 				room.BroadcastMissionOffer(player, template)
 			}
 		}
+		player.SendMessage("mission:list", room.MissionListLocked(player))
 	}
 	room.Mu.Unlock()
 
@@ -323,7 +342,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 				case *pb.WsEnvelope_Join:
 					handleJoin(room, playerID, payload.Join)
 				case *pb.WsEnvelope_SpawnBot:
-					handleSpawnBot(room, playerID)
+					handleSpawnBot(room, playerID, payload.SpawnBot)
 				case *pb.WsEnvelope_AddWaypoint:
 					handleAddWaypoint(room, playerID, payload.AddWaypoint)
 				case *pb.WsEnvelope_UpdateWaypoint:
@@ -356,6 +375,22 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 					handleSetActiveMissileRoute(room, playerID, payload.SetActiveMissileRoute)
 				case *pb.WsEnvelope_LaunchMissile:
 					handleLaunchMissile(room, playerID, payload.LaunchMissile)
+				case *pb.WsEnvelope_UseRepairKit:
+					handleUseRepairKit(room, playerID)
+
+				// Heat management commands
+				case *pb.WsEnvelope_EmergencyVent:
+					handleEmergencyVent(room, playerID)
+				case *pb.WsEnvelope_DeployRadiators:
+					handleDeployRadiators(room, playerID, payload.DeployRadiators)
+				case *pb.WsEnvelope_UseHeatSink:
+					handleUseHeatSink(room, playerID)
+
+				// Wingman commands
+				case *pb.WsEnvelope_AddWingman:
+					handleAddWingman(room, playerID)
+				case *pb.WsEnvelope_WingmanOrder:
+					handleWingmanOrder(room, playerID, payload.WingmanOrder)
 
 				// Phase 2: DAG commands
 				case *pb.WsEnvelope_DagStart:
@@ -390,16 +425,39 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 						continue
 					}
 					room.Mu.Lock()
-					dir := room.BeaconDirectorLocked()
 					p := room.Players[playerID]
-					if dir != nil && p != nil {
-						if err := dir.AcceptMission(room, p, payload.MissionID); err != nil {
+					if p != nil && mode == "campaign" {
+						missionID := payload.MissionID
+						if missionID != "" {
+							missionID = normalizeMissionID(missionID)
+						}
+						if err := room.SelectMissionLocked(p, missionID); err != nil {
 							log.Printf("mission accept error for player %s: %v", playerID, err)
+							p.SendMessage("mission:list", room.MissionListLocked(p))
 						} else {
+							if template := room.BeaconDirectorLocked().MissionTemplate(); template != nil {
+								room.BroadcastMissionOffer(p, template)
+							}
 							room.BroadcastObjectiveProgress(p, "", 0)
 						}
 					}
 					room.Mu.Unlock()
+				case "mission:generate":
+					var payload MissionGenerateDTO
+					if err := json.Unmarshal(inbound.Payload, &payload); err != nil {
+						log.Printf("invalid mission:generate payload: %v", err)
+						continue
+					}
+					if mode != "campaign" {
+						continue
+					}
+					handleMissionGenerate(h, room, playerID, payload)
+				case "mission:list":
+					room.Mu.Lock()
+					if p := room.Players[playerID]; p != nil {
+						p.SendMessage("mission:list", room.MissionListLocked(p))
+					}
+					room.Mu.Unlock()
 				case "debug:request-encounter-info":
 					room.Mu.Lock()
 					director := room.BeaconDirectorLocked()
@@ -459,6 +517,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 				var dagDTO *dagStateDTO
 				var invDTO *inventoryDTO
 				var storyDTO *storyStateDTO
+				var capsDTO *capabilitiesDTO
 
 				var meEntity EntityID
 				var meTransform *Transform
@@ -489,6 +548,8 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 					missileCfg.Speed = cfg.Speed
 					missileCfg.AgroRadius = cfg.AgroRadius
 					missileCfg.Lifetime = cfg.Lifetime
+					missileCfg.Guidance = cfg.Guidance.String()
+					missileCfg.Warhead = cfg.Warhead.String()
 					missileCfg.HeatConfig = &heatParamsDTO{
 						Max:         cfg.HeatParams.Max,
 						WarnAt:      cfg.HeatParams.WarnAt,
@@ -540,6 +601,8 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 						}
 						if shipData != nil {
 							meGhost.HP = shipData.HP
+							meGhost.MaxHP = shipData.MaxHP
+							meGhost.Hull = shipData.Hull
 						}
 						meGhost.Kills = p.Kills
 						if route != nil && len(route.Waypoints) > 0 {
@@ -570,6 +633,48 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 								EX: heat.P.Exp,
 							}
 						}
+						if pd := room.World.PointDefense(meEntity); pd != nil {
+							meGhost.PointDefense = &pointDefenseViewDTO{
+								Range:    pd.Range,
+								Cooldown: pd.Cooldown,
+								HeatCost: pd.HeatCost,
+								ReadyAt:  pd.ReadyAt,
+							}
+						}
+						if subs := room.World.Subsystems(meEntity); subs != nil {
+							meGhost.Subsystems = &shipSubsystemViewDTO{
+								Engines:     subs.Health[SubsystemEngines],
+								Sensors:     subs.Health[SubsystemSensors],
+								Launchers:   subs.Health[SubsystemLaunchers],
+								Radiators:   subs.Health[SubsystemRadiators],
+								RepairAfter: subs.RepairAfter,
+							}
+						}
+						if abilities := room.World.HeatAbilities(meEntity); abilities != nil {
+							meGhost.HeatAbilities = &heatAbilitiesViewDTO{
+								VentReadyAt:       abilities.VentReadyAt,
+								SinkReadyAt:       abilities.SinkReadyAt,
+								RadiatorsDeployed: abilities.RadiatorsDeployed,
+								RadiatorReadyAt:   abilities.RadiatorReadyAt,
+							}
+							meGhost.Venting = abilities.VentVisibleAt(now)
+						}
+					}
+
+					hull := ResolveHull(p.Hull)
+					capsDTO = &capabilitiesDTO{
+						SpeedMultiplier:           p.Capabilities.ShipSpeedMultiplier,
+						UnlockedMissiles:          p.Capabilities.UnlockedMissiles,
+						HeatCapacity:              p.Capabilities.ShipHeatCapacity,
+						HeatEfficiency:            p.Capabilities.ShipHeatEfficiency,
+						Hull:                      hull.ID,
+						MissileCooldownMultiplier: hull.MissileCooldownMultiplier,
+						MaxSpeed:                  hull.MaxSpeed,
+						MaxHP:                     hull.MaxHP,
+						WingmanSlots:              p.Capabilities.WingmanSlots,
+					}
+					if mov := room.World.Movement(p.Ship); mov != nil {
+						capsDTO.MaxSpeed = mov.MaxSpeed
 					}
 
 					// Build DAG state DTO
@@ -689,6 +794,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 
 				if meTransform != nil {
 					mePos := meTransform.Pos
+					sensorRange := SensorRange(room.World, meEntity)
 					// Render other ships using perception system
 					room.World.ForEach([]ComponentKey{CompTransform, CompShip, CompOwner, CompHistory}, func(e EntityID) {
 						if e == meEntity {
@@ -701,23 +807,31 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 						}
 						// Use perception system to get what player sees of this ship
 						snap, ok := PerceiveEntity(mePos, e, room.World, now)
-						if !ok {
+						if !ok || mePos.Sub(snap.Pos).Len() > sensorRange {
 							return
 						}
 						kills := 0
 						if otherPlayer := room.Players[owner.PlayerID]; otherPlayer != nil {
 							kills = otherPlayer.Kills
 						}
+						ghostID := fmt.Sprintf("ship-%s", owner.PlayerID)
+						if room.World.Gunship(e) != nil {
+							ghostID = fmt.Sprintf("gunship-%d", e)
+						}
 						ghosts = append(ghosts, ghost{
-							ID:    fmt.Sprintf("ship-%s", owner.PlayerID),
-							X:     snap.Pos.X,
-							Y:     snap.Pos.Y,
-							VX:    snap.Vel.X,
-							VY:    snap.Vel.Y,
-							T:     snap.T,
-							HP:    shipData.HP,
-							Kills: kills,
-							Self:  false,
+							ID:      ghostID,
+							X:       snap.Pos.X,
+							Y:       snap.Pos.Y,
+							VX:      snap.Vel.X,
+							VY:      snap.Vel.Y,
+							T:       snap.T,
+							HP:      shipData.HP,
+							MaxHP:   shipData.MaxHP,
+							Hull:    shipData.Hull,
+							Kills:   kills,
+							Self:    false,
+							Venting: room.World.HeatAbilities(e).VentVisibleAt(snap.T),
+							Allied:  owner.LeaderID == playerID,
 						})
 					})
 
@@ -731,12 +845,14 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 						// Use perception system to get what player sees of this missile
 						// This handles light delay and prevents showing missiles before light reaches viewer
 						snap, ok := PerceiveEntity(mePos, e, room.World, now)
-						if !ok {
+						if !ok || mePos.Sub(snap.Pos).Len() > sensorRange {
 							return
 						}
 						targetID := ""
 						if missile.Target != 0 {
-							if targetOwner := room.World.Owner(missile.Target); targetOwner != nil {
+							if room.World.MissileData(missile.Target) != nil {
+								targetID = fmt.Sprintf("miss-%d", missile.Target)
+							} else if targetOwner := room.World.Owner(missile.Target); targetOwner != nil {
 								targetID = fmt.Sprintf("ship-%s", targetOwner.PlayerID)
 							}
 						}
@@ -758,20 +874,23 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 						}
 
 						missiles = append(missiles, missileDTO{
-							ID:         fmt.Sprintf("miss-%d", e),
-							Owner:      owner.PlayerID,
-							Self:       owner.PlayerID == playerID,
-							X:          snap.Pos.X,
-							Y:          snap.Pos.Y,
-							VX:         snap.Vel.X,
-							VY:         snap.Vel.Y,
-							T:          snap.T,
-							AgroRadius: missile.AgroRadius,
-							Lifetime:   missile.Lifetime,
-							LaunchTime: missile.LaunchTime,
-							ExpiresAt:  missile.LaunchTime + missile.Lifetime,
-							TargetID:   targetID,
-							Heat:       heatView,
+							ID:          fmt.Sprintf("miss-%d", e),
+							Owner:       owner.PlayerID,
+							Self:        owner.PlayerID == playerID,
+							X:           snap.Pos.X,
+							Y:           snap.Pos.Y,
+							VX:          snap.Vel.X,
+							VY:          snap.Vel.Y,
+							T:           snap.T,
+							AgroRadius:  missile.AgroRadius,
+							Lifetime:    missile.Lifetime,
+							LaunchTime:  missile.LaunchTime,
+							ExpiresAt:   missile.LaunchTime + missile.Lifetime,
+							TargetID:    targetID,
+							Heat:        heatView,
+							Guidance:    missile.Guidance.String(),
+							Warhead:     missile.Warhead.String(),
+							BlastRadius: GetWarheadSpec(missile.Warhead).BlastRadius,
 						})
 					})
 				}
@@ -813,6 +932,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 					Dag:                dagDTO,
 					Inventory:          invDTO,
 					Story:              storyDTO,
+					Capabilities:       capsDTO,
 				}
 				// Convert to protobuf and send
 				stateProto := stateToProto(msg)
@@ -853,10 +973,7 @@ func serveWS(h *Hub, w http.ResponseWriter, r *http.Request) {
 	conn.Close()
 
 	room.Mu.Lock()
-	if _, ok := room.Players[playerID]; ok {
-		room.RemovePlayerEntitiesLocked(playerID)
-		delete(room.Players, playerID)
-	}
+	room.RemovePlayerLocked(playerID)
 	if room.HumanPlayerCountLocked() == 0 {
 		room.RemoveAllBotsLocked()
 	}
@@ -875,10 +992,15 @@ func handleJoin(room *Room, playerID string, msg *pb.ClientJoin) {
 	}
 	if p := room.Players[playerID]; p != nil {
 		p.Name = name
+		if hull := strings.TrimSpace(msg.Hull); hull != "" {
+			if err := room.SetPlayerHullLocked(p, hull); err != nil {
+				log.Printf("player %s join: %v", playerID, err)
+			}
+		}
 	}
 }
 
-func handleSpawnBot(room *Room, playerID string) {
+func handleSpawnBot(room *Room, playerID string, msg *pb.SpawnBot) {
 	room.Mu.Lock()
 	defer room.Mu.Unlock()
 
@@ -892,7 +1014,24 @@ func handleSpawnBot(room *Room, playerID string) {
 				Y: Clamp(room.WorldHeight-tr.Pos.Y, 0, room.WorldHeight),
 			}
 		}
-		room.AddBotLocked("Sentinel AI", NewDefensiveBehavior(), spawnPos)
+		opts := BotSpawnOptions{Name: "Sentinel AI", Pos: spawnPos}
+		if msg != nil {
+			opts.Behavior = msg.Behavior
+			opts.Difficulty = msg.Difficulty
+			opts.Hull = msg.Hull
+			if msg.X != nil {
+				opts.Pos.X = msg.GetX()
+			}
+			if msg.Y != nil {
+				opts.Pos.Y = msg.GetY()
+			}
+			if msg.Behavior != "" || msg.Difficulty != "" {
+				opts.Name = ""
+			}
+		}
+		if _, err := room.AddConfiguredBotLocked(opts); err != nil {
+			log.Printf("spawn_bot error for player %s: %v", playerID, err)
+		}
 	}
 }
 
@@ -958,6 +1097,12 @@ func handleConfigureMissile(room *Room, playerID string, msg *pb.ConfigureMissil
 		if msg.MissileAgro >= 0 {
 			cfg.AgroRadius = msg.MissileAgro
 		}
+		if msg.Guidance != pb.MissileGuidance_MISSILE_GUIDANCE_UNSPECIFIED {
+			cfg.Guidance = missileGuidanceFromProto(msg.Guidance)
+		}
+		if msg.Warhead != pb.Warhead_WARHEAD_UNSPECIFIED {
+			cfg.Warhead = warheadFromProto(msg.Warhead)
+		}
 		p.MissileConfig = SanitizeMissileConfig(cfg)
 	}
 }
@@ -1149,7 +1294,7 @@ func handleLaunchMissile(room *Room, playerID string, msg *pb.LaunchMissile) {
 		if tr := room.World.Transform(p.Ship); tr != nil {
 			speed := tr.Vel.Len()
 			if id := room.LaunchMissile(playerID, p.Ship, cfg, waypoints, tr.Pos, tr.Vel); id != 0 {
-				p.MissileReadyAt = now + MissileCooldownForSpeed(speed)
+				p.MissileReadyAt = now + room.MissileCooldownFor(p, speed)
 				// Consume one missile from inventory
 				p.Inventory.RemoveItem(missileToConsume.Type, missileToConsume.VariantID, missileToConsume.HeatCapacity, 1)
 				log.Printf("Player %s launched missile, consumed 1x %s (heat: %.0f)", playerID, missileToConsume.VariantID, missileToConsume.HeatCapacity)
@@ -1158,6 +1303,76 @@ func handleLaunchMissile(room *Room, playerID string, msg *pb.LaunchMissile) {
 	}
 }
 
+func handleUseRepairKit(room *Room, playerID string) {
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+
+	if p := room.Players[playerID]; p != nil {
+		if err := room.UseRepairKitLocked(p); err != nil {
+			log.Printf("use_repair_kit error for player %s: %v", playerID, err)
+		}
+	}
+}
+
+func handleEmergencyVent(room *Room, playerID string) {
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+
+	if p := room.Players[playerID]; p != nil {
+		if err := room.VentHeatLocked(p); err != nil {
+			log.Printf("emergency_vent error for player %s: %v", playerID, err)
+		}
+	}
+}
+
+func handleDeployRadiators(room *Room, playerID string, msg *pb.DeployRadiators) {
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+
+	if p := room.Players[playerID]; p != nil {
+		if err := room.SetRadiatorsLocked(p, msg.Deployed); err != nil {
+			log.Printf("deploy_radiators error for player %s: %v", playerID, err)
+		}
+	}
+}
+
+func handleUseHeatSink(room *Room, playerID string) {
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+
+	if p := room.Players[playerID]; p != nil {
+		if err := room.UseHeatSinkLocked(p); err != nil {
+			log.Printf("use_heat_sink error for player %s: %v", playerID, err)
+		}
+	}
+}
+
+func handleAddWingman(room *Room, playerID string) {
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+
+	if p := room.Players[playerID]; p != nil {
+		if _, err := room.AddWingmanLocked(p); err != nil {
+			log.Printf("add_wingman error for player %s: %v", playerID, err)
+		}
+	}
+}
+
+func handleWingmanOrder(room *Room, playerID string, msg *pb.WingmanOrder) {
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+
+	if p := room.Players[playerID]; p != nil {
+		order, err := ParseWingmanOrder(msg.GetOrder())
+		if err == nil {
+			err = room.SetWingmanOrderLocked(p, order)
+		}
+		if err != nil {
+			log.Printf("wingman_order error for player %s: %v", playerID, err)
+		}
+	}
+}
+
 // ========== Phase 2: DAG Command Handlers ==========
 
 func handleDagStart(room *Room, playerID string, msg *pb.DagStart) {
@@ -1270,14 +1485,65 @@ func handleDagList(room *Room, playerID string, conn *websocket.Conn) {
 
 // ========== Phase 2: Mission Event Handlers ==========
 
+// normalizeMissionID maps a requested mission to a template ID. Numbers pick
+// the nth mission of the default campaign; empty picks the first.
 func normalizeMissionID(raw string) string {
 	trimmed := strings.TrimSpace(strings.ToLower(raw))
-	if trimmed == "" || trimmed == "1" {
+	if trimmed == "" {
+		trimmed = "1"
+	}
+	if n, err := strconv.Atoi(trimmed); err == nil {
+		if id, ok := CampaignMissionID(DefaultCampaignID, n); ok {
+			return id
+		}
 		return "campaign-1"
 	}
 	return trimmed
 }
 
+// handleMissionGenerate generates and registers a side mission or the daily
+// challenge, then sends it and the refreshed mission list to the player.
+func handleMissionGenerate(h *Hub, room *Room, playerID string, msg MissionGenerateDTO) {
+	room.Mu.Lock()
+	allowed := room.ClaimMissionGenerateLocked(room.Players[playerID])
+	room.Mu.Unlock()
+	if !allowed {
+		log.Printf("mission generate from player %s ignored: still cooling down", playerID)
+		return
+	}
+
+	var (
+		generated game.GeneratedMission
+		err       error
+	)
+	if msg.Daily {
+		generated, err = game.GenerateDailyMission(time.Now(), msg.Difficulty)
+	} else {
+		seed := msg.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		generated, err = game.GenerateMission(seed, msg.Difficulty)
+	}
+	if err != nil {
+		log.Printf("mission generate error for player %s: %v", playerID, err)
+		return
+	}
+	h.RegisterGeneratedMission(generated)
+
+	room.Mu.Lock()
+	defer room.Mu.Unlock()
+	if p := room.Players[playerID]; p != nil {
+		p.SendMessage("mission:generated", MissionGeneratedDTO{
+			MissionID:   generated.Template.ID,
+			DisplayName: generated.Template.DisplayName,
+			Seed:        generated.Seed,
+			Difficulty:  generated.Difficulty,
+		})
+		p.SendMessage("mission:list", room.MissionListLocked(p))
+	}
+}
+
 func handleMissionSpawnWave(room *Room, playerID string, msg *pb.MissionSpawnWave, mode string) {
 	if mode != "campaign" {
 		return
@@ -1291,7 +1557,9 @@ func handleMissionSpawnWave(room *Room, playerID string, msg *pb.MissionSpawnWav
 	room.Mu.Lock()
 	defer room.Mu.Unlock()
 
-	room.EnsureBeaconDirectorLocked("campaign-1")
+	if room.BeaconDirectorLocked() == nil {
+		room.EnsureBeaconDirectorLocked(normalizeMissionID(""))
+	}
 	if p := room.Players[playerID]; p != nil {
 		room.HandleMissionStoryEventLocked(p, "mission:beacon-locked", waveIndex)
 	}
@@ -1456,6 +1724,8 @@ func missionEncounterTypeToProto(t EncounterDeltaType) pb.MissionEncounterEventT
 		return pb.MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_TIMEOUT
 	case EncounterDeltaPurged:
 		return pb.MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_PURGED
+	case EncounterDeltaSourceDestroyed:
+		return pb.MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_SOURCE_DESTROYED
 	default:
 		return pb.MissionEncounterEventType_MISSION_ENCOUNTER_EVENT_UNSPECIFIED
 	}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"log"
 	"math"
 
 	"LightSpeedDuel/internal/server"
@@ -10,6 +11,11 @@ import (
 func main() {
 	addr := flag.String("addr", ":8080", "address to listen on (e.g., 127.0.0.1:8080)")
 	heatConfigPath := flag.String("heat-config", "configs/world.json", "path to world/heat tuning JSON")
+	botTreesDir := flag.String("bots-dir", "configs/bots", "directory of JSON behavior trees for bots")
+	contentDir := flag.String("content-dir", "configs/content", "directory of JSON mission, encounter, spawn table and DAG node files")
+	watch := flag.Duration("watch", 0, "poll world config and content for edits at this interval and reload them (e.g. 2s; SIGHUP always reloads)")
+	gym := flag.Bool("gym", false, "run the training gym protocol instead of the web server")
+	gymAddr := flag.String("gym-addr", "", "serve the gym on this local address instead of stdin/stdout")
 	heatMax := flag.Float64("heat-max", math.NaN(), "override maximum heat capacity")
 	heatWarn := flag.Float64("heat-warn", math.NaN(), "override warning threshold")
 	heatOverheat := flag.Float64("heat-overheat", math.NaN(), "override overheat threshold")
@@ -25,6 +31,9 @@ func main() {
 
 	cfg := server.DefaultAppConfig()
 	cfg.HeatConfigPath = *heatConfigPath
+	cfg.BotTreesDir = *botTreesDir
+	cfg.ContentDir = *contentDir
+	cfg.WatchInterval = *watch
 
 	var overrides server.HeatParamOverrides
 
@@ -75,5 +84,12 @@ func main() {
 
 	cfg.HeatOverrides = overrides
 
+	if *gym {
+		if err := server.RunGym(*gymAddr, cfg); err != nil {
+			log.Fatalf("gym: %v", err)
+		}
+		return
+	}
+
 	server.StartApp(*addr, cfg)
 }